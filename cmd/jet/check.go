@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/go-jet/jet/v2/generator/template"
+	"github.com/go-jet/jet/v2/internal/utils/diff"
+)
+
+// checkGeneratedFiles compares files rendered into the memory output with the files from the destination
+// directory, and prints unified diff for each file that differs. Destination directory files, not generated
+// anymore, are reported as deleted. Returns true if any difference is found.
+func checkGeneratedFiles(output *template.MemoryOutput) (bool, error) {
+	filePaths := map[string]bool{}
+
+	for filePath := range output.Files {
+		filePaths[filePath] = true
+	}
+
+	for _, dirPath := range output.Dirs {
+		err := filepath.WalkDir(dirPath, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if entry.Type().IsRegular() {
+				filePaths[path] = true
+			}
+
+			return nil
+		})
+
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return false, fmt.Errorf("failed to read destination directory %s: %w", dirPath, err)
+		}
+	}
+
+	var changedFiles []string
+
+	for _, filePath := range slices.Sorted(maps.Keys(filePaths)) {
+		oldName, newName := filePath, filePath
+
+		existing, err := os.ReadFile(filePath) // #nosec G304
+		isExisting := err == nil
+		if errors.Is(err, fs.ErrNotExist) {
+			oldName = "/dev/null"
+		} else if err != nil {
+			return false, fmt.Errorf("failed to read %s: %w", filePath, err)
+		}
+
+		generated, isGenerated := output.Files[filePath]
+		if !isGenerated {
+			newName = "/dev/null"
+		}
+
+		fileDiff := diff.Unified(oldName, newName, existing, generated)
+		if fileDiff == "" {
+			if isExisting && isGenerated {
+				continue
+			}
+			fileDiff = fmt.Sprintf("--- %s\n+++ %s\n", oldName, newName) // empty file added or removed
+		}
+
+		fmt.Print(fileDiff)
+		changedFiles = append(changedFiles, filePath)
+	}
+
+	if len(changedFiles) > 0 {
+		fmt.Printf("\n%d generated file(s) out of date.\n", len(changedFiles))
+		return true, nil
+	}
+
+	fmt.Println("Generated files are up to date.")
+	return false, nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-jet/jet/v2/generator/config"
+)
+
+// loadConfigFile reads generator configuration file. File format is determined by the file extension, and can be
+// JSON (.json) or YAML (.yaml, .yml). YAML configuration is converted to JSON, so unknown configuration properties
+// are reported as an error for both formats.
+func loadConfigFile(filePath string) (config.Config, error) {
+	switch ext := strings.ToLower(filepath.Ext(filePath)); ext {
+	case ".json":
+		return config.Load(filePath)
+	case ".yaml", ".yml":
+		data, err := os.ReadFile(filePath) // #nosec G304
+		if err != nil {
+			return config.Config{}, fmt.Errorf("failed to read config file: %w", err)
+		}
+
+		jsonData, err := yamlToJSON(data)
+		if err != nil {
+			return config.Config{}, fmt.Errorf("failed to parse config file %s: %w", filePath, err)
+		}
+
+		cfg, err := config.Parse(jsonData)
+		if err != nil {
+			return config.Config{}, fmt.Errorf("failed to parse config file %s: %w", filePath, err)
+		}
+
+		return cfg, nil
+	default:
+		return config.Config{}, fmt.Errorf("unsupported config file format '%s', only .json, .yaml and .yml files are supported", ext)
+	}
+}
+
+func yamlToJSON(data []byte) ([]byte, error) {
+	var content any
+
+	if err := yaml.Unmarshal(data, &content); err != nil {
+		return nil, err
+	}
+
+	if content == nil { // empty file
+		return nil, nil
+	}
+
+	return json.Marshal(content)
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-jet/jet/v2/generator/config"
+)
+
+const yamlConfig = `
+model:
+  path: entity
+  tables:
+    film:
+      fields:
+        price:
+          type: decimal.Decimal
+          importPath: github.com/shopspring/decimal
+          tags: ['json:"price"']
+`
+
+const jsonConfig = `
+{
+  "model": {
+    "path": "entity",
+    "tables": {
+      "film": {
+        "fields": {
+          "price": {
+            "type": "decimal.Decimal",
+            "importPath": "github.com/shopspring/decimal",
+            "tags": ["json:\"price\""]
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+func TestLoadConfigFile(t *testing.T) {
+	yamlCfg, err := loadConfigFile(writeConfigFile(t, "jet.yaml", yamlConfig))
+	require.NoError(t, err)
+
+	ymlCfg, err := loadConfigFile(writeConfigFile(t, "jet.yml", yamlConfig))
+	require.NoError(t, err)
+
+	jsonCfg, err := loadConfigFile(writeConfigFile(t, "jet.json", jsonConfig))
+	require.NoError(t, err)
+
+	require.Equal(t, jsonCfg, yamlCfg)
+	require.Equal(t, jsonCfg, ymlCfg)
+	require.Equal(t, "decimal.Decimal", yamlCfg.Model.Tables["film"].Fields["price"].Type)
+}
+
+func TestLoadConfigFileErrors(t *testing.T) {
+	_, err := loadConfigFile(writeConfigFile(t, "jet.yaml", "model:\n  pth: entity\n"))
+	require.ErrorContains(t, err, `unknown field "pth"`)
+
+	_, err = loadConfigFile(writeConfigFile(t, "jet.yaml", "model: [\n"))
+	require.ErrorContains(t, err, "failed to parse config file")
+
+	_, err = loadConfigFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorContains(t, err, "failed to read config file")
+
+	_, err = loadConfigFile(writeConfigFile(t, "jet.toml", ""))
+	require.EqualError(t, err, "unsupported config file format '.toml', only .json, .yaml and .yml files are supported")
+
+	cfg, err := loadConfigFile(writeConfigFile(t, "empty.yaml", ""))
+	require.NoError(t, err)
+	require.Equal(t, config.Config{}, cfg)
+}
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	filePath := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(filePath, []byte(content), 0600))
+	return filePath
+}
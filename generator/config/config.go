@@ -0,0 +1,449 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/generator/template"
+)
+
+// Config is generator configuration file content. Each part of the configuration maps onto the generator
+// template builder functions, and overrides only the properties that are set.
+type Config struct {
+	Model      Model      `json:"model"`
+	SQLBuilder SQLBuilder `json:"sqlBuilder"`
+}
+
+// Model is configuration of the model types generation
+type Model struct {
+	Skip bool   `json:"skip"`
+	Path string `json:"path"`
+	// Nullable is representation of the nullable column fields: pointer, sql-null, optional or zero
+	Nullable string `json:"nullable"`
+	// Tables, Views, Enums and Composites are keyed by database object name
+	Tables     map[string]TableModel `json:"tables"`
+	Views      map[string]TableModel `json:"views"`
+	Enums      map[string]EnumModel  `json:"enums"`
+	Composites map[string]TableModel `json:"composites"`
+	// Domains are keyed by domain name, and set the type of the table, view and composite type fields of the
+	// domain type (PostgreSQL only). Field configuration takes precedence over the domain configuration.
+	Domains map[string]DomainModel `json:"domains"`
+}
+
+// DomainModel is configuration of the go type of the domain fields. Pointer to the type is used for nullable
+// fields, unless the type is already a pointer.
+type DomainModel struct {
+	Type       string `json:"type"`
+	ImportPath string `json:"importPath"`
+}
+
+// TableModel is configuration of the table or view model type
+type TableModel struct {
+	Skip     bool   `json:"skip"`
+	FileName string `json:"fileName"`
+	TypeName string `json:"typeName"`
+	// Fields are keyed by column name
+	Fields map[string]TableModelField `json:"fields"`
+}
+
+// TableModelField is configuration of the model type field
+type TableModelField struct {
+	Skip bool   `json:"skip"`
+	Name string `json:"name"`
+	// Type is go type of the field, for instance 'decimal.Decimal' or '*decimal.Decimal', and ImportPath
+	// is the path of the package type is declared in.
+	Type       string   `json:"type"`
+	ImportPath string   `json:"importPath"`
+	Tags       []string `json:"tags"`
+}
+
+// EnumModel is configuration of the enum model type
+type EnumModel struct {
+	Skip     bool   `json:"skip"`
+	FileName string `json:"fileName"`
+	TypeName string `json:"typeName"`
+}
+
+// SQLBuilder is configuration of the SQL Builder types generation
+type SQLBuilder struct {
+	Skip bool   `json:"skip"`
+	Path string `json:"path"`
+	// TablePath, ViewPath, EnumPath and CompositePath are default paths of the table, view, enum and composite
+	// type SQL Builder packages
+	TablePath     string `json:"tablePath"`
+	ViewPath      string `json:"viewPath"`
+	EnumPath      string `json:"enumPath"`
+	CompositePath string `json:"compositePath"`
+	// Tables, Views, Enums and Composites are keyed by database object name
+	Tables     map[string]TableSQLBuilder     `json:"tables"`
+	Views      map[string]TableSQLBuilder     `json:"views"`
+	Enums      map[string]EnumSQLBuilder      `json:"enums"`
+	Composites map[string]CompositeSQLBuilder `json:"composites"`
+}
+
+// TableSQLBuilder is configuration of the table or view SQL Builder type
+type TableSQLBuilder struct {
+	Skip         bool   `json:"skip"`
+	Path         string `json:"path"`
+	FileName     string `json:"fileName"`
+	InstanceName string `json:"instanceName"`
+	TypeName     string `json:"typeName"`
+	DefaultAlias string `json:"defaultAlias"`
+	// Columns are keyed by column name
+	Columns map[string]TableSQLBuilderColumn `json:"columns"`
+}
+
+// TableSQLBuilderColumn is configuration of the SQL Builder type column
+type TableSQLBuilderColumn struct {
+	Skip bool   `json:"skip"`
+	Name string `json:"name"`
+}
+
+// EnumSQLBuilder is configuration of the enum SQL Builder type
+type EnumSQLBuilder struct {
+	Skip         bool   `json:"skip"`
+	Path         string `json:"path"`
+	FileName     string `json:"fileName"`
+	InstanceName string `json:"instanceName"`
+}
+
+// CompositeSQLBuilder is configuration of the composite type SQL Builder type
+type CompositeSQLBuilder struct {
+	Skip         bool   `json:"skip"`
+	Path         string `json:"path"`
+	FileName     string `json:"fileName"`
+	InstanceName string `json:"instanceName"`
+	TypeName     string `json:"typeName"`
+	// Fields are keyed by attribute name
+	Fields map[string]TableSQLBuilderColumn `json:"fields"`
+}
+
+// Load reads JSON configuration file. Unknown configuration properties are reported as an error.
+func Load(filePath string) (Config, error) {
+	data, err := os.ReadFile(filePath) // #nosec G304
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	config, err := Parse(data)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to parse config file %s: %w", filePath, err)
+	}
+
+	return config, nil
+}
+
+// Parse parses JSON configuration. Unknown configuration properties are reported as an error.
+func Parse(data []byte) (Config, error) {
+	var config Config
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(&config)
+	if err != nil && !errors.Is(err, io.EOF) { // io.EOF - empty file
+		return Config{}, err
+	}
+
+	return config, nil
+}
+
+// Apply returns new generator template with configuration overrides applied on top of the generatorTemplate
+func (c Config) Apply(generatorTemplate template.Template) template.Template {
+	schemaFunc := generatorTemplate.Schema
+
+	return generatorTemplate.UseSchema(func(schemaMetaData metadata.Schema) template.Schema {
+		schema := schemaFunc(schemaMetaData)
+
+		return schema.
+			UseModel(c.Model.apply(schema.Model)).
+			UseSQLBuilder(c.SQLBuilder.apply(schema.SQLBuilder))
+	})
+}
+
+func (m Model) apply(model template.Model) template.Model {
+	if m.Skip {
+		model = model.ShouldSkip(true)
+	}
+
+	if m.Path != "" {
+		model = model.UsePath(m.Path)
+	}
+
+	if m.Nullable != "" {
+		model = model.UseNullable(template.NullableStrategy(m.Nullable))
+	}
+
+	tableFunc, viewFunc, enumFunc, compositeFunc := model.Table, model.View, model.Enum, model.Composite
+
+	model = model.
+		UseTable(func(table metadata.Table) template.TableModel {
+			return m.Tables[table.Name].apply(m.applyDomains(tableFunc(table)))
+		}).
+		UseView(func(view metadata.Table) template.ViewModel {
+			return m.Views[view.Name].apply(m.applyDomains(viewFunc(view)))
+		}).
+		UseEnum(func(enum metadata.Enum) template.EnumModel {
+			return m.Enums[enum.Name].apply(enumFunc(enum))
+		})
+
+	if compositeFunc == nil {
+		return model
+	}
+
+	return model.UseComposite(func(composite metadata.Composite) template.CompositeModel {
+		compositeModel := compositeFunc(composite)
+		tableModel := m.Composites[composite.Name].apply(m.applyDomains(template.TableModel{
+			Skip:     compositeModel.Skip,
+			FileName: compositeModel.FileName,
+			TypeName: compositeModel.TypeName,
+			Field:    compositeModel.Field,
+		}))
+
+		compositeModel.Skip = tableModel.Skip
+		compositeModel.FileName = tableModel.FileName
+		compositeModel.TypeName = tableModel.TypeName
+		compositeModel.Field = tableModel.Field
+
+		return compositeModel
+	})
+}
+
+// applyDomains sets the configured domain types to the fields of the domain type
+func (m Model) applyDomains(tableModel template.TableModel) template.TableModel {
+	if len(m.Domains) == 0 || tableModel.Field == nil {
+		return tableModel
+	}
+
+	fieldFunc := tableModel.Field
+
+	return tableModel.UseField(func(columnMetaData metadata.Column) template.TableModelField {
+		field := fieldFunc(columnMetaData)
+
+		domain, ok := m.Domains[columnMetaData.DataType.Domain]
+		if !ok || columnMetaData.DataType.Domain == "" || domain.Type == "" {
+			return field
+		}
+
+		typeName := domain.Type
+		if columnMetaData.IsNullable && !strings.HasPrefix(typeName, "*") {
+			typeName = "*" + typeName
+		}
+
+		return field.UseType(template.Type{
+			ImportPath: domain.ImportPath,
+			Name:       typeName,
+		})
+	})
+}
+
+func (t TableModel) apply(tableModel template.TableModel) template.TableModel {
+	if t.Skip {
+		tableModel.Skip = true
+	}
+
+	if t.FileName != "" {
+		tableModel = tableModel.UseFileName(t.FileName)
+	}
+
+	if t.TypeName != "" {
+		tableModel = tableModel.UseTypeName(t.TypeName)
+	}
+
+	if len(t.Fields) == 0 || tableModel.Field == nil {
+		return tableModel
+	}
+
+	fieldFunc := tableModel.Field
+
+	return tableModel.UseField(func(columnMetaData metadata.Column) template.TableModelField {
+		return t.Fields[columnMetaData.Name].apply(fieldFunc(columnMetaData))
+	})
+}
+
+func (f TableModelField) apply(field template.TableModelField) template.TableModelField {
+	if f.Skip {
+		field.Skip = true
+	}
+
+	if f.Name != "" {
+		field = field.UseName(f.Name)
+	}
+
+	if f.Type != "" {
+		field = field.UseType(template.Type{
+			ImportPath: f.ImportPath,
+			Name:       f.Type,
+		})
+	}
+
+	return field.UseTags(f.Tags...)
+}
+
+func (e EnumModel) apply(enumModel template.EnumModel) template.EnumModel {
+	if e.Skip {
+		enumModel.Skip = true
+	}
+
+	if e.FileName != "" {
+		enumModel = enumModel.UseFileName(e.FileName)
+	}
+
+	if e.TypeName != "" {
+		enumModel = enumModel.UseTypeName(e.TypeName)
+	}
+
+	return enumModel
+}
+
+func (sb SQLBuilder) apply(sqlBuilder template.SQLBuilder) template.SQLBuilder {
+	if sb.Skip {
+		sqlBuilder = sqlBuilder.ShouldSkip(true)
+	}
+
+	if sb.Path != "" {
+		sqlBuilder = sqlBuilder.UsePath(sb.Path)
+	}
+
+	tableFunc, viewFunc, enumFunc, compositeFunc := sqlBuilder.Table, sqlBuilder.View, sqlBuilder.Enum, sqlBuilder.Composite
+
+	sqlBuilder = sqlBuilder.
+		UseTable(func(table metadata.Table) template.TableSQLBuilder {
+			tableSQLBuilder := tableFunc(table)
+			if sb.TablePath != "" {
+				tableSQLBuilder = tableSQLBuilder.UsePath(sb.TablePath)
+			}
+			return sb.Tables[table.Name].apply(tableSQLBuilder)
+		}).
+		UseView(func(view metadata.Table) template.ViewSQLBuilder {
+			viewSQLBuilder := viewFunc(view)
+			if sb.ViewPath != "" {
+				viewSQLBuilder = viewSQLBuilder.UsePath(sb.ViewPath)
+			}
+			return sb.Views[view.Name].apply(viewSQLBuilder)
+		}).
+		UseEnum(func(enum metadata.Enum) template.EnumSQLBuilder {
+			enumSQLBuilder := enumFunc(enum)
+			if sb.EnumPath != "" {
+				enumSQLBuilder = enumSQLBuilder.UsePath(sb.EnumPath)
+			}
+			return sb.Enums[enum.Name].apply(enumSQLBuilder)
+		})
+
+	if compositeFunc == nil {
+		return sqlBuilder
+	}
+
+	return sqlBuilder.UseComposite(func(composite metadata.Composite) template.CompositeSQLBuilder {
+		compositeSQLBuilder := compositeFunc(composite)
+		if sb.CompositePath != "" {
+			compositeSQLBuilder = compositeSQLBuilder.UsePath(sb.CompositePath)
+		}
+		return sb.Composites[composite.Name].apply(compositeSQLBuilder)
+	})
+}
+
+func (t TableSQLBuilder) apply(tableSQLBuilder template.TableSQLBuilder) template.TableSQLBuilder {
+	if t.Skip {
+		tableSQLBuilder.Skip = true
+	}
+
+	if t.Path != "" {
+		tableSQLBuilder = tableSQLBuilder.UsePath(t.Path)
+	}
+
+	if t.FileName != "" {
+		tableSQLBuilder = tableSQLBuilder.UseFileName(t.FileName)
+	}
+
+	if t.InstanceName != "" {
+		tableSQLBuilder = tableSQLBuilder.UseInstanceName(t.InstanceName)
+	}
+
+	if t.TypeName != "" {
+		tableSQLBuilder = tableSQLBuilder.UseTypeName(t.TypeName)
+	}
+
+	if t.DefaultAlias != "" {
+		tableSQLBuilder = tableSQLBuilder.UseDefaultAlias(t.DefaultAlias)
+	}
+
+	if len(t.Columns) == 0 || tableSQLBuilder.Column == nil {
+		return tableSQLBuilder
+	}
+
+	return tableSQLBuilder.UseColumn(applyColumns(t.Columns, tableSQLBuilder.Column))
+}
+
+func (c CompositeSQLBuilder) apply(compositeSQLBuilder template.CompositeSQLBuilder) template.CompositeSQLBuilder {
+	if c.Skip {
+		compositeSQLBuilder.Skip = true
+	}
+
+	if c.Path != "" {
+		compositeSQLBuilder = compositeSQLBuilder.UsePath(c.Path)
+	}
+
+	if c.FileName != "" {
+		compositeSQLBuilder = compositeSQLBuilder.UseFileName(c.FileName)
+	}
+
+	if c.InstanceName != "" {
+		compositeSQLBuilder = compositeSQLBuilder.UseInstanceName(c.InstanceName)
+	}
+
+	if c.TypeName != "" {
+		compositeSQLBuilder = compositeSQLBuilder.UseTypeName(c.TypeName)
+	}
+
+	if len(c.Fields) == 0 || compositeSQLBuilder.Field == nil {
+		return compositeSQLBuilder
+	}
+
+	return compositeSQLBuilder.UseField(applyColumns(c.Fields, compositeSQLBuilder.Field))
+}
+
+func applyColumns(columns map[string]TableSQLBuilderColumn,
+	columnFunc func(columnMetaData metadata.Column) template.TableSQLBuilderColumn) func(columnMetaData metadata.Column) template.TableSQLBuilderColumn {
+
+	return func(columnMetaData metadata.Column) template.TableSQLBuilderColumn {
+		column := columnFunc(columnMetaData)
+		columnConfig := columns[columnMetaData.Name]
+
+		if columnConfig.Skip {
+			column.Skip = true
+		}
+
+		if columnConfig.Name != "" {
+			column.Name = columnConfig.Name
+		}
+
+		return column
+	}
+}
+
+func (e EnumSQLBuilder) apply(enumSQLBuilder template.EnumSQLBuilder) template.EnumSQLBuilder {
+	if e.Skip {
+		enumSQLBuilder.Skip = true
+	}
+
+	if e.Path != "" {
+		enumSQLBuilder = enumSQLBuilder.UsePath(e.Path)
+	}
+
+	if e.FileName != "" {
+		enumSQLBuilder = enumSQLBuilder.UseFileName(e.FileName)
+	}
+
+	if e.InstanceName != "" {
+		enumSQLBuilder = enumSQLBuilder.UseInstanceName(e.InstanceName)
+	}
+
+	return enumSQLBuilder
+}
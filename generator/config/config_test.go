@@ -0,0 +1,224 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/generator/template"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+var filmTable = metadata.Table{
+	Name: "film",
+	Columns: []metadata.Column{
+		{Name: "film_id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "int4", Kind: metadata.BaseType}},
+		{Name: "price", DataType: metadata.DataType{Name: "numeric", Kind: metadata.BaseType}},
+		{Name: "secret", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+		{Name: "language_id", DataType: metadata.DataType{Name: "int2", Kind: metadata.BaseType}},
+	},
+}
+
+var ratingEnum = metadata.Enum{Name: "mpaa_rating", Values: []string{"G", "PG"}}
+
+const jsonConfig = `
+{
+  "model": {
+    "path": "entity",
+    "tables": {
+      "film": {
+        "typeName": "Movie",
+        "fields": {
+          "price": {"type": "decimal.Decimal", "importPath": "github.com/shopspring/decimal", "tags": ["json:\"price\""]},
+          "secret": {"skip": true}
+        }
+      }
+    },
+    "enums": {
+      "mpaa_rating": {"typeName": "Rating"}
+    }
+  },
+  "sqlBuilder": {
+    "tablePath": "tables",
+    "tables": {
+      "film": {
+        "instanceName": "Movies",
+        "defaultAlias": "m",
+        "columns": {
+          "secret": {"skip": true},
+          "language_id": {"name": "LangID"}
+        }
+      }
+    },
+    "enums": {
+      "mpaa_rating": {"skip": true}
+    }
+  }
+}
+`
+
+func writeConfigFile(t *testing.T, fileName, content string) string {
+	filePath := filepath.Join(t.TempDir(), fileName)
+	require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+	return filePath
+}
+
+func TestLoad(t *testing.T) {
+	config, err := Load(writeConfigFile(t, "jet.json", jsonConfig))
+	require.NoError(t, err)
+
+	require.Equal(t, "entity", config.Model.Path)
+	require.Equal(t, TableModelField{
+		Type:       "decimal.Decimal",
+		ImportPath: "github.com/shopspring/decimal",
+		Tags:       []string{`json:"price"`},
+	}, config.Model.Tables["film"].Fields["price"])
+}
+
+func TestLoadErrors(t *testing.T) {
+	_, err := Load(writeConfigFile(t, "jet.json", `{"model": {"pth": "entity"}}`))
+	require.ErrorContains(t, err, `unknown field "pth"`)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorContains(t, err, "failed to read config file")
+
+	config, err := Load(writeConfigFile(t, "empty.json", ""))
+	require.NoError(t, err)
+	require.Equal(t, Config{}, config)
+}
+
+func TestConfigApply(t *testing.T) {
+	config, err := Load(writeConfigFile(t, "jet.json", jsonConfig))
+	require.NoError(t, err)
+
+	schema := config.Apply(template.Default(postgres.Dialect)).Schema(metadata.Schema{Name: "public"})
+
+	require.Equal(t, "entity", schema.Model.Path)
+
+	filmModel := schema.Model.Table(filmTable)
+	require.Equal(t, "Movie", filmModel.TypeName)
+	require.Equal(t, "film", filmModel.FileName)
+	require.Equal(t, template.TableModelField{
+		Name: "Price",
+		Type: template.Type{ImportPath: "github.com/shopspring/decimal", Name: "decimal.Decimal"},
+		Tags: []string{`json:"price"`},
+	}, filmModel.Field(filmTable.Columns[1]))
+	require.True(t, filmModel.Field(filmTable.Columns[2]).Skip)
+	require.Equal(t, template.DefaultTableModelField(filmTable.Columns[0]), filmModel.Field(filmTable.Columns[0]))
+
+	require.Equal(t, "Rating", schema.Model.Enum(ratingEnum).TypeName)
+
+	filmSQLBuilder := schema.SQLBuilder.Table(filmTable)
+	require.Equal(t, "tables", filmSQLBuilder.Path)
+	require.Equal(t, "Movies", filmSQLBuilder.InstanceName)
+	require.Equal(t, "FilmTable", filmSQLBuilder.TypeName)
+	require.Equal(t, "m", filmSQLBuilder.DefaultAlias)
+	require.True(t, filmSQLBuilder.Column(filmTable.Columns[2]).Skip)
+	require.Equal(t, "LangID", filmSQLBuilder.Column(filmTable.Columns[3]).Name)
+	require.Equal(t, "FilmID", filmSQLBuilder.Column(filmTable.Columns[0]).Name)
+
+	require.True(t, schema.SQLBuilder.Enum(ratingEnum).Skip)
+
+	// objects without configuration keep the default template
+	actorTable := metadata.Table{Name: "actor"}
+	require.Equal(t, template.DefaultTableModel(actorTable).TypeName, schema.Model.Table(actorTable).TypeName)
+	require.Equal(t, "/view", schema.SQLBuilder.View(actorTable).Path)
+}
+
+func TestConfigApplyProcessSchema(t *testing.T) {
+	config, err := Load(writeConfigFile(t, "jet.json", jsonConfig))
+	require.NoError(t, err)
+
+	output := template.NewMemoryOutput()
+	generatorTemplate := config.Apply(template.Default(postgres.Dialect)).UseOutput(output)
+
+	err = template.ProcessSchema("gen", metadata.Schema{
+		Name:           "public",
+		TablesMetaData: []metadata.Table{filmTable},
+		EnumsMetaData:  []metadata.Enum{ratingEnum},
+	}, generatorTemplate)
+	require.NoError(t, err)
+
+	require.Len(t, output.Files, 4)
+	require.Contains(t, string(output.Files[filepath.Join("gen", "public", "entity", "film.go")]), `type Movie struct {
+	FilmID     int32           `+"`sql:\"primary_key\"`"+`
+	Price      decimal.Decimal `+"`json:\"price\"`"+`
+	LanguageID int16
+}`)
+	require.Contains(t, string(output.Files[filepath.Join("gen", "public", "tables", "film.go")]), `var Movies = newFilmTable("public", "film", "m")`)
+	require.Contains(t, output.Files, filepath.Join("gen", "public", "entity", "mpaa_rating.go"))
+	require.Contains(t, output.Files, filepath.Join("gen", "public", "tables", "table_use_schema.go"))
+}
+
+func TestConfigApplyCompositesAndDomains(t *testing.T) {
+	config, err := Parse([]byte(`
+{
+  "model": {
+    "domains": {
+      "email": {"type": "types.Email", "importPath": "example.com/types"}
+    },
+    "tables": {
+      "customer": {"fields": {"backup_email": {"type": "string"}}}
+    },
+    "composites": {
+      "address": {"typeName": "PostalAddress", "fields": {"city": {"name": "Town"}}}
+    }
+  },
+  "sqlBuilder": {
+    "compositePath": "types",
+    "composites": {
+      "address": {"typeName": "PostalAddressRow", "fields": {"city": {"name": "Town"}}}
+    }
+  }
+}
+`))
+	require.NoError(t, err)
+
+	email := metadata.DataType{Name: "text", Kind: metadata.BaseType, Domain: "email"}
+	customerTable := metadata.Table{
+		Name: "customer",
+		Columns: []metadata.Column{
+			{Name: "email", DataType: email},
+			{Name: "other_email", IsNullable: true, DataType: email},
+			{Name: "backup_email", DataType: email},
+		},
+	}
+	address := metadata.Composite{
+		Name: "address",
+		Attributes: []metadata.Column{
+			{Name: "city", IsNullable: true, DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			{Name: "email", IsNullable: true, DataType: email},
+		},
+	}
+
+	schema := config.Apply(template.Default(postgres.Dialect)).Schema(metadata.Schema{Name: "public"})
+
+	customerModel := schema.Model.Table(customerTable)
+	require.Equal(t, template.Type{Name: "types.Email", ImportPath: "example.com/types"}, customerModel.Field(customerTable.Columns[0]).Type)
+	require.Equal(t, template.Type{Name: "*types.Email", ImportPath: "example.com/types"}, customerModel.Field(customerTable.Columns[1]).Type)
+	require.Equal(t, template.Type{Name: "string"}, customerModel.Field(customerTable.Columns[2]).Type)
+
+	addressModel := schema.Model.Composite(address)
+	require.Equal(t, "PostalAddress", addressModel.TypeName)
+	require.Equal(t, "Town", addressModel.Field(address.Attributes[0]).Name)
+	require.Equal(t, "*types.Email", addressModel.Field(address.Attributes[1]).Type.Name)
+
+	addressSQLBuilder := schema.SQLBuilder.Composite(address)
+	require.Equal(t, "types", addressSQLBuilder.Path)
+	require.Equal(t, "PostalAddressRow", addressSQLBuilder.TypeName)
+	require.Equal(t, "Address", addressSQLBuilder.InstanceName)
+	require.Equal(t, "Town", addressSQLBuilder.Field(address.Attributes[0]).Name)
+}
+
+func TestConfigApplyNullable(t *testing.T) {
+	config, err := Parse([]byte(`{"model": {"nullable": "sql-null"}}`))
+	require.NoError(t, err)
+
+	schema := config.Apply(template.Default(postgres.Dialect)).Schema(metadata.Schema{Name: "public"})
+	require.Equal(t, template.NullableSQLNull, schema.Model.Nullable)
+
+	schema = Config{}.Apply(template.Default(postgres.Dialect)).Schema(metadata.Schema{Name: "public"})
+	require.Equal(t, template.NullablePointer, schema.Model.Nullable)
+}
@@ -0,0 +1,751 @@
+package ddl
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+)
+
+type tableKind int
+
+const (
+	baseTable tableKind = iota
+	view
+	materializedView
+)
+
+type table struct {
+	schema  string
+	name    string
+	comment string
+	kind    tableKind
+
+	columns          []*column
+	indexes          []*metadata.Index
+	foreignKeys      []*metadata.ForeignKey
+	checkConstraints []*metadata.CheckConstraint
+}
+
+type column struct {
+	name            string
+	comment         string
+	dataType        metadata.DataType
+	enumValues      []string // MySQL enum column values
+	isNullable      bool
+	hasDefault      bool
+	isGenerated     bool
+	isAutoIncrement bool // MySQL AUTO_INCREMENT column
+}
+
+type userType struct {
+	schema     string
+	name       string
+	comment    string
+	kind       metadata.DataTypeKind
+	values     []string           // enum values
+	baseType   *metadata.DataType // domain base type
+	attributes []*column          // composite type attributes
+}
+
+func (t *userType) findAttribute(name string) *column {
+	for _, attribute := range t.attributes {
+		if attribute.name == name {
+			return attribute
+		}
+	}
+
+	return nil
+}
+
+// catalog contains database objects created with DDL statements
+type catalog struct {
+	mysql         bool
+	currentSchema string
+
+	tables []*table // tables and views in creation order
+	types  []*userType
+}
+
+func newCatalog(mysql bool, currentSchema string) *catalog {
+	return &catalog{
+		mysql:         mysql,
+		currentSchema: currentSchema,
+	}
+}
+
+func (c *catalog) schemaOrCurrent(schema string) string {
+	if schema == "" {
+		return c.currentSchema
+	}
+
+	return schema
+}
+
+// sameName compares identifiers. MySQL identifiers are compared case-insensitive, and PostgreSQL unquoted
+// identifiers are already folded to lower case.
+func (c *catalog) sameName(name1, name2 string) bool {
+	if c.mysql {
+		return strings.EqualFold(name1, name2)
+	}
+
+	return name1 == name2
+}
+
+func (c *catalog) findTable(schema, name string) *table {
+	schema = c.schemaOrCurrent(schema)
+
+	for _, t := range c.tables {
+		if c.sameName(t.schema, schema) && c.sameName(t.name, name) {
+			return t
+		}
+	}
+
+	return nil
+}
+
+func (c *catalog) findType(schema, name string) *userType {
+	schema = c.schemaOrCurrent(schema)
+
+	for _, t := range c.types {
+		if c.sameName(t.schema, schema) && c.sameName(t.name, name) {
+			return t
+		}
+	}
+
+	// user types from other schemas are often used without schema qualifier (search_path)
+	for _, t := range c.types {
+		if c.sameName(t.name, name) {
+			return t
+		}
+	}
+
+	return nil
+}
+
+func (c *catalog) addTable(t *table, ifNotExists bool) error {
+	if c.findTable(t.schema, t.name) != nil {
+		if ifNotExists {
+			return nil
+		}
+		return fmt.Errorf("relation '%s' already exists", t.name)
+	}
+
+	c.tables = append(c.tables, t)
+
+	return nil
+}
+
+func (c *catalog) dropTable(schema, name string, ifExists bool) error {
+	t := c.findTable(schema, name)
+	if t == nil {
+		if ifExists {
+			return nil
+		}
+		return fmt.Errorf("relation '%s' does not exist", name)
+	}
+
+	c.tables = slices.DeleteFunc(c.tables, func(other *table) bool { return other == t })
+
+	// foreign keys referencing dropped table are dropped as well
+	for _, other := range c.tables {
+		other.foreignKeys = slices.DeleteFunc(other.foreignKeys, func(fk *metadata.ForeignKey) bool {
+			return c.sameName(fk.ReferencedSchema, t.schema) && c.sameName(fk.ReferencedTable, t.name)
+		})
+	}
+
+	return nil
+}
+
+func (c *catalog) renameTable(t *table, newName string) {
+	for _, other := range c.tables {
+		for _, fk := range other.foreignKeys {
+			if c.sameName(fk.ReferencedSchema, t.schema) && c.sameName(fk.ReferencedTable, t.name) {
+				fk.ReferencedTable = newName
+			}
+		}
+	}
+
+	t.name = newName
+}
+
+func (c *catalog) addType(t *userType, ifNotExists bool) error {
+	if existing := c.findType(t.schema, t.name); existing != nil && c.sameName(existing.schema, t.schema) {
+		if ifNotExists {
+			return nil
+		}
+		return fmt.Errorf("type '%s' already exists", t.name)
+	}
+
+	c.types = append(c.types, t)
+
+	return nil
+}
+
+func (c *catalog) dropType(schema, name string, ifExists bool) error {
+	t := c.findType(schema, name)
+	if t == nil {
+		if ifExists {
+			return nil
+		}
+		return fmt.Errorf("type '%s' does not exist", name)
+	}
+
+	c.types = slices.DeleteFunc(c.types, func(other *userType) bool { return other == t })
+
+	return nil
+}
+
+// findIndex returns index or constraint with the name from any table of the schema
+func (c *catalog) findIndex(schema, name string) (*table, *metadata.Index) {
+	schema = c.schemaOrCurrent(schema)
+
+	for _, t := range c.tables {
+		if !c.sameName(t.schema, schema) {
+			continue
+		}
+
+		for _, index := range t.indexes {
+			if c.sameName(index.Name, name) {
+				return t, index
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// isRelationNameUsed returns true if the name is already used by table, view or index in the schema (PostgreSQL)
+func (c *catalog) isRelationNameUsed(schema, name string) bool {
+	if c.findTable(schema, name) != nil {
+		return true
+	}
+
+	_, index := c.findIndex(schema, name)
+
+	return index != nil
+}
+
+// isConstraintNameUsed returns true if the name is used by any table constraint in the schema
+func (c *catalog) isConstraintNameUsed(schema, name string) bool {
+	for _, t := range c.tables {
+		if c.sameName(t.schema, schema) && t.isConstraintNameUsed(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *table) findColumn(name string, mysql bool) *column {
+	for _, col := range t.columns {
+		if col.name == name || mysql && strings.EqualFold(col.name, name) {
+			return col
+		}
+	}
+
+	return nil
+}
+
+func (t *table) primaryKey() *metadata.Index {
+	for _, index := range t.indexes {
+		if index.IsPrimary {
+			return index
+		}
+	}
+
+	return nil
+}
+
+func (t *table) isConstraintNameUsed(name string) bool {
+	for _, index := range t.indexes {
+		if index.IsConstraint && strings.EqualFold(index.Name, name) {
+			return true
+		}
+	}
+
+	for _, fk := range t.foreignKeys {
+		if strings.EqualFold(fk.Name, name) {
+			return true
+		}
+	}
+
+	for _, check := range t.checkConstraints {
+		if strings.EqualFold(check.Name, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dropConstraint drops primary key, unique, foreign key or check constraint with the name
+func (t *table) dropConstraint(name string) bool {
+	dropped := false
+
+	t.indexes = slices.DeleteFunc(t.indexes, func(index *metadata.Index) bool {
+		if index.IsConstraint && strings.EqualFold(index.Name, name) {
+			dropped = true
+		}
+		return index.IsConstraint && strings.EqualFold(index.Name, name)
+	})
+
+	t.foreignKeys = slices.DeleteFunc(t.foreignKeys, func(fk *metadata.ForeignKey) bool {
+		if strings.EqualFold(fk.Name, name) {
+			dropped = true
+		}
+		return strings.EqualFold(fk.Name, name)
+	})
+
+	t.checkConstraints = slices.DeleteFunc(t.checkConstraints, func(check *metadata.CheckConstraint) bool {
+		if strings.EqualFold(check.Name, name) {
+			dropped = true
+		}
+		return strings.EqualFold(check.Name, name)
+	})
+
+	return dropped
+}
+
+// dropColumn drops the column, and indexes and constraints containing the column
+func (c *catalog) dropColumn(t *table, col *column) {
+	t.columns = slices.DeleteFunc(t.columns, func(other *column) bool { return other == col })
+
+	t.indexes = slices.DeleteFunc(t.indexes, func(index *metadata.Index) bool {
+		return slices.ContainsFunc(index.Columns, func(name string) bool { return c.sameName(name, col.name) })
+	})
+
+	t.foreignKeys = slices.DeleteFunc(t.foreignKeys, func(fk *metadata.ForeignKey) bool {
+		return slices.ContainsFunc(fk.Columns, func(name string) bool { return c.sameName(name, col.name) })
+	})
+
+	t.checkConstraints = slices.DeleteFunc(t.checkConstraints, func(check *metadata.CheckConstraint) bool {
+		return referencesIdentifier(check.Expression, col.name)
+	})
+
+	for _, other := range c.tables {
+		other.foreignKeys = slices.DeleteFunc(other.foreignKeys, func(fk *metadata.ForeignKey) bool {
+			return c.sameName(fk.ReferencedSchema, t.schema) && c.sameName(fk.ReferencedTable, t.name) &&
+				slices.ContainsFunc(fk.ReferencedColumns, func(name string) bool { return c.sameName(name, col.name) })
+		})
+	}
+}
+
+// renameColumn renames the column, and updates indexes and constraints referencing the column
+func (c *catalog) renameColumn(t *table, col *column, newName string) {
+	rename := func(names []string) {
+		for i, name := range names {
+			if c.sameName(name, col.name) {
+				names[i] = newName
+			}
+		}
+	}
+
+	for _, index := range t.indexes {
+		rename(index.Columns)
+	}
+
+	for _, fk := range t.foreignKeys {
+		rename(fk.Columns)
+	}
+
+	for _, check := range t.checkConstraints {
+		check.Expression = replaceIdentifier(check.Expression, col.name, newName)
+	}
+
+	for _, other := range c.tables {
+		for _, fk := range other.foreignKeys {
+			if c.sameName(fk.ReferencedSchema, t.schema) && c.sameName(fk.ReferencedTable, t.name) {
+				rename(fk.ReferencedColumns)
+			}
+		}
+	}
+
+	col.name = newName
+}
+
+func (c *catalog) renameConstraint(t *table, oldName, newName string) {
+	for _, index := range t.indexes {
+		if index.IsConstraint && c.sameName(index.Name, oldName) {
+			index.Name = newName
+		}
+	}
+
+	for _, fk := range t.foreignKeys {
+		if c.sameName(fk.Name, oldName) {
+			fk.Name = newName
+		}
+	}
+
+	for _, check := range t.checkConstraints {
+		if c.sameName(check.Name, oldName) {
+			check.Name = newName
+		}
+	}
+}
+
+func (c *catalog) renameType(userType *userType, newName string) {
+	var columns []*column
+
+	for _, t := range c.tables {
+		columns = append(columns, t.columns...)
+	}
+
+	for _, t := range c.types {
+		columns = append(columns, t.attributes...)
+	}
+
+	for _, col := range columns {
+		if col.dataType.Name == userType.name && col.dataType.Kind == userType.kind && userType.kind != metadata.BaseType &&
+			(col.dataType.Schema == "" || col.dataType.Schema == userType.schema) {
+			col.dataType.Name = newName
+		}
+
+		if col.dataType.Domain == userType.name && userType.baseType != nil {
+			col.dataType.Domain = newName
+		}
+	}
+
+	userType.name = newName
+}
+
+func (c *catalog) addPrimaryKey(t *table, name string, columns []string) error {
+	if t.primaryKey() != nil {
+		return fmt.Errorf("multiple primary keys for table '%s' are not allowed", t.name)
+	}
+
+	switch {
+	case c.mysql:
+		name = "PRIMARY"
+	case name == "":
+		name = c.postgresObjectName(t, nil, "pkey")
+	}
+
+	for _, columnName := range columns {
+		if col := t.findColumn(columnName, c.mysql); col != nil {
+			col.isNullable = false
+		}
+	}
+
+	t.indexes = append(t.indexes, &metadata.Index{
+		Name:         name,
+		IsPrimary:    true,
+		IsUnique:     true,
+		IsConstraint: true,
+		Columns:      columns,
+	})
+
+	return nil
+}
+
+// addIndex adds index to the table. Index is constraint if it is created with PRIMARY KEY or UNIQUE constraint.
+// All MySQL unique indexes are reported as constraints.
+func (c *catalog) addIndex(t *table, name string, unique, constraint bool, predicate string, columns []string) error {
+	switch {
+	case c.mysql:
+		constraint = unique
+		if name == "" {
+			name = mysqlIndexName(t, columns[0])
+		}
+	case name == "" && constraint:
+		name = c.postgresObjectName(t, columns, "key")
+	case name == "":
+		name = c.postgresObjectName(t, columns, "idx")
+	}
+
+	if c.mysql && slices.ContainsFunc(t.indexes, func(index *metadata.Index) bool { return strings.EqualFold(index.Name, name) }) ||
+		!c.mysql && c.isRelationNameUsed(t.schema, name) {
+		return fmt.Errorf("relation '%s' already exists", name)
+	}
+
+	t.indexes = append(t.indexes, &metadata.Index{
+		Name:         name,
+		IsUnique:     unique,
+		IsConstraint: constraint,
+		Predicate:    predicate,
+		Columns:      columns,
+	})
+
+	return nil
+}
+
+// addCheckConstraint adds check constraint to the table. Column is set for column check constraints.
+func (c *catalog) addCheckConstraint(t *table, name, expression, column string) error {
+	switch {
+	case name != "":
+	case c.mysql:
+		name = mysqlConstraintName(t, "chk")
+	case column != "":
+		name = c.postgresObjectName(t, []string{column}, "check")
+	default:
+		name = c.postgresObjectName(t, firstReferencedColumn(t, expression), "check")
+	}
+
+	t.checkConstraints = append(t.checkConstraints, &metadata.CheckConstraint{
+		Name:       name,
+		Expression: expression,
+	})
+
+	return nil
+}
+
+// addForeignKey adds foreign key to the table. MySQL requires index on foreign key columns, and if there is no such
+// index, it is created with the constraint name, index name or the first column name.
+func (c *catalog) addForeignKey(t *table, foreignKey *metadata.ForeignKey, indexName string) error {
+	if c.mysql {
+		hasIndex := slices.ContainsFunc(t.indexes, func(index *metadata.Index) bool {
+			return len(index.Columns) >= len(foreignKey.Columns) &&
+				slices.EqualFunc(index.Columns[:len(foreignKey.Columns)], foreignKey.Columns, strings.EqualFold)
+		})
+
+		if !hasIndex {
+			if foreignKey.Name != "" {
+				indexName = foreignKey.Name
+			}
+
+			if err := c.addIndex(t, indexName, false, false, "", slices.Clone(foreignKey.Columns)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if foreignKey.Name == "" {
+		if c.mysql {
+			foreignKey.Name = mysqlConstraintName(t, "ibfk")
+		} else {
+			foreignKey.Name = c.postgresObjectName(t, foreignKey.Columns, "fkey")
+		}
+	}
+
+	t.foreignKeys = append(t.foreignKeys, foreignKey)
+
+	return nil
+}
+
+// firstReferencedColumn returns the table column referenced first in the expression
+func firstReferencedColumn(t *table, expression string) []string {
+	var (
+		ret      []string
+		position = len(expression) + 1
+	)
+
+	for _, col := range t.columns {
+		if match := identifierRegexp(col.name).FindStringIndex(expression); match != nil && match[0] < position {
+			position = match[0]
+			ret = []string{col.name}
+		}
+	}
+
+	return ret
+}
+
+func identifierRegexp(name string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)(^|[^\w'])(["` + "`" + `]?)` + regexp.QuoteMeta(name) + `(["` + "`" + `]?)($|[^\w'])`)
+}
+
+func referencesIdentifier(expression, name string) bool {
+	return identifierRegexp(name).MatchString(expression)
+}
+
+func replaceIdentifier(expression, name, newName string) string {
+	return identifierRegexp(name).ReplaceAllString(expression, "${1}${2}"+newName+"${3}${4}")
+}
+
+// schemaNames returns sorted names of the schemas with at least one table, view or type
+func (c *catalog) schemaNames() []string {
+	var ret []string
+
+	for _, t := range c.tables {
+		ret = append(ret, t.schema)
+	}
+
+	for _, t := range c.types {
+		ret = append(ret, t.schema)
+	}
+
+	slices.Sort(ret)
+
+	return slices.Compact(ret)
+}
+
+// schema returns metadata of the schema objects, in the same order and format as metadata.GetSchema
+// returns for the live database.
+func (c *catalog) schema(schemaName string) metadata.Schema {
+	ret := metadata.Schema{
+		Name: schemaName,
+	}
+
+	var tables, views, materializedViews []*table
+
+	for _, t := range c.tables {
+		if !c.sameName(t.schema, schemaName) {
+			continue
+		}
+
+		switch t.kind {
+		case baseTable:
+			tables = append(tables, t)
+		case view:
+			views = append(views, t)
+		case materializedView:
+			materializedViews = append(materializedViews, t)
+		}
+	}
+
+	tables = c.sortedByName(tables)
+	views = c.sortedByName(views)
+	materializedViews = c.sortedByName(materializedViews)
+
+	for _, t := range tables {
+		ret.TablesMetaData = append(ret.TablesMetaData, c.tableMetaData(t))
+	}
+
+	for _, t := range append(views, materializedViews...) {
+		ret.ViewsMetaData = append(ret.ViewsMetaData, c.tableMetaData(t))
+	}
+
+	ret.EnumsMetaData = c.enumsMetaData(schemaName, append(tables, views...))
+
+	for _, t := range sortedByName(c, c.types, func(t *userType) string { return t.name }) {
+		if t.kind != metadata.CompositeType || !c.sameName(t.schema, schemaName) {
+			continue
+		}
+
+		composite := metadata.Composite{
+			Name:    t.name,
+			Comment: t.comment,
+		}
+
+		for _, attribute := range t.attributes {
+			composite.Attributes = append(composite.Attributes, metadata.Column{
+				Name:       attribute.name,
+				IsNullable: true,
+				DataType:   attribute.dataType,
+				Comment:    attribute.comment,
+			})
+		}
+
+		ret.CompositesMetaData = append(ret.CompositesMetaData, composite)
+	}
+
+	return ret
+}
+
+func (c *catalog) tableMetaData(t *table) metadata.Table {
+	ret := metadata.Table{
+		Name:    t.name,
+		Comment: t.comment,
+	}
+
+	if c.mysql || t.kind == materializedView {
+		ret.Comment = "" // comments are not retrieved for MySQL tables and PostgreSQL materialized views
+	}
+
+	var primaryKeyColumns []string
+	if primaryKey := t.primaryKey(); primaryKey != nil {
+		primaryKeyColumns = primaryKey.Columns
+	}
+
+	for _, col := range t.columns {
+		dataType := col.dataType
+
+		if col.enumValues != nil {
+			dataType.Name = t.name + "_" + col.name
+			dataType.Kind = metadata.EnumType
+		}
+
+		ret.Columns = append(ret.Columns, metadata.Column{
+			Name:            col.name,
+			IsPrimaryKey:    slices.ContainsFunc(primaryKeyColumns, func(name string) bool { return c.sameName(name, col.name) }),
+			IsNullable:      col.isNullable,
+			IsGenerated:     col.isGenerated,
+			HasDefault:      col.hasDefault,
+			IsAutoIncrement: col.isAutoIncrement,
+			DataType:        dataType,
+			Comment:         col.comment,
+		})
+	}
+
+	if t.kind != baseTable {
+		return ret
+	}
+
+	for _, fk := range sortedByName(c, t.foreignKeys, func(fk *metadata.ForeignKey) string { return fk.Name }) {
+		foreignKey := *fk
+		foreignKey.Columns = slices.Clone(fk.Columns)
+		foreignKey.ReferencedColumns = slices.Clone(fk.ReferencedColumns)
+
+		if len(foreignKey.ReferencedColumns) == 0 { // references primary key
+			if referencedTable := c.findTable(fk.ReferencedSchema, fk.ReferencedTable); referencedTable != nil && referencedTable.primaryKey() != nil {
+				foreignKey.ReferencedColumns = slices.Clone(referencedTable.primaryKey().Columns)
+			}
+		}
+
+		ret.ForeignKeys = append(ret.ForeignKeys, foreignKey)
+	}
+
+	for _, index := range sortedByName(c, t.indexes, func(index *metadata.Index) string { return index.Name }) {
+		indexCopy := *index
+		indexCopy.Columns = slices.Clone(index.Columns)
+		ret.Indexes = append(ret.Indexes, indexCopy)
+	}
+
+	for _, check := range sortedByName(c, t.checkConstraints, func(check *metadata.CheckConstraint) string { return check.Name }) {
+		ret.CheckConstraints = append(ret.CheckConstraints, metadata.NewCheckConstraint(check.Name, check.Expression, ret.Columns))
+	}
+
+	return ret
+}
+
+func (c *catalog) enumsMetaData(schemaName string, tables []*table) []metadata.Enum {
+	var ret []metadata.Enum
+
+	if c.mysql {
+		for _, t := range tables {
+			for _, col := range t.columns {
+				if col.enumValues != nil {
+					ret = append(ret, metadata.Enum{
+						Name:   t.name + "_" + col.name,
+						Values: slices.Clone(col.enumValues),
+					})
+				}
+			}
+		}
+
+		return ret
+	}
+
+	for _, t := range sortedByName(c, c.types, func(t *userType) string { return t.name }) {
+		if t.kind == metadata.EnumType && c.sameName(t.schema, schemaName) {
+			ret = append(ret, metadata.Enum{
+				Name:    t.name,
+				Comment: t.comment,
+				Values:  slices.Clone(t.values),
+			})
+		}
+	}
+
+	return ret
+}
+
+func (c *catalog) sortedByName(tables []*table) []*table {
+	return sortedByName(c, tables, func(t *table) string { return t.name })
+}
+
+// sortedByName returns the list sorted by name, in the same order as database metadata queries return it.
+// MySQL information schema names are sorted case-insensitive.
+func sortedByName[T any](c *catalog, list []T, name func(T) string) []T {
+	ret := slices.Clone(list)
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		if c.mysql {
+			return strings.ToLower(name(ret[i])) < strings.ToLower(name(ret[j]))
+		}
+		return name(ret[i]) < name(ret[j])
+	})
+
+	return ret
+}
@@ -0,0 +1,106 @@
+package ddl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// GetSchema parses DDL statements of the sql script (schema dump or list of migrations), and returns schema
+// metadata in the same format as metadata.GetSchema retrieves it from the live database. Supported dialects are
+// PostgreSQL and MySQL. For MySQL databases, schemaName is the database name.
+//
+// Statements that do not change tables, views, indexes, constraints or types are ignored.
+func GetSchema(dialect jet.Dialect, schemaName string, sqlScript string) (metadata.Schema, error) {
+	schemaCatalog, err := parseScript(dialect, schemaName, sqlScript)
+	if err != nil {
+		return metadata.Schema{}, err
+	}
+
+	ret := schemaCatalog.schema(schemaName)
+
+	printFound(ret)
+
+	return ret, nil
+}
+
+// GetSchemas parses DDL statements of the sql script in the same way as GetSchema, and returns metadata of
+// the schemas selected with comma-separated list of schema names and glob patterns (see metadata.MatchSchemaNames).
+// If more than one schema is listed, unqualified objects are created in the 'public' schema, unless the search
+// path is set by the script.
+func GetSchemas(dialect jet.Dialect, schemaList string, sqlScript string) ([]metadata.Schema, error) {
+	currentSchema := "public"
+	if !strings.ContainsAny(schemaList, ",*?[\\") { // single schema name
+		currentSchema = strings.TrimSpace(schemaList)
+	}
+
+	schemaCatalog, err := parseScript(dialect, currentSchema, sqlScript)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []metadata.Schema
+
+	for _, schemaName := range metadata.MatchSchemaNames(schemaList, schemaCatalog.schemaNames()) {
+		fmt.Printf("Schema %s:\n", schemaName)
+		schema := schemaCatalog.schema(schemaName)
+		printFound(schema)
+		ret = append(ret, schema)
+	}
+
+	return ret, nil
+}
+
+func parseScript(dialect jet.Dialect, currentSchema string, sqlScript string) (*catalog, error) {
+	var mysql bool
+
+	switch dialect.Name() {
+	case "PostgreSQL":
+	case "MySQL":
+		mysql = true
+	default:
+		return nil, fmt.Errorf("unsupported dialect: %s", dialect.Name())
+	}
+
+	statements, err := splitStatements(sqlScript, mysql)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sql script: %w", err)
+	}
+
+	schemaCatalog := newCatalog(mysql, currentSchema)
+
+	for _, stmt := range statements {
+		if err := newParser(schemaCatalog, stmt).parseStatement(); err != nil {
+			return nil, fmt.Errorf("failed to parse statement '%s': %w", statementSummary(stmt), err)
+		}
+	}
+
+	return schemaCatalog, nil
+}
+
+func printFound(schema metadata.Schema) {
+	found := fmt.Sprint("	FOUND ", len(schema.TablesMetaData), " table(s), ", len(schema.ViewsMetaData), " view(s), ",
+		len(schema.EnumsMetaData), " enum(s)")
+
+	if len(schema.CompositesMetaData) > 0 {
+		found += fmt.Sprint(", ", len(schema.CompositesMetaData), " composite type(s)")
+	}
+
+	fmt.Println(found)
+}
+
+// statementSummary returns the beginning of the statement, used in error messages
+func statementSummary(stmt statement) string {
+	const maxLength = 80
+
+	start := stmt.tokens[0].start
+	end := stmt.tokens[len(stmt.tokens)-1].end
+
+	if end-start > maxLength {
+		return stmt.text[start:start+maxLength] + "..."
+	}
+
+	return stmt.text[start:end]
+}
@@ -0,0 +1,412 @@
+package ddl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStatements(t *testing.T) {
+	statements, err := splitStatements(`
+-- comment; with semicolon
+CREATE TABLE a (b text DEFAULT 'x;y'); /* block ; comment */
+CREATE FUNCTION f() RETURNS int AS $body$ SELECT 1; $body$ LANGUAGE sql;
+`, false)
+	require.NoError(t, err)
+	require.Len(t, statements, 2)
+	require.Equal(t, "x;y", statements[0].tokens[7].text)
+	require.Equal(t, " SELECT 1; ", statements[1].tokens[8].text)
+
+	statements, err = splitStatements(`
+# mysql comment
+DELIMITER $$
+CREATE PROCEDURE p() BEGIN SELECT 'it\'s'; END$$
+DELIMITER ;
+CREATE TABLE a (b int);
+`, true)
+	require.NoError(t, err)
+	require.Len(t, statements, 2)
+	require.Equal(t, "it's", statements[0].tokens[7].text)
+
+	_, err = splitStatements(`CREATE TABLE a (b text DEFAULT 'x);`, false)
+	require.Error(t, err)
+}
+
+const postgresSchema = `
+SET search_path = "$user", public;
+
+CREATE TYPE mood AS ENUM ('sad', 'happy');
+ALTER TYPE mood ADD VALUE 'ok' BEFORE 'happy';
+COMMENT ON TYPE mood IS 'Current mood';
+CREATE DOMAIN year AS integer CHECK (VALUE >= 1901);
+
+CREATE TABLE language (
+    language_id serial PRIMARY KEY,
+    name character(20) NOT NULL,
+    last_update timestamp without time zone DEFAULT now() NOT NULL
+);
+
+CREATE TABLE public.film (
+    film_id integer GENERATED ALWAYS AS IDENTITY,
+    title varchar(255) NOT NULL,
+    release_year year,
+    language_id smallint NOT NULL REFERENCES language,
+    rental_rate numeric(4,2) DEFAULT 4.99 NOT NULL CHECK (rental_rate > 0),
+    tags text[],
+    matrix int[][],
+    mood mood DEFAULT NULL,
+    during tstzrange,
+    "Score" double precision,
+    search tsvector GENERATED ALWAYS AS (to_tsvector('english', title)) STORED,
+    CONSTRAINT film_pkey PRIMARY KEY (film_id),
+    UNIQUE (title, release_year)
+);
+
+COMMENT ON TABLE film IS 'Films';
+COMMENT ON COLUMN public.film.title IS 'Film title';
+
+CREATE UNIQUE INDEX film_title_idx ON film (lower(title)) WHERE release_year IS NOT NULL;
+CREATE INDEX ON film USING gin (tags);
+
+ALTER TABLE ONLY film ADD COLUMN original_language_id smallint;
+ALTER TABLE film ADD CONSTRAINT film_original_language_fkey FOREIGN KEY (original_language_id) REFERENCES language (language_id) ON DELETE SET NULL;
+ALTER TABLE film RENAME COLUMN "Score" TO score;
+ALTER TABLE film ALTER COLUMN score SET NOT NULL, ALTER COLUMN tags SET DEFAULT '{}'::text[];
+ALTER TABLE film DROP COLUMN matrix;
+
+CREATE TABLE temp (id int);
+DROP TABLE IF EXISTS temp, missing CASCADE;
+
+CREATE VIEW film_list AS
+SELECT f.film_id AS fid, f.title, l.name AS language, f.rental_rate::float8, count(*) AS total, 'x' AS label
+FROM film f
+    LEFT JOIN language l ON l.language_id = f.language_id
+GROUP BY f.film_id, f.title, l.name;
+
+CREATE MATERIALIZED VIEW language_list AS SELECT * FROM language;
+
+CREATE SCHEMA other;
+CREATE TABLE other.film (id int);
+INSERT INTO language (name) VALUES ('English');
+`
+
+func TestGetSchemaPostgres(t *testing.T) {
+	schema, err := GetSchema(postgres.Dialect, "public", postgresSchema)
+	require.NoError(t, err)
+
+	require.Equal(t, "public", schema.Name)
+	require.Len(t, schema.TablesMetaData, 2)
+	require.Equal(t, []metadata.Enum{{Name: "mood", Comment: "Current mood", Values: []string{"sad", "ok", "happy"}}}, schema.EnumsMetaData)
+
+	film := schema.TablesMetaData[0]
+	require.Equal(t, "film", film.Name)
+	require.Equal(t, "Films", film.Comment)
+
+	base := func(name string) metadata.DataType {
+		return metadata.DataType{Name: name, Kind: metadata.BaseType, SourceDialect: "PostgreSQL"}
+	}
+
+	require.Equal(t, []metadata.Column{
+		{Name: "film_id", IsPrimaryKey: true, DataType: base("int4")},
+		{Name: "title", DataType: base("varchar"), Comment: "Film title"},
+		{Name: "release_year", IsNullable: true, DataType: metadata.DataType{Name: "int4", Kind: metadata.BaseType, Domain: "year", SourceDialect: "PostgreSQL"}},
+		{Name: "language_id", DataType: base("int2")},
+		{Name: "rental_rate", HasDefault: true, DataType: base("numeric")},
+		{Name: "tags", IsNullable: true, HasDefault: true, DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType, Dimensions: 1, SourceDialect: "PostgreSQL"}},
+		{Name: "mood", IsNullable: true, DataType: metadata.DataType{Name: "mood", Kind: metadata.EnumType, Schema: "public", SourceDialect: "PostgreSQL"}},
+		{Name: "during", IsNullable: true, DataType: metadata.DataType{Name: "tstzrange", Kind: metadata.RangeType, SourceDialect: "PostgreSQL"}},
+		{Name: "score", DataType: base("float8")},
+		{Name: "search", IsNullable: true, IsGenerated: true, HasDefault: true, DataType: base("tsvector")},
+		{Name: "original_language_id", IsNullable: true, DataType: base("int2")},
+	}, film.Columns)
+
+	require.Equal(t, []metadata.ForeignKey{
+		{
+			Name:              "film_language_id_fkey",
+			Columns:           []string{"language_id"},
+			ReferencedSchema:  "public",
+			ReferencedTable:   "language",
+			ReferencedColumns: []string{"language_id"},
+		},
+		{
+			Name:              "film_original_language_fkey",
+			Columns:           []string{"original_language_id"},
+			ReferencedSchema:  "public",
+			ReferencedTable:   "language",
+			ReferencedColumns: []string{"language_id"},
+		},
+	}, film.ForeignKeys)
+
+	require.Equal(t, []metadata.Index{
+		{Name: "film_pkey", IsPrimary: true, IsUnique: true, IsConstraint: true, Columns: []string{"film_id"}},
+		{Name: "film_tags_idx", Columns: []string{"tags"}},
+		{Name: "film_title_idx", IsUnique: true, Predicate: "release_year IS NOT NULL", Columns: []string{"lower(title)"}},
+		{Name: "film_title_release_year_key", IsUnique: true, IsConstraint: true, Columns: []string{"title", "release_year"}},
+	}, film.Indexes)
+
+	require.Equal(t, []metadata.CheckConstraint{
+		{Name: "film_rental_rate_check", Expression: "rental_rate > 0", Columns: []string{"rental_rate"}},
+	}, film.CheckConstraints)
+
+	language := schema.TablesMetaData[1]
+	require.Equal(t, metadata.Column{Name: "language_id", IsPrimaryKey: true, HasDefault: true, DataType: base("int4")}, language.Columns[0])
+	require.Equal(t, "bpchar", language.Columns[1].DataType.Name)
+
+	require.Len(t, schema.ViewsMetaData, 2)
+
+	filmList := schema.ViewsMetaData[0]
+	require.Equal(t, "film_list", filmList.Name)
+	require.Equal(t, []metadata.Column{
+		{Name: "fid", IsNullable: true, DataType: base("int4")},
+		{Name: "title", IsNullable: true, DataType: base("varchar")},
+		{Name: "language", IsNullable: true, DataType: base("bpchar")},
+		{Name: "rental_rate", IsNullable: true, DataType: base("float8")},
+		{Name: "total", IsNullable: true, DataType: base("int8")},
+		{Name: "label", IsNullable: true, DataType: base("text")},
+	}, filmList.Columns)
+	require.Nil(t, filmList.Indexes)
+
+	require.Equal(t, "language_list", schema.ViewsMetaData[1].Name)
+	require.Len(t, schema.ViewsMetaData[1].Columns, 3)
+
+	otherSchema, err := GetSchema(postgres.Dialect, "other", postgresSchema)
+	require.NoError(t, err)
+	require.Len(t, otherSchema.TablesMetaData, 1)
+	require.Empty(t, otherSchema.EnumsMetaData)
+}
+
+func TestGetSchemaPostgresErrors(t *testing.T) {
+	_, err := GetSchema(postgres.Dialect, "public", `CREATE TABLE a (id int); CREATE TABLE a (id int);`)
+	require.EqualError(t, err, "failed to parse statement 'CREATE TABLE a (id int)': relation 'a' already exists")
+
+	_, err = GetSchema(postgres.Dialect, "public", `ALTER TABLE a ADD COLUMN b int;`)
+	require.EqualError(t, err, "failed to parse statement 'ALTER TABLE a ADD COLUMN b int': relation 'a' does not exist")
+
+	_, err = GetSchema(postgres.Dialect, "public", `CREATE TABLE a (id int PRIMARY KEY, b int PRIMARY KEY);`)
+	require.EqualError(t, err, "failed to parse statement 'CREATE TABLE a (id int PRIMARY KEY, b int PRIMARY KEY)': "+
+		"multiple primary keys for table 'a' are not allowed")
+
+	_, err = GetSchema(postgres.Dialect, "public", `CREATE TABLE a (id int PRIMARY KEY EXTRA);`)
+	require.EqualError(t, err, "failed to parse statement 'CREATE TABLE a (id int PRIMARY KEY EXTRA)': "+
+		"unexpected 'EXTRA', expected column constraint")
+}
+
+func TestGetSchemasPostgres(t *testing.T) {
+	script := `
+CREATE SCHEMA sales_eu;
+CREATE SCHEMA sales_us;
+CREATE TYPE mood AS ENUM ('sad', 'happy');
+CREATE TABLE customer (id serial PRIMARY KEY, mood mood);
+CREATE TABLE sales_eu.orders (id serial PRIMARY KEY, customer_id int REFERENCES public.customer (id), mood public.mood NOT NULL);
+CREATE TABLE sales_us.orders (id serial PRIMARY KEY);
+`
+	schemas, err := GetSchemas(postgres.Dialect, "public,sales_*", script)
+	require.NoError(t, err)
+	require.Len(t, schemas, 3)
+	require.Equal(t, "public", schemas[0].Name)
+	require.Equal(t, "sales_eu", schemas[1].Name)
+	require.Equal(t, "sales_us", schemas[2].Name)
+
+	orders := schemas[1].TablesMetaData[0]
+	require.Equal(t, metadata.DataType{Name: "mood", Kind: metadata.EnumType, Schema: "public", SourceDialect: "PostgreSQL"}, orders.Columns[2].DataType)
+	require.Equal(t, []metadata.ForeignKey{{
+		Name:              "orders_customer_id_fkey",
+		Columns:           []string{"customer_id"},
+		ReferencedSchema:  "public",
+		ReferencedTable:   "customer",
+		ReferencedColumns: []string{"id"},
+	}}, orders.ForeignKeys)
+
+	schemas, err = GetSchemas(postgres.Dialect, "sales_us,sales_eu", script)
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+	require.Equal(t, "sales_us", schemas[0].Name)
+	require.Len(t, schemas[0].TablesMetaData, 1)
+	require.Len(t, schemas[0].TablesMetaData[0].Columns, 1)
+
+	schemas, err = GetSchemas(postgres.Dialect, "hr_*", script)
+	require.NoError(t, err)
+	require.Empty(t, schemas)
+}
+
+const mysqlSchema = "" +
+	"CREATE TABLE `language` (\n" +
+	"  `language_id` tinyint unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` char(20) NOT NULL,\n" +
+	"  `last_update` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,\n" +
+	"  PRIMARY KEY (`language_id`)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='Languages';\n" +
+	`
+CREATE TABLE film (
+  film_id SMALLINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  title VARCHAR(255) CHARACTER SET utf8mb4 NOT NULL COMMENT 'Film title',
+  description TEXT DEFAULT NULL,
+  rating ENUM('G','PG','PG-13') DEFAULT 'G',
+  is_active BOOL NOT NULL DEFAULT TRUE,
+  price DECIMAL(4,2) CHECK (price > 0),
+  title_length INT AS (CHAR_LENGTH(title)) VIRTUAL,
+  language_id TINYINT UNSIGNED NOT NULL,
+  UNIQUE KEY uk_title (title),
+  KEY (description(10)),
+  FULLTEXT KEY idx_title_description (title, description),
+  CONSTRAINT fk_film_language FOREIGN KEY (language_id) REFERENCES language (language_id),
+  CHECK (title <> '')
+);
+
+ALTER TABLE film ADD COLUMN code INT FIRST, MODIFY description MEDIUMTEXT NOT NULL, CHANGE COLUMN price rental_price DECIMAL(4,2);
+ALTER TABLE film ADD INDEX (code), DROP INDEX description;
+
+CREATE ALGORITHM=UNDEFINED DEFINER=` + "`root`@`localhost`" + ` SQL SECURITY DEFINER VIEW film_list AS
+SELECT film.film_id AS FID, film.title, film.rating, language.name
+FROM film JOIN language ON film.language_id = language.language_id;
+`
+
+func TestGetSchemaMySQL(t *testing.T) {
+	schema, err := GetSchema(mysql.Dialect, "dvds", mysqlSchema)
+	require.NoError(t, err)
+
+	require.Equal(t, []metadata.Enum{
+		{Name: "film_rating", Values: []string{"G", "PG", "PG-13"}},
+		{Name: "film_list_rating", Values: []string{"G", "PG", "PG-13"}},
+	}, schema.EnumsMetaData)
+
+	base := func(name string, unsigned bool) metadata.DataType {
+		return metadata.DataType{Name: name, Kind: metadata.BaseType, IsUnsigned: unsigned, SourceDialect: "MySQL"}
+	}
+
+	film := schema.TablesMetaData[0]
+	require.Equal(t, []metadata.Column{
+		{Name: "code", IsNullable: true, DataType: base("int", false)},
+		{Name: "film_id", IsPrimaryKey: true, IsAutoIncrement: true, DataType: base("smallint", true)},
+		{Name: "title", DataType: base("varchar", false), Comment: "Film title"},
+		{Name: "description", DataType: base("mediumtext", false)},
+		{Name: "rating", IsNullable: true, HasDefault: true, DataType: metadata.DataType{Name: "film_rating", Kind: metadata.EnumType, SourceDialect: "MySQL"}},
+		{Name: "is_active", HasDefault: true, DataType: base("boolean", false)},
+		{Name: "rental_price", IsNullable: true, DataType: base("decimal", false)},
+		{Name: "title_length", IsNullable: true, IsGenerated: true, DataType: base("int", false)},
+		{Name: "language_id", DataType: base("tinyint", true)},
+	}, film.Columns)
+
+	require.Equal(t, []metadata.Index{
+		{Name: "code", Columns: []string{"code"}},
+		{Name: "fk_film_language", Columns: []string{"language_id"}},
+		{Name: "idx_title_description", Columns: []string{"title", "description"}},
+		{Name: "PRIMARY", IsPrimary: true, IsUnique: true, IsConstraint: true, Columns: []string{"film_id"}},
+		{Name: "uk_title", IsUnique: true, IsConstraint: true, Columns: []string{"title"}},
+	}, film.Indexes)
+
+	require.Equal(t, []metadata.ForeignKey{{
+		Name:              "fk_film_language",
+		Columns:           []string{"language_id"},
+		ReferencedSchema:  "dvds",
+		ReferencedTable:   "language",
+		ReferencedColumns: []string{"language_id"},
+	}}, film.ForeignKeys)
+
+	require.Equal(t, []metadata.CheckConstraint{
+		{Name: "film_chk_1", Expression: "rental_price > 0", Columns: []string{"rental_price"}},
+		{Name: "film_chk_2", Expression: "title <> ''", Columns: []string{"title"}},
+	}, film.CheckConstraints)
+
+	language := schema.TablesMetaData[1]
+	require.Equal(t, "", language.Comment)
+	require.Equal(t, metadata.Column{Name: "language_id", IsPrimaryKey: true, IsAutoIncrement: true, DataType: base("tinyint", true)}, language.Columns[0])
+	require.Equal(t, metadata.Column{Name: "last_update", HasDefault: true, DataType: base("timestamp", false)}, language.Columns[2])
+
+	require.Equal(t, []metadata.Column{
+		{Name: "FID", DataType: base("smallint", true)},
+		{Name: "title", DataType: base("varchar", false)},
+		{Name: "rating", IsNullable: true, DataType: metadata.DataType{Name: "film_list_rating", Kind: metadata.EnumType, SourceDialect: "MySQL"}},
+		{Name: "name", DataType: base("char", false)},
+	}, schema.ViewsMetaData[0].Columns)
+}
+
+func TestReadFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "002_film.sql"), []byte(`
+-- +goose Up
+CREATE TABLE film (id int);
+-- +goose Down
+DROP TABLE film;
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "001_language.up.sql"), []byte(`CREATE TABLE language (id int)`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "001_language.down.sql"), []byte(`DROP TABLE language;`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "readme.md"), []byte(`DROP TABLE language;`), 0600))
+
+	script, err := ReadFiles(dir)
+	require.NoError(t, err)
+
+	schema, err := GetSchema(postgres.Dialect, "public", script)
+	require.NoError(t, err)
+	require.Len(t, schema.TablesMetaData, 2)
+	require.Equal(t, "film", schema.TablesMetaData[0].Name)
+	require.Equal(t, "language", schema.TablesMetaData[1].Name)
+
+	_, err = ReadFiles(filepath.Join(dir, "missing.sql"))
+	require.Error(t, err)
+}
+
+func TestGetSchemaPostgresComposite(t *testing.T) {
+	schema, err := GetSchema(postgres.Dialect, "public", `
+CREATE DOMAIN email AS varchar(100);
+CREATE TYPE geo AS (lat float8, lng float8);
+CREATE TYPE address AS (street text, city email, location geo, tags text[]);
+COMMENT ON TYPE address IS 'Postal address';
+COMMENT ON COLUMN address.city IS 'City name';
+ALTER TYPE address ADD ATTRIBUTE country text, DROP ATTRIBUTE IF EXISTS tags, ALTER ATTRIBUTE street TYPE varchar(50);
+ALTER TYPE address RENAME ATTRIBUTE street TO street_name;
+
+CREATE TABLE customer (
+    id int PRIMARY KEY,
+    email email NOT NULL,
+    address address,
+    addresses address[]
+);
+`)
+	require.NoError(t, err)
+
+	base := func(name string) metadata.DataType {
+		return metadata.DataType{Name: name, Kind: metadata.BaseType, SourceDialect: "PostgreSQL"}
+	}
+	address := metadata.DataType{Name: "address", Kind: metadata.CompositeType, Schema: "public", SourceDialect: "PostgreSQL"}
+	email := metadata.DataType{Name: "varchar", Kind: metadata.BaseType, Domain: "email", SourceDialect: "PostgreSQL"}
+
+	require.Equal(t, []metadata.Composite{
+		{
+			Name:    "address",
+			Comment: "Postal address",
+			Attributes: []metadata.Column{
+				{Name: "street_name", IsNullable: true, DataType: base("varchar")},
+				{Name: "city", IsNullable: true, DataType: email, Comment: "City name"},
+				{Name: "location", IsNullable: true, DataType: metadata.DataType{Name: "geo", Kind: metadata.CompositeType, Schema: "public", SourceDialect: "PostgreSQL"}},
+				{Name: "country", IsNullable: true, DataType: base("text")},
+			},
+		},
+		{
+			Name: "geo",
+			Attributes: []metadata.Column{
+				{Name: "lat", IsNullable: true, DataType: base("float8")},
+				{Name: "lng", IsNullable: true, DataType: base("float8")},
+			},
+		},
+	}, schema.CompositesMetaData)
+
+	addresses := address
+	addresses.Dimensions = 1
+
+	require.Equal(t, []metadata.Column{
+		{Name: "id", IsPrimaryKey: true, DataType: base("int4")},
+		{Name: "email", DataType: email},
+		{Name: "address", IsNullable: true, DataType: address},
+		{Name: "addresses", IsNullable: true, DataType: addresses},
+	}, schema.TablesMetaData[0].Columns)
+
+	_, err = GetSchema(postgres.Dialect, "public", `CREATE TYPE a AS (b int, b text);`)
+	require.Error(t, err)
+}
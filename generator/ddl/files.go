@@ -0,0 +1,76 @@
+package ddl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// downMigrationMarker matches the start of the down migration section in goose and dbmate migration files
+var downMigrationMarker = regexp.MustCompile(`(?im)^\s*--\s*(\+goose\s+down|migrate:down)\b`)
+
+// ReadFiles reads and concatenates sql files. If the path is a directory, all the .sql files from the directory are
+// read in the file name order, except down migrations (.down.sql files). Down migration sections of goose and
+// dbmate migration files are skipped as well.
+func ReadFiles(paths ...string) (string, error) {
+	var script strings.Builder
+
+	for _, path := range paths {
+		fileInfo, err := os.Stat(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read sql file: %w", err)
+		}
+
+		files := []string{path}
+
+		if fileInfo.IsDir() {
+			if files, err = migrationFiles(path); err != nil {
+				return "", err
+			}
+		}
+
+		for _, file := range files {
+			// #nosec G304 -- sql files are provided by the user
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return "", fmt.Errorf("failed to read sql file: %w", err)
+			}
+
+			text := string(content)
+			if location := downMigrationMarker.FindStringIndex(text); location != nil {
+				text = text[:location[0]]
+			}
+
+			script.WriteString(text)
+			script.WriteString("\n;\n")
+		}
+	}
+
+	return script.String(), nil
+}
+
+func migrationFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sql files directory: %w", err)
+	}
+
+	var ret []string
+
+	for _, entry := range entries {
+		name := strings.ToLower(entry.Name())
+
+		if entry.IsDir() || !strings.HasSuffix(name, ".sql") || strings.HasSuffix(name, ".down.sql") {
+			continue
+		}
+
+		ret = append(ret, filepath.Join(dir, entry.Name()))
+	}
+
+	sort.Strings(ret)
+
+	return ret, nil
+}
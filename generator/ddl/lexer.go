@@ -0,0 +1,321 @@
+package ddl
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	wordToken       tokenKind = iota // keyword or unquoted identifier
+	identifierToken                  // quoted identifier
+	stringToken                      // string literal
+	numberToken
+	symbolToken
+)
+
+type token struct {
+	kind  tokenKind
+	text  string // identifier name, string literal value or raw token text
+	start int    // token start position in the statement text
+	end   int    // token end position in the statement text
+}
+
+// is returns true if token is keyword (or symbol) equal to one of the words
+func (t token) is(words ...string) bool {
+	if t.kind != wordToken && t.kind != symbolToken {
+		return false
+	}
+
+	for _, word := range words {
+		if strings.EqualFold(t.text, word) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// statement is single DDL statement
+type statement struct {
+	text   string
+	tokens []token
+}
+
+// splitStatements splits sql script into list of tokenized statements. Comments are removed, and MySQL DELIMITER
+// commands are taken into account. MySQL syntax enables '#' comments and backslash escapes in all string literals,
+// otherwise backslash escapes are allowed only in escape string constants E'...' (PostgreSQL).
+func splitStatements(sqlScript string, mysqlSyntax bool) ([]statement, error) {
+	var (
+		ret       []statement
+		delimiter = ";"
+		start     = 0
+	)
+
+	lex := lexer{text: sqlScript, mysqlSyntax: mysqlSyntax}
+
+	for {
+		lex.skipSpacesAndComments()
+
+		if lex.pos >= len(lex.text) {
+			break
+		}
+
+		if lex.atLineStart() && lex.hasWordPrefix("DELIMITER") {
+			lineEnd := strings.IndexByte(lex.text[lex.pos:], '\n')
+			if lineEnd < 0 {
+				lineEnd = len(lex.text) - lex.pos
+			}
+
+			delimiter = strings.TrimSpace(lex.text[lex.pos+len("DELIMITER") : lex.pos+lineEnd])
+			if delimiter == "" {
+				return nil, fmt.Errorf("invalid DELIMITER command")
+			}
+
+			lex.pos += lineEnd
+			start = lex.pos
+			continue
+		}
+
+		if strings.HasPrefix(lex.text[lex.pos:], delimiter) {
+			ret = appendStatement(ret, lex.text[start:lex.pos], mysqlSyntax)
+			lex.pos += len(delimiter)
+			start = lex.pos
+			continue
+		}
+
+		if _, err := lex.next(); err != nil {
+			return nil, err
+		}
+	}
+
+	return appendStatement(ret, lex.text[start:], mysqlSyntax), nil
+}
+
+func appendStatement(statements []statement, text string, mysqlSyntax bool) []statement {
+	lex := lexer{text: text, mysqlSyntax: mysqlSyntax}
+
+	var tokens []token
+
+	for {
+		tok, err := lex.next()
+		if err != nil || tok == nil {
+			break
+		}
+
+		tokens = append(tokens, *tok)
+	}
+
+	if len(tokens) == 0 {
+		return statements
+	}
+
+	return append(statements, statement{text: text, tokens: tokens})
+}
+
+type lexer struct {
+	text        string
+	pos         int
+	mysqlSyntax bool
+}
+
+func (l *lexer) atLineStart() bool {
+	for i := l.pos - 1; i >= 0; i-- {
+		switch l.text[i] {
+		case '\n':
+			return true
+		case ' ', '\t', '\r':
+			continue
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l *lexer) hasWordPrefix(word string) bool {
+	end := l.pos + len(word)
+
+	return end <= len(l.text) && strings.EqualFold(l.text[l.pos:end], word) &&
+		(end == len(l.text) || !isWordChar(rune(l.text[end])))
+}
+
+func (l *lexer) skipSpacesAndComments() {
+	for l.pos < len(l.text) {
+		switch {
+		case unicode.IsSpace(rune(l.text[l.pos])):
+			l.pos++
+		case strings.HasPrefix(l.text[l.pos:], "--") || l.text[l.pos] == '#' && l.mysqlSyntax:
+			end := strings.IndexByte(l.text[l.pos:], '\n')
+			if end < 0 {
+				l.pos = len(l.text)
+			} else {
+				l.pos += end + 1
+			}
+		case strings.HasPrefix(l.text[l.pos:], "/*"):
+			end := strings.Index(l.text[l.pos+2:], "*/")
+			if end < 0 {
+				l.pos = len(l.text)
+			} else {
+				l.pos += end + 4
+			}
+		default:
+			return
+		}
+	}
+}
+
+// next returns next token, or nil at the end of the text
+func (l *lexer) next() (*token, error) {
+	l.skipSpacesAndComments()
+
+	if l.pos >= len(l.text) {
+		return nil, nil
+	}
+
+	start := l.pos
+	c := l.text[l.pos]
+
+	switch {
+	case c == '\'':
+		value, err := l.readQuoted('\'', l.mysqlSyntax)
+		if err != nil {
+			return nil, err
+		}
+		return &token{kind: stringToken, text: value, start: start, end: l.pos}, nil
+
+	case (c == 'E' || c == 'e' || c == 'N' || c == 'n') && l.peek(1) == '\'':
+		l.pos++
+		value, err := l.readQuoted('\'', c == 'E' || c == 'e' || l.mysqlSyntax)
+		if err != nil {
+			return nil, err
+		}
+		return &token{kind: stringToken, text: value, start: start, end: l.pos}, nil
+
+	case c == '_' && l.charsetIntroducerLength() > 0: // MySQL charset introducer, for instance _utf8mb4'text'
+		l.pos += l.charsetIntroducerLength()
+		value, err := l.readQuoted('\'', l.mysqlSyntax)
+		if err != nil {
+			return nil, err
+		}
+		return &token{kind: stringToken, text: value, start: start, end: l.pos}, nil
+
+	case c == '"' || c == '`':
+		value, err := l.readQuoted(c, false)
+		if err != nil {
+			return nil, err
+		}
+		return &token{kind: identifierToken, text: value, start: start, end: l.pos}, nil
+
+	case c == '$' && !l.mysqlSyntax && l.dollarQuoteTag() != "":
+		tag := l.dollarQuoteTag()
+		end := strings.Index(l.text[l.pos+len(tag):], tag)
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated dollar-quoted string")
+		}
+		value := l.text[l.pos+len(tag) : l.pos+len(tag)+end]
+		l.pos += len(tag) + end + len(tag)
+		return &token{kind: stringToken, text: value, start: start, end: l.pos}, nil
+
+	case c >= '0' && c <= '9' || c == '.' && l.peek(1) >= '0' && l.peek(1) <= '9':
+		for l.pos < len(l.text) && (isDigit(l.text[l.pos]) || l.text[l.pos] == '.' ||
+			(l.text[l.pos] == 'e' || l.text[l.pos] == 'E') && l.pos+1 < len(l.text) && (isDigit(l.text[l.pos+1]) || l.text[l.pos+1] == '-')) {
+			if l.text[l.pos] == 'e' || l.text[l.pos] == 'E' {
+				l.pos++
+			}
+			l.pos++
+		}
+		return &token{kind: numberToken, text: l.text[start:l.pos], start: start, end: l.pos}, nil
+
+	case isWordChar(rune(c)) || c >= 0x80:
+		for l.pos < len(l.text) && (isWordChar(rune(l.text[l.pos])) || l.text[l.pos] >= 0x80) {
+			l.pos++
+		}
+		return &token{kind: wordToken, text: l.text[start:l.pos], start: start, end: l.pos}, nil
+	}
+
+	for _, operator := range []string{"::", "<=", ">=", "<>", "!=", "||", "->>", "->"} {
+		if strings.HasPrefix(l.text[l.pos:], operator) {
+			l.pos += len(operator)
+			return &token{kind: symbolToken, text: operator, start: start, end: l.pos}, nil
+		}
+	}
+
+	l.pos++
+	return &token{kind: symbolToken, text: string(c), start: start, end: l.pos}, nil
+}
+
+func (l *lexer) peek(offset int) byte {
+	if l.pos+offset >= len(l.text) {
+		return 0
+	}
+
+	return l.text[l.pos+offset]
+}
+
+// readQuoted reads string literal or quoted identifier. Quote character is escaped by doubling, or with backslash
+// if backslashEscapes is set.
+func (l *lexer) readQuoted(quote byte, backslashEscapes bool) (string, error) {
+	var value strings.Builder
+
+	for i := l.pos + 1; i < len(l.text); i++ {
+		c := l.text[i]
+
+		switch {
+		case c == quote && i+1 < len(l.text) && l.text[i+1] == quote:
+			value.WriteByte(quote)
+			i++
+		case c == quote:
+			l.pos = i + 1
+			return value.String(), nil
+		case c == '\\' && backslashEscapes && i+1 < len(l.text):
+			value.WriteByte(l.text[i+1])
+			i++
+		default:
+			value.WriteByte(c)
+		}
+	}
+
+	return "", fmt.Errorf("unterminated quoted text starting with: %.20s", l.text[l.pos:])
+}
+
+// dollarQuoteTag returns PostgreSQL dollar quote tag ($$ or $tag$) at the current position, or empty string
+func (l *lexer) dollarQuoteTag() string {
+	for i := l.pos + 1; i < len(l.text); i++ {
+		switch {
+		case l.text[i] == '$':
+			return l.text[l.pos : i+1]
+		case !isWordChar(rune(l.text[i])) || isDigit(l.text[i]) && i == l.pos+1:
+			return ""
+		}
+	}
+
+	return ""
+}
+
+func (l *lexer) charsetIntroducerLength() int {
+	for i := l.pos + 1; i < len(l.text); i++ {
+		switch {
+		case l.text[i] == '\'':
+			if i == l.pos+1 {
+				return 0
+			}
+			return i - l.pos
+		case !isWordChar(rune(l.text[i])):
+			return 0
+		}
+	}
+
+	return 0
+}
+
+func isWordChar(c rune) bool {
+	return c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,130 @@
+package ddl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// mysqlTypeNames maps MySQL data type names and aliases to information schema DATA_TYPE names
+var mysqlTypeNames = map[string]string{
+	"int1":                       "tinyint",
+	"int2":                       "smallint",
+	"int3":                       "mediumint",
+	"middleint":                  "mediumint",
+	"int4":                       "int",
+	"integer":                    "int",
+	"int8":                       "bigint",
+	"bool":                       "boolean",
+	"dec":                        "decimal",
+	"numeric":                    "decimal",
+	"fixed":                      "decimal",
+	"real":                       "double",
+	"double precision":           "double",
+	"float4":                     "float",
+	"float8":                     "double",
+	"character":                  "char",
+	"nchar":                      "char",
+	"national char":              "char",
+	"national character":         "char",
+	"character varying":          "varchar",
+	"char varying":               "varchar",
+	"nvarchar":                   "varchar",
+	"national varchar":           "varchar",
+	"national character varying": "varchar",
+	"long":                       "mediumtext",
+	"long varchar":               "mediumtext",
+	"long varbinary":             "mediumblob",
+	"serial":                     "bigint",
+}
+
+// setMySQLColumnType resolves column data type in the same format as information schema returns it.
+// SERIAL is an alias for BIGINT UNSIGNED NOT NULL AUTO_INCREMENT UNIQUE.
+func (c *catalog) setMySQLColumnType(t *table, col *column, colType columnType) error {
+	name := colType.name()
+
+	col.dataType = metadata.DataType{
+		Name:          name,
+		Kind:          metadata.BaseType,
+		IsUnsigned:    colType.unsigned,
+		SourceDialect: mysql.Dialect.Name(),
+	}
+	col.enumValues = nil
+
+	if typeName, ok := mysqlTypeNames[name]; ok {
+		col.dataType.Name = typeName
+	}
+
+	switch col.dataType.Name {
+	case "tinyint":
+		if len(colType.args) == 1 && colType.args[0] == "1" && !colType.unsigned {
+			col.dataType.Name = "boolean"
+		}
+
+	case "float":
+		if len(colType.args) == 1 {
+			if precision, err := strconv.Atoi(colType.args[0]); err == nil && precision > 24 {
+				col.dataType.Name = "double"
+			}
+		}
+
+	case "enum":
+		col.enumValues = colType.args
+		if col.enumValues == nil {
+			col.enumValues = []string{}
+		}
+	}
+
+	if name == "serial" {
+		col.dataType.IsUnsigned = true
+		col.isNullable = false
+		col.isAutoIncrement = true
+
+		if err := c.addIndex(t, "", true, true, "", []string{col.name}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mysqlIndexName returns unused name for implicitly named index. MySQL names the index after its first column,
+// and appends the numeric suffix if the name is already used.
+func mysqlIndexName(t *table, column string) string {
+	if column == "" {
+		column = "functional_index"
+	}
+
+	name := column
+
+	for i := 2; t.isIndexNameUsed(name); i++ {
+		name = fmt.Sprintf("%s_%d", column, i)
+	}
+
+	return name
+}
+
+// mysqlConstraintName returns unused name for implicitly named foreign key or check constraint,
+// for instance 'film_ibfk_1' or 'film_chk_1'.
+func mysqlConstraintName(t *table, infix string) string {
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("%s_%s_%d", t.name, infix, i)
+
+		if !t.isConstraintNameUsed(name) {
+			return name
+		}
+	}
+}
+
+func (t *table) isIndexNameUsed(name string) bool {
+	for _, index := range t.indexes {
+		if strings.EqualFold(index.Name, name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,1884 @@
+package ddl
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+)
+
+// parser parses single DDL statement and applies it to the catalog
+type parser struct {
+	catalog *catalog
+	text    string
+	tokens  []token
+	pos     int
+}
+
+func newParser(c *catalog, stmt statement) *parser {
+	return &parser{
+		catalog: c,
+		text:    stmt.text,
+		tokens:  stmt.tokens,
+	}
+}
+
+// subParser returns parser for the part of the statement tokens
+func (p *parser) subParser(from, to int) *parser {
+	return &parser{
+		catalog: p.catalog,
+		text:    p.text,
+		tokens:  p.tokens[from:to],
+	}
+}
+
+var endToken = token{kind: symbolToken}
+
+func (p *parser) atEnd() bool {
+	return p.pos >= len(p.tokens) || p.tokens[p.pos].is(";")
+}
+
+func (p *parser) peek() token {
+	return p.peekAt(0)
+}
+
+func (p *parser) peekAt(offset int) token {
+	if p.pos+offset >= len(p.tokens) {
+		return endToken
+	}
+
+	return p.tokens[p.pos+offset]
+}
+
+func (p *parser) next() token {
+	ret := p.peek()
+
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+
+	return ret
+}
+
+// accept consumes the sequence of keywords, if the statement continues with the sequence
+func (p *parser) accept(words ...string) bool {
+	for i, word := range words {
+		if !p.peekAt(i).is(word) {
+			return false
+		}
+	}
+
+	p.pos += len(words)
+
+	return true
+}
+
+func (p *parser) expect(words ...string) error {
+	if !p.accept(words...) {
+		return p.unexpected(strings.Join(words, " "))
+	}
+
+	return nil
+}
+
+func (p *parser) unexpected(expected string) error {
+	if p.atEnd() {
+		return fmt.Errorf("unexpected end of statement, expected %s", expected)
+	}
+
+	return fmt.Errorf("unexpected '%s', expected %s", p.peek().text, expected)
+}
+
+// text returns statement text of the tokens in the range
+func (p *parser) textRange(from, to int) string {
+	if from >= to {
+		return ""
+	}
+
+	return p.text[p.tokens[from].start:p.tokens[to-1].end]
+}
+
+func (p *parser) isIdentifier(tok token) bool {
+	return tok.kind == wordToken || tok.kind == identifierToken
+}
+
+// identifier returns next identifier. PostgreSQL unquoted identifiers are folded to lower case.
+func (p *parser) identifier() (string, error) {
+	tok := p.peek()
+
+	switch {
+	case tok.kind == identifierToken:
+		p.next()
+		return tok.text, nil
+	case tok.kind == wordToken:
+		p.next()
+		if p.catalog.mysql {
+			return tok.text, nil
+		}
+		return strings.ToLower(tok.text), nil
+	}
+
+	return "", p.unexpected("identifier")
+}
+
+// qualifiedName returns next dot separated list of identifiers
+func (p *parser) qualifiedName() ([]string, error) {
+	name, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := []string{name}
+
+	for p.peek().is(".") && p.isIdentifier(p.peekAt(1)) {
+		p.next()
+		name, _ = p.identifier()
+		ret = append(ret, name)
+	}
+
+	return ret, nil
+}
+
+// objectName returns next schema qualified object name
+func (p *parser) objectName() (schema, name string, err error) {
+	parts, err := p.qualifiedName()
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(parts) > 1 {
+		schema = parts[len(parts)-2]
+	}
+
+	return schema, parts[len(parts)-1], nil
+}
+
+// identifierList returns list of identifiers in parenthesis
+func (p *parser) identifierList() ([]string, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var ret []string
+
+	for {
+		name, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, name)
+
+		if p.accept(")") {
+			return ret, nil
+		}
+
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) stringLiteral() (string, error) {
+	tok := p.peek()
+	if tok.kind != stringToken {
+		return "", p.unexpected("string literal")
+	}
+	p.next()
+
+	return tok.text, nil
+}
+
+// closingParenthesis returns position of the closing parenthesis matching opening parenthesis at the position
+func (p *parser) closingParenthesis(pos int) int {
+	depth := 0
+
+	for i := pos; i < len(p.tokens); i++ {
+		switch {
+		case p.tokens[i].is("(", "["):
+			depth++
+		case p.tokens[i].is(")", "]"):
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return len(p.tokens)
+}
+
+// parenthesized returns the text in parenthesis, and moves after closing parenthesis
+func (p *parser) parenthesized() (string, error) {
+	if !p.peek().is("(") {
+		return "", p.unexpected("(")
+	}
+
+	end := p.closingParenthesis(p.pos)
+	if end >= len(p.tokens) {
+		return "", fmt.Errorf("missing closing parenthesis")
+	}
+
+	ret := p.textRange(p.pos+1, end)
+	p.pos = end + 1
+
+	return ret, nil
+}
+
+// skipElement skips tokens until the comma or unmatched closing parenthesis
+func (p *parser) skipElement() {
+	for !p.atEnd() && !p.peek().is(",", ")") {
+		if p.peek().is("(", "[") {
+			p.pos = p.closingParenthesis(p.pos)
+		}
+		p.next()
+	}
+}
+
+// expression returns text of the expression that ends with comma, unmatched closing parenthesis or any of
+// the stop keywords at the top level.
+func (p *parser) expression(stopWords ...string) string {
+	start := p.pos
+
+	for !p.atEnd() && !p.peek().is(",", ")") {
+		if p.pos > start && p.peek().is(stopWords...) {
+			break
+		}
+
+		switch {
+		case p.peek().is("(", "["):
+			p.pos = p.closingParenthesis(p.pos) + 1
+		case p.peek().is("::"):
+			p.next()
+			_, _ = p.columnType()
+		default:
+			p.next()
+		}
+	}
+
+	return p.textRange(start, p.pos)
+}
+
+// parseStatement parses the statement and applies it to the catalog. Statements that do not change the schema
+// objects metadata (INSERT, GRANT, CREATE FUNCTION, etc.) are ignored.
+func (p *parser) parseStatement() error {
+	switch {
+	case p.accept("CREATE"):
+		return p.parseCreate()
+	case p.accept("ALTER", "TABLE"):
+		return p.parseAlterTable()
+	case p.accept("ALTER", "TYPE"):
+		return p.parseAlterType()
+	case p.accept("ALTER", "VIEW"), p.accept("ALTER", "MATERIALIZED", "VIEW"):
+		return p.parseAlterView()
+	case p.accept("DROP"):
+		return p.parseDrop()
+	case p.accept("RENAME", "TABLE"):
+		return p.parseRenameTable()
+	case p.accept("COMMENT", "ON"):
+		return p.parseComment()
+	case p.accept("SET"):
+		return p.parseSet()
+	case p.accept("USE"):
+		schema, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		p.catalog.currentSchema = schema
+	}
+
+	return nil
+}
+
+var createModifiers = []string{"OR", "REPLACE", "TEMP", "TEMPORARY", "GLOBAL", "LOCAL", "UNLOGGED", "UNIQUE",
+	"FULLTEXT", "SPATIAL", "MATERIALIZED", "RECURSIVE"}
+
+func (p *parser) parseCreate() error {
+	var modifiers []string
+
+modifiersLoop:
+	for {
+		switch {
+		case p.peek().is(createModifiers...):
+			modifiers = append(modifiers, strings.ToUpper(p.next().text))
+		case p.accept("ALGORITHM"), p.accept("DEFINER"): // MySQL view options
+			p.accept("=")
+			p.next()
+			if p.accept("@") {
+				p.next()
+			}
+		case p.accept("SQL", "SECURITY"):
+			p.next()
+		default:
+			break modifiersLoop
+		}
+	}
+
+	temporary := slices.Contains(modifiers, "TEMP") || slices.Contains(modifiers, "TEMPORARY")
+
+	switch {
+	case p.accept("TABLE"):
+		if temporary {
+			return nil // temporary tables are not part of the schema
+		}
+		return p.parseCreateTable()
+	case p.accept("VIEW"):
+		if temporary {
+			return nil
+		}
+		kind := view
+		if slices.Contains(modifiers, "MATERIALIZED") {
+			kind = materializedView
+		}
+		return p.parseCreateView(kind, slices.Contains(modifiers, "REPLACE"))
+	case p.accept("INDEX"):
+		return p.parseCreateIndex(slices.Contains(modifiers, "UNIQUE"))
+	case p.accept("TYPE"):
+		return p.parseCreateType()
+	case p.accept("DOMAIN"):
+		return p.parseCreateDomain()
+	}
+
+	return nil
+}
+
+func (p *parser) ifNotExists() bool {
+	return p.accept("IF", "NOT", "EXISTS")
+}
+
+func (p *parser) ifExists() bool {
+	return p.accept("IF", "EXISTS")
+}
+
+func (p *parser) parseCreateTable() error {
+	ifNotExists := p.ifNotExists()
+
+	schema, name, err := p.objectName()
+	if err != nil {
+		return err
+	}
+
+	newTable := &table{
+		schema: p.catalog.schemaOrCurrent(schema),
+		name:   name,
+		kind:   baseTable,
+	}
+
+	switch {
+	case p.accept("LIKE"): // MySQL CREATE TABLE ... LIKE copies columns and indexes
+		if err := p.copyColumns(newTable, true); err != nil {
+			return err
+		}
+
+	case p.accept("PARTITION", "OF"):
+		if err := p.copyColumns(newTable, false); err != nil {
+			return err
+		}
+
+	case p.peek().is("("):
+		p.next()
+
+		for !p.accept(")") {
+			if err := p.parseTableElement(newTable); err != nil {
+				return err
+			}
+
+			if !p.peek().is(")") {
+				if err := p.expect(","); err != nil {
+					return err
+				}
+			}
+		}
+
+		p.parseTableOptions(newTable)
+
+	default: // CREATE TABLE ... AS SELECT
+		for !p.atEnd() && !p.peek().is("AS") {
+			p.next()
+		}
+
+		if !p.accept("AS") {
+			return p.unexpected("(")
+		}
+
+		newTable.columns, err = p.selectColumns(nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.catalog.addTable(newTable, ifNotExists)
+}
+
+// copyColumns copies columns, and optionally indexes, of the table with the next name into the new table
+func (p *parser) copyColumns(newTable *table, copyIndexes bool) error {
+	schema, name, err := p.objectName()
+	if err != nil {
+		return err
+	}
+
+	source := p.catalog.findTable(schema, name)
+	if source == nil {
+		return fmt.Errorf("relation '%s' does not exist", name)
+	}
+
+	for _, col := range source.columns {
+		colCopy := *col
+		colCopy.comment = ""
+		newTable.columns = append(newTable.columns, &colCopy)
+	}
+
+	if copyIndexes {
+		for _, index := range source.indexes {
+			indexCopy := *index
+			indexCopy.Columns = slices.Clone(index.Columns)
+			newTable.indexes = append(newTable.indexes, &indexCopy)
+		}
+	}
+
+	return nil
+}
+
+func (p *parser) parseTableOptions(t *table) {
+	for !p.atEnd() {
+		switch {
+		case p.accept("COMMENT"):
+			p.accept("=")
+			if comment, err := p.stringLiteral(); err == nil {
+				t.comment = comment
+			}
+		case p.peek().is("("):
+			p.pos = p.closingParenthesis(p.pos) + 1
+		default:
+			p.next()
+		}
+	}
+}
+
+// parseTableElement parses column definition or table constraint
+func (p *parser) parseTableElement(t *table) error {
+	if p.peek().is("LIKE") {
+		p.next()
+		if err := p.copyColumns(t, false); err != nil {
+			return err
+		}
+		p.skipElement()
+		return nil
+	}
+
+	if p.isTableConstraint() {
+		return p.parseTableConstraint(t)
+	}
+
+	col, err := p.parseColumnDefinition(t)
+	if err != nil {
+		return err
+	}
+
+	if t.findColumn(col.name, p.catalog.mysql) != nil {
+		return fmt.Errorf("column '%s' specified more than once", col.name)
+	}
+
+	t.columns = append(t.columns, col)
+
+	return nil
+}
+
+func (p *parser) isTableConstraint() bool {
+	tok := p.peek()
+
+	if tok.kind != wordToken {
+		return false
+	}
+
+	if tok.is("CONSTRAINT", "PRIMARY", "UNIQUE", "CHECK", "FOREIGN", "EXCLUDE") {
+		return true
+	}
+
+	return p.catalog.mysql && tok.is("KEY", "INDEX", "FULLTEXT", "SPATIAL")
+}
+
+// parseTableConstraint parses table constraint, and MySQL index definition
+func (p *parser) parseTableConstraint(t *table) error {
+	var name string
+
+	if p.accept("CONSTRAINT") && !p.peek().is("PRIMARY", "UNIQUE", "CHECK", "FOREIGN", "EXCLUDE") {
+		var err error
+		if name, err = p.identifier(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case p.accept("PRIMARY", "KEY"):
+		columns, err := p.indexColumns()
+		if err != nil {
+			return err
+		}
+		p.skipElement()
+
+		return p.catalog.addPrimaryKey(t, name, columns)
+
+	case p.accept("UNIQUE"):
+		if p.catalog.mysql {
+			_ = p.accept("KEY") || p.accept("INDEX")
+			if !p.peek().is("(", "USING") { // index name takes precedence over constraint name
+				var err error
+				if name, err = p.identifier(); err != nil {
+					return err
+				}
+			}
+		}
+
+		columns, err := p.indexColumns()
+		if err != nil {
+			return err
+		}
+		p.skipElement()
+
+		return p.catalog.addIndex(t, name, true, true, "", columns)
+
+	case p.accept("CHECK"):
+		expression, err := p.parenthesized()
+		if err != nil {
+			return err
+		}
+		p.skipElement()
+
+		return p.catalog.addCheckConstraint(t, name, expression, "")
+
+	case p.accept("FOREIGN", "KEY"):
+		var indexName string
+		if !p.peek().is("(") {
+			var err error
+			if indexName, err = p.identifier(); err != nil {
+				return err
+			}
+		}
+
+		columns, err := p.identifierList()
+		if err != nil {
+			return err
+		}
+
+		if err := p.expect("REFERENCES"); err != nil {
+			return err
+		}
+
+		foreignKey, err := p.parseReferences(name, columns)
+		if err != nil {
+			return err
+		}
+
+		return p.catalog.addForeignKey(t, foreignKey, indexName)
+
+	case p.accept("EXCLUDE"):
+		p.skipElement()
+		return nil
+
+	case p.accept("KEY"), p.accept("INDEX"), p.accept("FULLTEXT"), p.accept("SPATIAL"):
+		_ = p.accept("KEY") || p.accept("INDEX")
+
+		if !p.peek().is("(", "USING") {
+			var err error
+			if name, err = p.identifier(); err != nil {
+				return err
+			}
+		}
+
+		columns, err := p.indexColumns()
+		if err != nil {
+			return err
+		}
+		p.skipElement()
+
+		return p.catalog.addIndex(t, name, false, false, "", columns)
+	}
+
+	return p.unexpected("table constraint")
+}
+
+// indexColumns parses list of index key parts. Expression key parts are returned as expression text for PostgreSQL,
+// and as empty string for MySQL (as information schema returns them).
+func (p *parser) indexColumns() ([]string, error) {
+	if p.accept("USING") {
+		p.next()
+	}
+
+	if p.accept("NULLS") {
+		p.accept("NOT")
+		p.accept("DISTINCT")
+	}
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var ret []string
+
+	for {
+		start := p.pos
+
+		switch {
+		case p.peek().is("("):
+			expression, err := p.parenthesized()
+			if err != nil {
+				return nil, err
+			}
+			if p.catalog.mysql {
+				expression = ""
+			}
+			ret = append(ret, expression)
+
+		case !p.catalog.mysql && p.isIdentifier(p.peek()) && p.peekAt(1).is("("):
+			p.next()
+			p.pos = p.closingParenthesis(p.pos) + 1
+			ret = append(ret, p.textRange(start, p.pos))
+
+		default:
+			name, err := p.identifier()
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, name)
+		}
+
+		p.skipElement() // prefix length, collation, operator class, ordering
+
+		if p.accept(")") {
+			return ret, nil
+		}
+
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseReferences parses the REFERENCES clause of the foreign key
+func (p *parser) parseReferences(name string, columns []string) (*metadata.ForeignKey, error) {
+	schema, table, err := p.objectName()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &metadata.ForeignKey{
+		Name:             name,
+		Columns:          columns,
+		ReferencedSchema: p.catalog.schemaOrCurrent(schema),
+		ReferencedTable:  table,
+	}
+
+	if p.peek().is("(") {
+		if ret.ReferencedColumns, err = p.identifierList(); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		switch {
+		case p.accept("MATCH"):
+			p.next()
+		case p.accept("ON", "DELETE"), p.accept("ON", "UPDATE"):
+			switch {
+			case p.accept("SET"):
+				p.next()
+				if p.peek().is("(") {
+					p.pos = p.closingParenthesis(p.pos) + 1
+				}
+			case p.accept("NO", "ACTION"):
+			default:
+				p.next()
+			}
+		case p.accept("NOT", "DEFERRABLE"), p.accept("DEFERRABLE"), p.accept("NOT", "VALID"):
+		case p.accept("INITIALLY"):
+			p.next()
+		default:
+			return ret, nil
+		}
+	}
+}
+
+// columnStopWords are keywords that end column data type or default value expression
+var columnStopWords = []string{"NOT", "NULL", "DEFAULT", "PRIMARY", "UNIQUE", "CHECK", "REFERENCES", "CONSTRAINT",
+	"GENERATED", "COLLATE", "COMMENT", "AUTO_INCREMENT", "ON", "KEY", "CHARSET", "VISIBLE", "INVISIBLE", "STORED",
+	"VIRTUAL", "SRID", "COLUMN_FORMAT", "STORAGE", "ENGINE_ATTRIBUTE", "SECONDARY_ENGINE_ATTRIBUTE", "FIRST", "AFTER",
+	"USING", "AS", "DEFERRABLE", "INITIALLY", "NO", "SERIAL"}
+
+// columnType contains data type of the column, as it is written in the column definition
+type columnType struct {
+	schema     string
+	words      []string // lower case type name words, for instance [double precision]
+	args       []string // type modifiers
+	dimensions int      // number of array dimensions
+	unsigned   bool
+}
+
+func (c columnType) name() string {
+	return strings.Join(c.words, " ")
+}
+
+func (p *parser) isColumnStop() bool {
+	tok := p.peek()
+
+	if tok.is(columnStopWords...) {
+		return !tok.is("SERIAL") || p.peekAt(1).is("DEFAULT")
+	}
+
+	return tok.is("CHARACTER") && p.peekAt(1).is("SET")
+}
+
+// columnType parses data type of the column
+func (p *parser) columnType() (columnType, error) {
+	var ret columnType
+
+	for !p.atEnd() {
+		tok := p.peek()
+
+		switch {
+		case tok.kind == wordToken || tok.kind == identifierToken:
+			if len(ret.words) > 0 && (tok.kind == identifierToken || p.isColumnStop()) {
+				return ret, nil
+			}
+
+			switch {
+			case len(ret.words) > 0 && tok.is("ARRAY"):
+				ret.dimensions++
+				p.next()
+				if p.peek().is("[") {
+					p.pos = p.closingParenthesis(p.pos) + 1
+				}
+				continue
+			case len(ret.words) > 0 && tok.is("UNSIGNED"):
+				ret.unsigned = true
+				p.next()
+				continue
+			case len(ret.words) > 0 && tok.is("SIGNED", "ZEROFILL", "BINARY"):
+				p.next()
+				continue
+			}
+
+			word := tok.text
+			if tok.kind == wordToken {
+				word = strings.ToLower(word)
+			}
+
+			ret.words = append(ret.words, word)
+			p.next()
+
+			if p.peek().is(".") && p.isIdentifier(p.peekAt(1)) {
+				p.next()
+				ret.schema = ret.words[len(ret.words)-1]
+				ret.words = nil
+			}
+
+		case tok.is("(") && len(ret.words) > 0:
+			args, err := p.argumentList()
+			if err != nil {
+				return ret, err
+			}
+			ret.args = append(ret.args, args...)
+
+		case tok.is("[") && len(ret.words) > 0:
+			ret.dimensions++
+			p.pos = p.closingParenthesis(p.pos) + 1
+
+		default:
+			if len(ret.words) == 0 {
+				return ret, p.unexpected("data type")
+			}
+			return ret, nil
+		}
+	}
+
+	if len(ret.words) == 0 {
+		return ret, p.unexpected("data type")
+	}
+
+	return ret, nil
+}
+
+// argumentList parses comma separated list of arguments in parenthesis. String literal arguments are returned
+// as literal values, other arguments as expression text.
+func (p *parser) argumentList() ([]string, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var ret []string
+
+	for !p.accept(")") {
+		if p.atEnd() {
+			return nil, p.unexpected(")")
+		}
+
+		start := p.pos
+		expression := p.expression()
+
+		if p.pos == start+1 && p.tokens[start].kind == stringToken {
+			expression = p.tokens[start].text
+		}
+
+		ret = append(ret, expression)
+		p.accept(",")
+	}
+
+	return ret, nil
+}
+
+// parseColumnDefinition parses column definition. Column constraints are added to the table.
+func (p *parser) parseColumnDefinition(t *table) (*column, error) {
+	name, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+
+	colType, err := p.columnType()
+	if err != nil {
+		return nil, err
+	}
+
+	col := &column{
+		name:       name,
+		isNullable: true,
+	}
+
+	if err := p.setColumnType(t, col, colType); err != nil {
+		return nil, err
+	}
+
+	if err := p.parseColumnConstraints(t, col); err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+func (p *parser) setColumnType(t *table, col *column, colType columnType) error {
+	if p.catalog.mysql {
+		return p.catalog.setMySQLColumnType(t, col, colType)
+	}
+
+	return p.catalog.setPostgresColumnType(col, colType)
+}
+
+func (p *parser) parseColumnConstraints(t *table, col *column) error {
+	for !p.atEnd() && !p.peek().is(",", ")", "FIRST", "AFTER") {
+		var name string
+
+		if p.accept("CONSTRAINT") && !p.peek().is("PRIMARY", "UNIQUE", "CHECK", "REFERENCES", "NOT", "NULL") {
+			var err error
+			if name, err = p.identifier(); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case p.accept("NOT", "NULL"):
+			col.isNullable = false
+
+		case p.accept("NULL"):
+			col.isNullable = true
+
+		case p.accept("DEFAULT"):
+			col.hasDefault = !strings.EqualFold(p.expression(columnStopWords...), "NULL")
+
+		case p.accept("PRIMARY", "KEY"), p.catalog.mysql && p.accept("KEY"):
+			if err := p.catalog.addPrimaryKey(t, name, []string{col.name}); err != nil {
+				return err
+			}
+			col.isNullable = false
+
+		case p.accept("UNIQUE"):
+			_ = p.accept("KEY") || p.accept("INDEX")
+			if p.accept("NULLS") {
+				p.accept("NOT")
+				p.accept("DISTINCT")
+			}
+			if err := p.catalog.addIndex(t, name, true, true, "", []string{col.name}); err != nil {
+				return err
+			}
+
+		case p.accept("CHECK"):
+			expression, err := p.parenthesized()
+			if err != nil {
+				return err
+			}
+			if err := p.catalog.addCheckConstraint(t, name, expression, col.name); err != nil {
+				return err
+			}
+
+		case p.accept("REFERENCES"):
+			foreignKey, err := p.parseReferences(name, []string{col.name})
+			if err != nil {
+				return err
+			}
+			if err := p.catalog.addForeignKey(t, foreignKey, ""); err != nil {
+				return err
+			}
+
+		case p.accept("GENERATED"):
+			_ = p.accept("ALWAYS") || p.accept("BY", "DEFAULT")
+
+			if err := p.expect("AS"); err != nil {
+				return err
+			}
+
+			if p.accept("IDENTITY") {
+				col.isNullable = false
+				if p.peek().is("(") {
+					p.pos = p.closingParenthesis(p.pos) + 1
+				}
+				continue
+			}
+
+			if err := p.parseGeneratedColumn(col); err != nil {
+				return err
+			}
+
+		case p.catalog.mysql && p.accept("AS"):
+			if err := p.parseGeneratedColumn(col); err != nil {
+				return err
+			}
+
+		case p.accept("COMMENT"):
+			comment, err := p.stringLiteral()
+			if err != nil {
+				return err
+			}
+			col.comment = comment
+
+		case p.accept("ON", "UPDATE"):
+			p.expression(columnStopWords...)
+
+		case p.accept("SERIAL", "DEFAULT", "VALUE"): // alias for NOT NULL AUTO_INCREMENT UNIQUE
+			col.isNullable = false
+			col.isAutoIncrement = true
+			if err := p.catalog.addIndex(t, "", true, true, "", []string{col.name}); err != nil {
+				return err
+			}
+
+		case p.accept("COLLATE"), p.accept("CHARACTER", "SET"), p.accept("CHARSET"), p.accept("COLUMN_FORMAT"),
+			p.accept("STORAGE"), p.accept("SRID"), p.accept("INITIALLY"):
+			p.next()
+
+		case p.accept("ENGINE_ATTRIBUTE"), p.accept("SECONDARY_ENGINE_ATTRIBUTE"):
+			p.accept("=")
+			p.next()
+
+		case p.accept("AUTO_INCREMENT"):
+			col.isAutoIncrement = true
+
+		case p.accept("VISIBLE"), p.accept("INVISIBLE"), p.accept("DEFERRABLE"),
+			p.accept("NOT", "DEFERRABLE"), p.accept("NO", "INHERIT"), p.accept("ENFORCED"), p.accept("NOT", "ENFORCED"):
+
+		default:
+			return p.unexpected("column constraint")
+		}
+	}
+
+	return nil
+}
+
+// parseGeneratedColumn parses generated column expression and storage. PostgreSQL columns are reported as generated
+// only if they are stored, and MySQL columns only if they are virtual.
+func (p *parser) parseGeneratedColumn(col *column) error {
+	if _, err := p.parenthesized(); err != nil {
+		return err
+	}
+
+	stored := p.accept("STORED")
+	if !stored {
+		p.accept("VIRTUAL")
+	}
+
+	if p.catalog.mysql {
+		col.isGenerated = !stored
+	} else {
+		col.isGenerated = stored
+		col.hasDefault = true
+	}
+
+	return nil
+}
+
+// ---------------------------------------------------//
+
+func (p *parser) parseAlterTable() error {
+	ifExists := p.ifExists()
+	p.accept("ONLY")
+
+	schema, name, err := p.objectName()
+	if err != nil {
+		return err
+	}
+	p.accept("*")
+
+	t := p.catalog.findTable(schema, name)
+	if t == nil {
+		if ifExists {
+			return nil
+		}
+		return fmt.Errorf("relation '%s' does not exist", name)
+	}
+
+	for !p.atEnd() {
+		if err := p.parseAlterTableAction(t); err != nil {
+			return err
+		}
+
+		p.skipElement()
+
+		if !p.accept(",") {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (p *parser) parseAlterTableAction(t *table) error {
+	switch {
+	case p.accept("ADD"):
+		if p.isTableConstraint() {
+			return p.parseTableConstraint(t)
+		}
+
+		p.accept("COLUMN")
+		ifNotExists := p.ifNotExists()
+
+		if p.peek().is("(") { // MySQL ADD (col1 ..., col2 ...)
+			p.next()
+			for !p.accept(")") {
+				if err := p.addColumn(t, ifNotExists); err != nil {
+					return err
+				}
+				p.accept(",")
+			}
+			return nil
+		}
+
+		return p.addColumn(t, ifNotExists)
+
+	case p.accept("DROP"):
+		return p.parseDropAction(t)
+
+	case p.accept("ALTER"):
+		p.accept("COLUMN")
+		return p.parseAlterColumn(t)
+
+	case p.accept("MODIFY"):
+		p.accept("COLUMN")
+		return p.changeColumn(t, "")
+
+	case p.accept("CHANGE"):
+		p.accept("COLUMN")
+		oldName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		return p.changeColumn(t, oldName)
+
+	case p.accept("RENAME"):
+		return p.parseRenameAction(t)
+
+	case p.accept("SET", "SCHEMA"):
+		schema, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		t.schema = schema
+	}
+
+	return nil
+}
+
+func (p *parser) addColumn(t *table, ifNotExists bool) error {
+	col, err := p.parseColumnDefinition(t)
+	if err != nil {
+		return err
+	}
+
+	if t.findColumn(col.name, p.catalog.mysql) != nil {
+		if ifNotExists {
+			return nil
+		}
+		return fmt.Errorf("column '%s' of relation '%s' already exists", col.name, t.name)
+	}
+
+	t.columns = slices.Insert(t.columns, p.columnPosition(t, len(t.columns)), col)
+
+	return nil
+}
+
+// columnPosition returns position of the column in the table specified with MySQL FIRST or AFTER clause,
+// or the default position if the clause is not present
+func (p *parser) columnPosition(t *table, defaultPosition int) int {
+	switch {
+	case p.accept("FIRST"):
+		return 0
+	case p.accept("AFTER"):
+		name, err := p.identifier()
+		if err != nil {
+			break
+		}
+		for i, col := range t.columns {
+			if strings.EqualFold(col.name, name) {
+				return i + 1
+			}
+		}
+	}
+
+	return defaultPosition
+}
+
+// changeColumn replaces the column definition (MySQL MODIFY and CHANGE)
+func (p *parser) changeColumn(t *table, oldName string) error {
+	if oldName == "" {
+		oldName = p.peek().text
+	}
+
+	oldColumn := t.findColumn(oldName, p.catalog.mysql)
+	if oldColumn == nil {
+		return fmt.Errorf("unknown column '%s' in '%s'", oldName, t.name)
+	}
+
+	col, err := p.parseColumnDefinition(t)
+	if err != nil {
+		return err
+	}
+
+	if !p.catalog.sameName(col.name, oldColumn.name) {
+		p.catalog.renameColumn(t, oldColumn, col.name)
+	}
+
+	index := slices.Index(t.columns, oldColumn)
+	t.columns = slices.Delete(t.columns, index, index+1)
+	t.columns = slices.Insert(t.columns, min(p.columnPosition(t, index), len(t.columns)), col)
+
+	return nil
+}
+
+func (p *parser) parseDropAction(t *table) error {
+	switch {
+	case p.accept("CONSTRAINT"), p.accept("CHECK"), p.accept("FOREIGN", "KEY"):
+		ifExists := p.ifExists()
+
+		name, err := p.identifier()
+		if err != nil {
+			return err
+		}
+
+		if !t.dropConstraint(name) && !ifExists {
+			return fmt.Errorf("constraint '%s' of relation '%s' does not exist", name, t.name)
+		}
+
+	case p.accept("PRIMARY", "KEY"):
+		t.indexes = slices.DeleteFunc(t.indexes, func(index *metadata.Index) bool { return index.IsPrimary })
+
+	case p.accept("INDEX"), p.accept("KEY"):
+		name, err := p.identifier()
+		if err != nil {
+			return err
+		}
+
+		t.indexes = slices.DeleteFunc(t.indexes, func(index *metadata.Index) bool {
+			return strings.EqualFold(index.Name, name)
+		})
+
+	default:
+		p.accept("COLUMN")
+		ifExists := p.ifExists()
+
+		name, err := p.identifier()
+		if err != nil {
+			return err
+		}
+
+		col := t.findColumn(name, p.catalog.mysql)
+		if col == nil {
+			if ifExists {
+				return nil
+			}
+			return fmt.Errorf("column '%s' of relation '%s' does not exist", name, t.name)
+		}
+
+		p.catalog.dropColumn(t, col)
+	}
+
+	return nil
+}
+
+func (p *parser) parseAlterColumn(t *table) error {
+	name, err := p.identifier()
+	if err != nil {
+		return err
+	}
+
+	col := t.findColumn(name, p.catalog.mysql)
+	if col == nil {
+		return fmt.Errorf("column '%s' of relation '%s' does not exist", name, t.name)
+	}
+
+	switch {
+	case p.accept("TYPE"), p.accept("SET", "DATA", "TYPE"):
+		colType, err := p.columnType()
+		if err != nil {
+			return err
+		}
+		return p.setColumnType(t, col, colType)
+
+	case p.accept("SET", "NOT", "NULL"):
+		col.isNullable = false
+
+	case p.accept("DROP", "NOT", "NULL"):
+		col.isNullable = true
+
+	case p.accept("SET", "DEFAULT"):
+		col.hasDefault = !strings.EqualFold(p.expression(), "NULL")
+
+	case p.accept("DROP", "DEFAULT"):
+		col.hasDefault = false
+
+	case p.accept("DROP", "EXPRESSION"):
+		col.isGenerated = false
+		col.hasDefault = false
+
+	case p.accept("ADD", "GENERATED"):
+		col.isNullable = false
+	}
+
+	return nil
+}
+
+func (p *parser) parseRenameAction(t *table) error {
+	switch {
+	case p.accept("CONSTRAINT"):
+		oldName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		if err := p.expect("TO"); err != nil {
+			return err
+		}
+		newName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		p.catalog.renameConstraint(t, oldName, newName)
+
+	case p.accept("INDEX"), p.accept("KEY"):
+		oldName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		if err := p.expect("TO"); err != nil {
+			return err
+		}
+		newName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		for _, index := range t.indexes {
+			if strings.EqualFold(index.Name, oldName) {
+				index.Name = newName
+			}
+		}
+
+	case p.accept("TO"), p.accept("AS"):
+		schema, newName, err := p.objectName()
+		if err != nil {
+			return err
+		}
+		p.catalog.renameTable(t, newName)
+		if schema != "" {
+			t.schema = schema
+		}
+
+	default:
+		p.accept("COLUMN")
+
+		oldName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		if err := p.expect("TO"); err != nil {
+			return err
+		}
+		newName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+
+		col := t.findColumn(oldName, p.catalog.mysql)
+		if col == nil {
+			return fmt.Errorf("column '%s' of relation '%s' does not exist", oldName, t.name)
+		}
+
+		p.catalog.renameColumn(t, col, newName)
+	}
+
+	return nil
+}
+
+// parseRenameTable parses MySQL RENAME TABLE statement
+func (p *parser) parseRenameTable() error {
+	for {
+		schema, name, err := p.objectName()
+		if err != nil {
+			return err
+		}
+
+		if err := p.expect("TO"); err != nil {
+			return err
+		}
+
+		newSchema, newName, err := p.objectName()
+		if err != nil {
+			return err
+		}
+
+		t := p.catalog.findTable(schema, name)
+		if t == nil {
+			return fmt.Errorf("table '%s' does not exist", name)
+		}
+
+		p.catalog.renameTable(t, newName)
+		if newSchema != "" {
+			t.schema = newSchema
+		}
+
+		if !p.accept(",") {
+			return nil
+		}
+	}
+}
+
+// ---------------------------------------------------//
+
+func (p *parser) parseCreateIndex(unique bool) error {
+	p.accept("CONCURRENTLY")
+	ifNotExists := p.ifNotExists()
+
+	var schema, name string
+
+	if !p.peek().is("ON") {
+		var err error
+		if schema, name, err = p.objectName(); err != nil {
+			return err
+		}
+	}
+
+	if p.accept("USING") {
+		p.next()
+	}
+
+	if err := p.expect("ON"); err != nil {
+		return err
+	}
+	p.accept("ONLY")
+
+	tableSchema, tableName, err := p.objectName()
+	if err != nil {
+		return err
+	}
+
+	t := p.catalog.findTable(tableSchema, tableName)
+	if t == nil {
+		return fmt.Errorf("relation '%s' does not exist", tableName)
+	}
+
+	if schema != "" && !p.catalog.sameName(schema, t.schema) {
+		return fmt.Errorf("index '%s' must be created in the same schema as table '%s'", name, tableName)
+	}
+
+	if ifNotExists && name != "" {
+		if _, index := p.catalog.findIndex(t.schema, name); index != nil {
+			return nil
+		}
+	}
+
+	columns, err := p.indexColumns()
+	if err != nil {
+		return err
+	}
+
+	var predicate string
+
+	for !p.atEnd() {
+		switch {
+		case p.accept("WHERE"):
+			predicate = p.expression()
+		case p.peek().is("("):
+			p.pos = p.closingParenthesis(p.pos) + 1
+		default:
+			p.next()
+		}
+	}
+
+	return p.catalog.addIndex(t, name, unique, false, predicate, columns)
+}
+
+// ---------------------------------------------------//
+
+func (p *parser) parseCreateType() error {
+	ifNotExists := p.ifNotExists()
+
+	schema, name, err := p.objectName()
+	if err != nil {
+		return err
+	}
+
+	newType := &userType{
+		schema: p.catalog.schemaOrCurrent(schema),
+		name:   name,
+	}
+
+	if !p.accept("AS") {
+		return nil // base or shell type
+	}
+
+	switch {
+	case p.accept("ENUM"):
+		newType.kind = metadata.EnumType
+		if newType.values, err = p.argumentList(); err != nil {
+			return err
+		}
+		if newType.values == nil {
+			newType.values = []string{}
+		}
+
+	case p.accept("RANGE"):
+		newType.kind = metadata.RangeType
+
+	case p.accept("("):
+		newType.kind = metadata.CompositeType
+
+		for !p.accept(")") {
+			if err := p.addAttribute(newType); err != nil {
+				return err
+			}
+
+			if !p.peek().is(")") {
+				if err := p.expect(","); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return p.catalog.addType(newType, ifNotExists)
+}
+
+// addAttribute parses composite type attribute definition, and adds the attribute to the composite type
+func (p *parser) addAttribute(compositeType *userType) error {
+	name, err := p.identifier()
+	if err != nil {
+		return err
+	}
+
+	if compositeType.findAttribute(name) != nil {
+		return fmt.Errorf("attribute '%s' specified more than once", name)
+	}
+
+	colType, err := p.columnType()
+	if err != nil {
+		return err
+	}
+
+	attribute := &column{
+		name:       name,
+		isNullable: true,
+	}
+
+	if err := p.catalog.setPostgresColumnType(attribute, colType); err != nil {
+		return err
+	}
+
+	p.skipElement() // COLLATE, CASCADE or RESTRICT
+
+	compositeType.attributes = append(compositeType.attributes, attribute)
+
+	return nil
+}
+
+func (p *parser) parseCreateDomain() error {
+	schema, name, err := p.objectName()
+	if err != nil {
+		return err
+	}
+
+	p.accept("AS")
+
+	colType, err := p.columnType()
+	if err != nil {
+		return err
+	}
+
+	domainColumn := &column{}
+	if err := p.catalog.setPostgresColumnType(domainColumn, colType); err != nil {
+		return err
+	}
+
+	return p.catalog.addType(&userType{
+		schema:   p.catalog.schemaOrCurrent(schema),
+		name:     name,
+		kind:     metadata.BaseType,
+		baseType: &domainColumn.dataType,
+	}, false)
+}
+
+func (p *parser) parseAlterType() error {
+	schema, name, err := p.objectName()
+	if err != nil {
+		return err
+	}
+
+	userType := p.catalog.findType(schema, name)
+	if userType == nil {
+		return fmt.Errorf("type '%s' does not exist", name)
+	}
+
+	switch {
+	case p.accept("ADD", "VALUE"):
+		ifNotExists := p.ifNotExists()
+
+		value, err := p.stringLiteral()
+		if err != nil {
+			return err
+		}
+
+		if slices.Contains(userType.values, value) {
+			if ifNotExists {
+				return nil
+			}
+			return fmt.Errorf("enum label '%s' already exists", value)
+		}
+
+		position := len(userType.values)
+		before := p.accept("BEFORE")
+
+		if before || p.accept("AFTER") {
+			neighbor, err := p.stringLiteral()
+			if err != nil {
+				return err
+			}
+
+			position = slices.Index(userType.values, neighbor)
+			if position < 0 {
+				return fmt.Errorf("'%s' is not an existing enum label", neighbor)
+			}
+			if !before {
+				position++
+			}
+		}
+
+		userType.values = slices.Insert(userType.values, position, value)
+
+	case p.accept("RENAME", "VALUE"):
+		oldValue, err := p.stringLiteral()
+		if err != nil {
+			return err
+		}
+		if err := p.expect("TO"); err != nil {
+			return err
+		}
+		newValue, err := p.stringLiteral()
+		if err != nil {
+			return err
+		}
+
+		index := slices.Index(userType.values, oldValue)
+		if index < 0 {
+			return fmt.Errorf("'%s' is not an existing enum label", oldValue)
+		}
+		userType.values[index] = newValue
+
+	case p.accept("RENAME", "TO"):
+		newName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		p.catalog.renameType(userType, newName)
+
+	case p.accept("RENAME", "ATTRIBUTE"):
+		oldName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		if err := p.expect("TO"); err != nil {
+			return err
+		}
+		newName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+
+		attribute := userType.findAttribute(oldName)
+		if attribute == nil {
+			return fmt.Errorf("attribute '%s' does not exist", oldName)
+		}
+		attribute.name = newName
+
+	default:
+		for {
+			if err := p.parseAlterTypeAttribute(userType); err != nil {
+				return err
+			}
+
+			if !p.accept(",") {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseAlterTypeAttribute parses composite type ADD, DROP and ALTER ATTRIBUTE actions
+func (p *parser) parseAlterTypeAttribute(userType *userType) error {
+	switch {
+	case p.accept("ADD", "ATTRIBUTE"):
+		return p.addAttribute(userType)
+
+	case p.accept("DROP", "ATTRIBUTE"):
+		ifExists := p.ifExists()
+
+		name, err := p.identifier()
+		if err != nil {
+			return err
+		}
+
+		attribute := userType.findAttribute(name)
+		if attribute == nil {
+			if ifExists {
+				return nil
+			}
+			return fmt.Errorf("attribute '%s' does not exist", name)
+		}
+
+		userType.attributes = slices.DeleteFunc(userType.attributes, func(other *column) bool { return other == attribute })
+		p.skipElement()
+
+	case p.accept("ALTER", "ATTRIBUTE"):
+		name, err := p.identifier()
+		if err != nil {
+			return err
+		}
+
+		attribute := userType.findAttribute(name)
+		if attribute == nil {
+			return fmt.Errorf("attribute '%s' does not exist", name)
+		}
+
+		p.accept("SET", "DATA")
+		if err := p.expect("TYPE"); err != nil {
+			return err
+		}
+
+		colType, err := p.columnType()
+		if err != nil {
+			return err
+		}
+
+		if err := p.catalog.setPostgresColumnType(attribute, colType); err != nil {
+			return err
+		}
+		p.skipElement()
+
+	default:
+		p.skipElement() // OWNER TO, SET SCHEMA, ...
+	}
+
+	return nil
+}
+
+func (p *parser) parseAlterView() error {
+	ifExists := p.ifExists()
+
+	schema, name, err := p.objectName()
+	if err != nil {
+		return err
+	}
+
+	t := p.catalog.findTable(schema, name)
+	if t == nil {
+		if ifExists {
+			return nil
+		}
+		return fmt.Errorf("relation '%s' does not exist", name)
+	}
+
+	if p.accept("RENAME", "TO") {
+		newName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		p.catalog.renameTable(t, newName)
+	}
+
+	return nil
+}
+
+// ---------------------------------------------------//
+
+func (p *parser) parseDrop() error {
+	switch {
+	case p.accept("TABLE"), p.accept("VIEW"), p.accept("MATERIALIZED", "VIEW"):
+		return p.dropObjects(p.catalog.dropTable)
+
+	case p.accept("TYPE"), p.accept("DOMAIN"):
+		return p.dropObjects(p.catalog.dropType)
+
+	case p.accept("INDEX"):
+		p.accept("CONCURRENTLY")
+
+		return p.dropObjects(func(schema, name string, ifExists bool) error {
+			if p.accept("ON") { // MySQL DROP INDEX name ON table
+				tableSchema, tableName, err := p.objectName()
+				if err != nil {
+					return err
+				}
+				schema = tableSchema
+				if p.catalog.findTable(tableSchema, tableName) == nil {
+					return fmt.Errorf("table '%s' does not exist", tableName)
+				}
+			}
+
+			t, index := p.catalog.findIndex(schema, name)
+			if index == nil {
+				if ifExists {
+					return nil
+				}
+				return fmt.Errorf("index '%s' does not exist", name)
+			}
+
+			t.indexes = slices.DeleteFunc(t.indexes, func(other *metadata.Index) bool { return other == index })
+
+			return nil
+		})
+	}
+
+	return nil
+}
+
+func (p *parser) dropObjects(drop func(schema, name string, ifExists bool) error) error {
+	ifExists := p.ifExists()
+
+	for {
+		schema, name, err := p.objectName()
+		if err != nil {
+			return err
+		}
+
+		if err := drop(schema, name, ifExists); err != nil {
+			return err
+		}
+
+		if !p.accept(",") {
+			return nil
+		}
+	}
+}
+
+// ---------------------------------------------------//
+
+func (p *parser) parseComment() error {
+	objectType := strings.ToUpper(p.next().text)
+	if objectType == "MATERIALIZED" {
+		objectType = strings.ToUpper(p.next().text)
+	}
+
+	if objectType != "TABLE" && objectType != "VIEW" && objectType != "COLUMN" && objectType != "TYPE" {
+		return nil
+	}
+
+	name, err := p.qualifiedName()
+	if err != nil {
+		return err
+	}
+
+	if err := p.expect("IS"); err != nil {
+		return err
+	}
+
+	var comment string
+	if !p.accept("NULL") {
+		if comment, err = p.stringLiteral(); err != nil {
+			return err
+		}
+	}
+
+	var columnName string
+
+	if objectType == "COLUMN" {
+		if len(name) < 2 {
+			return fmt.Errorf("column name must be qualified")
+		}
+		columnName = name[len(name)-1]
+		name = name[:len(name)-1]
+	}
+
+	var schema string
+	if len(name) > 1 {
+		schema = name[len(name)-2]
+	}
+
+	objectName := name[len(name)-1]
+
+	switch objectType {
+	case "TABLE", "VIEW":
+		if t := p.catalog.findTable(schema, objectName); t != nil {
+			t.comment = comment
+		}
+	case "COLUMN":
+		if t := p.catalog.findTable(schema, objectName); t != nil {
+			if col := t.findColumn(columnName, p.catalog.mysql); col != nil {
+				col.comment = comment
+			}
+		} else if userType := p.catalog.findType(schema, objectName); userType != nil {
+			if attribute := userType.findAttribute(columnName); attribute != nil {
+				attribute.comment = comment
+			}
+		}
+	case "TYPE":
+		if userType := p.catalog.findType(schema, objectName); userType != nil {
+			userType.comment = comment
+		}
+	}
+
+	return nil
+}
+
+// parseSet parses PostgreSQL SET search_path statement. The first schema of the search path is used as
+// the current schema for unqualified object names.
+func (p *parser) parseSet() error {
+	p.accept("SESSION")
+	p.accept("LOCAL")
+
+	if !p.accept("SEARCH_PATH") {
+		return nil
+	}
+
+	if !p.accept("TO") {
+		p.accept("=")
+	}
+
+	for !p.atEnd() {
+		tok := p.next()
+
+		switch {
+		case tok.is(",", "DEFAULT") || tok.text == "$user" || tok.text == "":
+			continue
+		case tok.kind == wordToken:
+			p.catalog.currentSchema = strings.ToLower(tok.text)
+		case tok.kind == stringToken || tok.kind == identifierToken:
+			p.catalog.currentSchema = tok.text
+		}
+
+		return nil
+	}
+
+	return nil
+}
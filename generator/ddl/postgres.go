@@ -0,0 +1,199 @@
+package ddl
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+// postgresTypeNames maps PostgreSQL data type names and aliases to internal type names (pg_type.typname)
+var postgresTypeNames = map[string]string{
+	"smallint":                    "int2",
+	"int2":                        "int2",
+	"smallserial":                 "int2",
+	"serial2":                     "int2",
+	"integer":                     "int4",
+	"int":                         "int4",
+	"int4":                        "int4",
+	"serial":                      "int4",
+	"serial4":                     "int4",
+	"bigint":                      "int8",
+	"int8":                        "int8",
+	"bigserial":                   "int8",
+	"serial8":                     "int8",
+	"real":                        "float4",
+	"float4":                      "float4",
+	"double precision":            "float8",
+	"float8":                      "float8",
+	"float":                       "float8",
+	"decimal":                     "numeric",
+	"numeric":                     "numeric",
+	"boolean":                     "bool",
+	"bool":                        "bool",
+	"character varying":           "varchar",
+	"char varying":                "varchar",
+	"varchar":                     "varchar",
+	"character":                   "bpchar",
+	"char":                        "bpchar",
+	"bpchar":                      "bpchar",
+	"bit varying":                 "varbit",
+	"varbit":                      "varbit",
+	"timestamp":                   "timestamp",
+	"timestamp without time zone": "timestamp",
+	"timestamp with time zone":    "timestamptz",
+	"timestamptz":                 "timestamptz",
+	"time":                        "time",
+	"time without time zone":      "time",
+	"time with time zone":         "timetz",
+	"timetz":                      "timetz",
+}
+
+var postgresRangeTypes = map[string]bool{
+	"int4range": true,
+	"int8range": true,
+	"numrange":  true,
+	"tsrange":   true,
+	"tstzrange": true,
+	"daterange": true,
+}
+
+var postgresSerialTypes = map[string]bool{
+	"smallserial": true,
+	"serial2":     true,
+	"serial":      true,
+	"serial4":     true,
+	"bigserial":   true,
+	"serial8":     true,
+}
+
+// setPostgresColumnType resolves column data type in the same format as pg_catalog returns it. Domains are
+// resolved to the domain base type, keeping the domain name, and serial types add NOT NULL constraint and
+// default value.
+func (c *catalog) setPostgresColumnType(col *column, colType columnType) error {
+	name := colType.name()
+
+	col.dataType = metadata.DataType{
+		Name:          name,
+		Kind:          metadata.BaseType,
+		Dimensions:    colType.dimensions,
+		SourceDialect: postgres.Dialect.Name(),
+	}
+
+	if strings.HasPrefix(name, "interval") {
+		col.dataType.Name = "interval"
+		return nil
+	}
+
+	if typeName, ok := postgresTypeNames[name]; ok && (colType.schema == "" || colType.schema == "pg_catalog") {
+		col.dataType.Name = typeName
+
+		if name == "float" && len(colType.args) > 0 {
+			if precision, err := strconv.Atoi(colType.args[0]); err == nil && precision <= 24 {
+				col.dataType.Name = "float4"
+			}
+		}
+
+		if postgresSerialTypes[name] {
+			col.isNullable = false
+			col.hasDefault = true
+		}
+
+		return nil
+	}
+
+	if postgresRangeTypes[name] {
+		col.dataType.Kind = metadata.RangeType
+		return nil
+	}
+
+	if userType := c.findType(colType.schema, name); userType != nil {
+		if userType.baseType != nil {
+			col.dataType.Name = userType.baseType.Name
+			col.dataType.Kind = userType.baseType.Kind
+			col.dataType.Dimensions += userType.baseType.Dimensions
+			col.dataType.Schema = userType.baseType.Schema
+			col.dataType.Domain = userType.name
+		} else {
+			col.dataType.Name = userType.name
+			col.dataType.Kind = userType.kind
+
+			if userType.kind == metadata.EnumType || userType.kind == metadata.CompositeType {
+				col.dataType.Schema = userType.schema
+			}
+		}
+	}
+
+	return nil
+}
+
+// postgresObjectName returns unused name for implicitly named index or constraint, in the same way as PostgreSQL
+// chooses it, for instance 'film_pkey', 'film_title_key' or 'film_language_id_fkey'.
+func (c *catalog) postgresObjectName(t *table, columns []string, label string) string {
+	var columnNames []string
+
+	for _, column := range columns {
+		switch {
+		case isIdentifierText(column):
+			columnNames = append(columnNames, column)
+		case strings.Contains(column, "("): // function call expression
+			columnNames = append(columnNames, strings.ToLower(strings.TrimSpace(column[:strings.Index(column, "(")])))
+		default:
+			columnNames = append(columnNames, "expr")
+		}
+	}
+
+	for i := 0; ; i++ {
+		suffix := label
+		if i > 0 {
+			suffix += strconv.Itoa(i)
+		}
+
+		name := postgresMakeObjectName(t.name, strings.Join(columnNames, "_"), suffix)
+
+		if !c.isRelationNameUsed(t.schema, name) && !c.isConstraintNameUsed(t.schema, name) {
+			return name
+		}
+	}
+}
+
+// postgresMaxIdentifierLength is maximum length of PostgreSQL identifier (NAMEDATALEN - 1)
+const postgresMaxIdentifierLength = 63
+
+// postgresMakeObjectName joins name parts with underscores. If the name is too long, the longer of the first
+// two parts is truncated, as PostgreSQL makeObjectName does.
+func postgresMakeObjectName(name1, name2, label string) string {
+	for {
+		length := len(name1) + len(label) + 1
+		if name2 != "" {
+			length += len(name2) + 1
+		}
+
+		if length <= postgresMaxIdentifierLength {
+			break
+		}
+
+		if len(name1) >= len(name2) {
+			name1 = name1[:len(name1)-1]
+		} else {
+			name2 = name2[:len(name2)-1]
+		}
+	}
+
+	if name2 == "" {
+		return name1 + "_" + label
+	}
+
+	return name1 + "_" + name2 + "_" + label
+}
+
+func isIdentifierText(text string) bool {
+	for _, c := range text {
+		if !isWordChar(c) {
+			return false
+		}
+	}
+
+	return text != ""
+}
@@ -0,0 +1,624 @@
+package ddl
+
+import (
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+func (p *parser) parseCreateView(kind tableKind, orReplace bool) error {
+	ifNotExists := p.ifNotExists()
+
+	schema, name, err := p.objectName()
+	if err != nil {
+		return err
+	}
+
+	newView := &table{
+		schema: p.catalog.schemaOrCurrent(schema),
+		name:   name,
+		kind:   kind,
+	}
+
+	var columnNames []string
+
+	if p.peek().is("(") {
+		if columnNames, err = p.identifierList(); err != nil {
+			return err
+		}
+	}
+
+	for !p.atEnd() && !p.peek().is("AS") {
+		p.next() // WITH (view options), USING, TABLESPACE
+	}
+
+	if err := p.expect("AS"); err != nil {
+		return err
+	}
+
+	if newView.columns, err = p.selectColumns(nil); err != nil {
+		return err
+	}
+
+	for i, columnName := range columnNames {
+		if i < len(newView.columns) {
+			newView.columns[i].name = columnName
+		}
+	}
+
+	if orReplace {
+		if existing := p.catalog.findTable(newView.schema, newView.name); existing != nil {
+			existing.columns = newView.columns
+			return nil
+		}
+	}
+
+	return p.catalog.addTable(newView, ifNotExists)
+}
+
+// selectSource is table, view or subquery in the FROM clause of the select statement
+type selectSource struct {
+	alias    string
+	columns  []*column
+	nullable bool // source is on the nullable side of the outer join
+}
+
+var selectClauseEndWords = []string{"FROM", "INTO", "WHERE", "GROUP", "HAVING", "WINDOW", "ORDER", "LIMIT",
+	"OFFSET", "FETCH", "UNION", "EXCEPT", "INTERSECT", "FOR", "WITH"}
+
+var joinWords = []string{"JOIN", "INNER", "LEFT", "RIGHT", "FULL", "CROSS", "NATURAL", "OUTER", "STRAIGHT_JOIN",
+	"ON", "USING", "LATERAL"}
+
+// selectColumns resolves column names and data types of the select statement result. Column data types that can
+// not be resolved from the referenced tables or cast expressions are reported as text.
+func (p *parser) selectColumns(commonTables map[string][]*column) ([]*column, error) {
+	for p.peek().is("(") && p.closingParenthesis(p.pos) >= len(p.tokens)-1 {
+		p.next()
+	}
+
+	if p.accept("WITH") {
+		var err error
+		if commonTables, err = p.commonTableExpressions(commonTables); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.accept("TABLE") { // TABLE name is short form of SELECT * FROM name
+		source, err := p.selectSource(commonTables)
+		if err != nil {
+			return nil, err
+		}
+		return p.copySourceColumns(source.columns, false), nil
+	}
+
+	if err := p.expect("SELECT"); err != nil {
+		return nil, err
+	}
+
+	if p.accept("DISTINCT") && p.accept("ON") {
+		p.pos = p.closingParenthesis(p.pos) + 1
+	}
+	p.accept("ALL")
+
+	var items [][2]int
+
+	for start := p.pos; ; {
+		p.expression(selectClauseEndWords...)
+		items = append(items, [2]int{start, p.pos})
+
+		if !p.accept(",") {
+			break
+		}
+		start = p.pos
+	}
+
+	var sources []selectSource
+
+	if p.accept("FROM") {
+		var err error
+		if sources, err = p.selectSources(commonTables); err != nil {
+			return nil, err
+		}
+	}
+
+	var ret []*column
+
+	for _, item := range items {
+		ret = append(ret, p.subParser(item[0], item[1]).selectItemColumns(sources)...)
+	}
+
+	return ret, nil
+}
+
+// commonTableExpressions parses WITH clause, and returns columns of the common table expressions
+func (p *parser) commonTableExpressions(commonTables map[string][]*column) (map[string][]*column, error) {
+	ret := map[string][]*column{}
+	for name, columns := range commonTables {
+		ret[name] = columns
+	}
+
+	p.accept("RECURSIVE")
+
+	for {
+		name, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+
+		var columnNames []string
+		if p.peek().is("(") {
+			if columnNames, err = p.identifierList(); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := p.expect("AS"); err != nil {
+			return nil, err
+		}
+		p.accept("NOT")
+		p.accept("MATERIALIZED")
+
+		if !p.peek().is("(") {
+			return nil, p.unexpected("(")
+		}
+
+		end := p.closingParenthesis(p.pos)
+
+		columns, err := p.subParser(p.pos+1, end).selectColumns(ret)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, columnName := range columnNames {
+			if i < len(columns) {
+				columns[i].name = columnName
+			}
+		}
+
+		ret[name] = columns
+		p.pos = end + 1
+
+		if !p.accept(",") {
+			return ret, nil
+		}
+	}
+}
+
+// selectSources parses FROM clause list of tables and joins
+func (p *parser) selectSources(commonTables map[string][]*column) ([]selectSource, error) {
+	var ret []selectSource
+
+	for !p.atEnd() && !p.peek().is(selectClauseEndWords...) && !p.peek().is(")") {
+		nullable := false
+
+		switch {
+		case p.accept(","):
+		case p.peek().is(joinWords...):
+			for p.peek().is(joinWords...) && !p.peek().is("ON", "USING") {
+				tok := p.next()
+
+				switch {
+				case tok.is("LEFT"):
+					nullable = true
+				case tok.is("RIGHT"):
+					for i := range ret {
+						ret[i].nullable = true
+					}
+				case tok.is("FULL"):
+					nullable = true
+					for i := range ret {
+						ret[i].nullable = true
+					}
+				}
+			}
+
+			if p.accept("ON") {
+				p.expression(append(selectClauseEndWords, joinWords...)...)
+				continue
+			}
+
+			if p.accept("USING") {
+				p.pos = p.closingParenthesis(p.pos) + 1
+				continue
+			}
+		}
+
+		if p.atEnd() || p.peek().is(selectClauseEndWords...) {
+			break
+		}
+
+		if p.isParenthesizedJoin() {
+			end := p.closingParenthesis(p.pos)
+
+			joined, err := p.subParser(p.pos+1, end).selectSources(commonTables)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, source := range joined {
+				source.nullable = source.nullable || nullable
+				ret = append(ret, source)
+			}
+
+			p.pos = end + 1
+			continue
+		}
+
+		source, err := p.selectSource(commonTables)
+		if err != nil {
+			return nil, err
+		}
+
+		source.nullable = nullable
+		ret = append(ret, source)
+	}
+
+	return ret, nil
+}
+
+// isParenthesizedJoin returns true if the next FROM clause item is join in parenthesis, and not a subquery
+func (p *parser) isParenthesizedJoin() bool {
+	i := p.pos
+	for i < len(p.tokens) && p.tokens[i].is("(") {
+		i++
+	}
+
+	return i > p.pos && i < len(p.tokens) && !p.tokens[i].is("SELECT", "WITH", "TABLE", "VALUES")
+}
+
+// selectSource parses table, view, subquery or table function reference with optional alias
+func (p *parser) selectSource(commonTables map[string][]*column) (selectSource, error) {
+	var ret selectSource
+
+	p.accept("ONLY")
+
+	switch {
+	case p.peek().is("("):
+		end := p.closingParenthesis(p.pos)
+
+		if p.peekAt(1).is("SELECT", "WITH", "TABLE", "(") {
+			columns, err := p.subParser(p.pos+1, end).selectColumns(commonTables)
+			if err != nil {
+				return ret, err
+			}
+			ret.columns = columns
+		}
+
+		p.pos = end + 1
+
+	default:
+		schema, name, err := p.objectName()
+		if err != nil {
+			return ret, err
+		}
+		ret.alias = name
+
+		switch {
+		case p.peek().is("("): // table function
+			p.pos = p.closingParenthesis(p.pos) + 1
+		case schema == "" && commonTables[name] != nil:
+			ret.columns = commonTables[name]
+		default:
+			// relations not created by the script (for instance system catalogs) have unknown columns
+			if t := p.catalog.findTable(schema, name); t != nil {
+				ret.columns = t.columns
+			}
+		}
+	}
+
+	p.accept("*")
+
+	if p.accept("AS") || p.isIdentifier(p.peek()) && !p.peek().is(selectClauseEndWords...) && !p.peek().is(joinWords...) {
+		alias, err := p.identifier()
+		if err != nil {
+			return ret, err
+		}
+		ret.alias = alias
+
+		if p.peek().is("(") {
+			columnNames, err := p.identifierList()
+			if err != nil {
+				return ret, err
+			}
+
+			ret.columns = p.copySourceColumns(ret.columns, false)
+			for i, columnName := range columnNames {
+				if i < len(ret.columns) {
+					ret.columns[i].name = columnName
+				}
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+func (p *parser) copySourceColumns(columns []*column, nullable bool) []*column {
+	var ret []*column
+
+	for _, col := range columns {
+		ret = append(ret, p.viewColumn(col.name, col, nullable))
+	}
+
+	return ret
+}
+
+// viewColumn returns the view column derived from the source column. PostgreSQL view columns are always
+// nullable and MySQL view columns are nullable if the source column is nullable.
+func (p *parser) viewColumn(name string, source *column, nullable bool) *column {
+	ret := &column{
+		name:       name,
+		dataType:   source.dataType,
+		enumValues: source.enumValues,
+		isNullable: true,
+	}
+
+	if p.catalog.mysql {
+		ret.isNullable = source.isNullable || nullable
+	}
+
+	return ret
+}
+
+// selectItemColumns returns columns of the select list item
+func (p *parser) selectItemColumns(sources []selectSource) []*column {
+	if len(p.tokens) == 0 {
+		return nil
+	}
+
+	if len(p.tokens) == 1 && p.tokens[0].is("*") {
+		var ret []*column
+		for _, source := range sources {
+			ret = append(ret, p.copySourceColumns(source.columns, source.nullable)...)
+		}
+		return ret
+	}
+
+	if len(p.tokens) >= 3 && p.tokens[len(p.tokens)-1].is("*") && p.tokens[len(p.tokens)-2].is(".") {
+		p.pos = len(p.tokens) - 3
+		alias, _ := p.identifier()
+
+		for _, source := range sources {
+			if p.catalog.sameName(source.alias, alias) {
+				return p.copySourceColumns(source.columns, source.nullable)
+			}
+		}
+
+		return nil
+	}
+
+	end := len(p.tokens)
+	alias := ""
+
+	switch last := p.tokens[end-1]; {
+	case end >= 2 && p.tokens[end-2].is("AS"):
+		p.pos = end - 1
+		alias, _ = p.identifier()
+		if last.kind == stringToken { // MySQL allows string literal alias
+			alias = last.text
+		}
+		end -= 2
+	case end >= 2 && p.isIdentifier(last) && !last.is("END", "NULL", "TRUE", "FALSE") &&
+		(p.isIdentifier(p.tokens[end-2]) && !p.tokens[end-2].is("IS", "NOT", "AND", "OR", "ELSE", "THEN", "INTERVAL") ||
+			p.tokens[end-2].is(")")):
+		p.pos = end - 1
+		alias, _ = p.identifier()
+		end--
+	}
+
+	expression := p.subParser(0, end)
+	col := expression.expressionColumn(sources)
+
+	if alias != "" {
+		col.name = alias
+	}
+
+	return []*column{col}
+}
+
+// expressionColumn returns column with name and data type of the expression
+func (p *parser) expressionColumn(sources []selectSource) *column {
+	col := &column{
+		name:       p.textRange(0, len(p.tokens)),
+		isNullable: true,
+	}
+
+	if !p.catalog.mysql {
+		col.name = "?column?"
+	}
+
+	// column reference
+	if p.isIdentifier(p.peek()) {
+		if parts, err := p.qualifiedName(); err == nil && p.atEnd() {
+			if source := p.findSourceColumn(sources, parts); source != nil {
+				return source
+			}
+			col.name = parts[len(parts)-1]
+		}
+		p.pos = 0
+	}
+
+	// type cast
+	if castPosition := p.lastTopLevel("::"); castPosition > 0 {
+		inner := p.subParser(0, castPosition).expressionColumn(sources)
+
+		p.pos = castPosition + 1
+		if colType, err := p.columnType(); err == nil && p.atEnd() {
+			p.setExpressionType(col, colType)
+			col.name = inner.name
+			if inner.name == "?column?" {
+				col.name = col.dataType.Name
+			}
+			return col
+		}
+		p.pos = 0
+	}
+
+	first := p.peek()
+
+	switch {
+	case first.is("CAST") && p.peekAt(1).is("(") && p.closingParenthesis(1) == len(p.tokens)-1 &&
+		p.subParser(2, len(p.tokens)-1).lastTopLevel("AS") > 0:
+		asPosition := p.subParser(2, len(p.tokens)-1).lastTopLevel("AS") + 2
+		inner := p.subParser(2, asPosition).expressionColumn(sources)
+
+		p.pos = asPosition + 1
+		if colType, err := p.columnType(); err == nil {
+			p.setExpressionType(col, colType)
+		}
+
+		if !p.catalog.mysql {
+			col.name = inner.name
+			if inner.name == "?column?" {
+				col.name = col.dataType.Name
+			}
+		}
+
+	case p.isIdentifier(first) && p.peekAt(1).is("(") && p.closingParenthesis(1) == len(p.tokens)-1:
+		functionName := strings.ToLower(first.text)
+		if !p.catalog.mysql {
+			col.name = functionName
+		}
+
+		args := p.subParser(2, len(p.tokens)-1)
+
+		switch functionName {
+		case "count":
+			col.dataType = p.builtinDataType("int8", "bigint")
+			col.isNullable = !p.catalog.mysql
+		case "sum", "avg":
+			col.dataType = p.builtinDataType("numeric", "decimal")
+		case "now", "current_timestamp":
+			col.dataType = p.builtinDataType("timestamptz", "datetime")
+		case "min", "max", "coalesce", "nullif", "greatest", "least", "lower", "upper", "trim", "abs", "round":
+			argument := args.subParser(0, args.firstTopLevel(",")).expressionColumn(sources)
+			col.dataType = argument.dataType
+			col.enumValues = argument.enumValues
+		default:
+			col.dataType = p.builtinDataType("text", "text")
+		}
+
+	case len(p.tokens) == 1 && first.kind == numberToken:
+		if strings.ContainsAny(first.text, ".eE") {
+			col.dataType = p.builtinDataType("numeric", "decimal")
+		} else {
+			col.dataType = p.builtinDataType("int4", "int")
+		}
+		col.isNullable = !p.catalog.mysql
+
+	case len(p.tokens) == 1 && first.is("TRUE", "FALSE"):
+		col.dataType = p.builtinDataType("bool", "int")
+		if !p.catalog.mysql {
+			col.name = "bool"
+		}
+
+	case len(p.tokens) == 1 && first.is("CURRENT_TIMESTAMP", "LOCALTIMESTAMP", "CURRENT_DATE"):
+		switch {
+		case first.is("CURRENT_DATE"):
+			col.dataType = p.builtinDataType("date", "date")
+		case first.is("LOCALTIMESTAMP"):
+			col.dataType = p.builtinDataType("timestamp", "datetime")
+		default:
+			col.dataType = p.builtinDataType("timestamptz", "datetime")
+		}
+		if !p.catalog.mysql {
+			col.name = strings.ToLower(first.text)
+		}
+
+	default:
+		col.dataType = p.builtinDataType("text", "text")
+	}
+
+	if col.dataType.Name == "" {
+		col.dataType = p.builtinDataType("text", "text")
+	}
+
+	return col
+}
+
+func (p *parser) builtinDataType(postgresName, mysqlName string) metadata.DataType {
+	if p.catalog.mysql {
+		return metadata.DataType{Name: mysqlName, Kind: metadata.BaseType, SourceDialect: mysql.Dialect.Name()}
+	}
+
+	return metadata.DataType{Name: postgresName, Kind: metadata.BaseType, SourceDialect: postgres.Dialect.Name()}
+}
+
+func (p *parser) setExpressionType(col *column, colType columnType) {
+	typedColumn := &column{}
+
+	if p.catalog.mysql {
+		colType.words = mysqlCastTypeWords(colType.words)
+		_ = p.catalog.setMySQLColumnType(&table{}, typedColumn, colType)
+	} else {
+		_ = p.catalog.setPostgresColumnType(typedColumn, colType)
+	}
+
+	col.dataType = typedColumn.dataType
+	col.enumValues = typedColumn.enumValues
+}
+
+// mysqlCastTypeWords maps MySQL CAST target types to column data types
+func mysqlCastTypeWords(words []string) []string {
+	switch strings.Join(words, " ") {
+	case "signed", "signed integer":
+		return []string{"bigint"}
+	case "unsigned", "unsigned integer":
+		return []string{"bigint"}
+	case "char", "nchar":
+		return []string{"varchar"}
+	}
+
+	return words
+}
+
+// findSourceColumn returns view column for the column reference (column, table.column or schema.table.column)
+func (p *parser) findSourceColumn(sources []selectSource, parts []string) *column {
+	columnName := parts[len(parts)-1]
+
+	for _, source := range sources {
+		if len(parts) > 1 && !p.catalog.sameName(source.alias, parts[len(parts)-2]) {
+			continue
+		}
+
+		for _, col := range source.columns {
+			if p.catalog.sameName(col.name, columnName) {
+				return p.viewColumn(col.name, col, source.nullable)
+			}
+		}
+	}
+
+	return nil
+}
+
+// lastTopLevel returns position of the last top level token equal to the word, or -1
+func (p *parser) lastTopLevel(word string) int {
+	ret := -1
+
+	for i := 0; i < len(p.tokens); i++ {
+		switch {
+		case p.tokens[i].is("(", "["):
+			i = p.closingParenthesis(i)
+		case p.tokens[i].is(word):
+			ret = i
+		}
+	}
+
+	return ret
+}
+
+// firstTopLevel returns position of the first top level token equal to the word, or number of tokens
+func (p *parser) firstTopLevel(word string) int {
+	for i := 0; i < len(p.tokens); i++ {
+		switch {
+		case p.tokens[i].is("(", "["):
+			i = p.closingParenthesis(i)
+		case p.tokens[i].is(word):
+			return i
+		}
+	}
+
+	return len(p.tokens)
+}
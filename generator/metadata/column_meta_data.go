@@ -2,16 +2,18 @@ package metadata
 
 // Column struct
 type Column struct {
-	Name         string `sql:"primary_key"`
-	IsPrimaryKey bool
-	IsNullable   bool
-	IsGenerated  bool
-	HasDefault   bool
-	DataType     DataType
-	Comment      string
+	Name            string `sql:"primary_key"`
+	IsPrimaryKey    bool
+	IsNullable      bool
+	IsGenerated     bool
+	IsHidden        bool // hidden column of the virtual table (SQLite only)
+	HasDefault      bool
+	IsAutoIncrement bool // MySQL AUTO_INCREMENT column or SQLite rowid alias column
+	DataType        DataType
+	Comment         string
 }
 
-// DataTypeKind is database type kind(base, enum, user-defined, array)
+// DataTypeKind is database type kind(base, enum, user-defined, range, composite)
 type DataTypeKind string
 
 // DataTypeKind possible values
@@ -20,6 +22,7 @@ const (
 	EnumType        DataTypeKind = "enum"
 	UserDefinedType DataTypeKind = "user-defined"
 	RangeType       DataTypeKind = "range"
+	CompositeType   DataTypeKind = "composite"
 )
 
 // DataType contains information about column data type
@@ -27,7 +30,9 @@ type DataType struct {
 	Name          string
 	Kind          DataTypeKind
 	IsUnsigned    bool
-	Dimensions    int // The number of array dimensions
+	Dimensions    int    // The number of array dimensions
+	Schema        string // The schema of the enum or composite type (PostgreSQL only)
+	Domain        string // The name of the domain, if column type is a domain. Other properties describe domain base type. (PostgreSQL only)
 	SourceDialect string
 }
 
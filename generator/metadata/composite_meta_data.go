@@ -0,0 +1,8 @@
+package metadata
+
+// Composite type metadata struct (PostgreSQL only)
+type Composite struct {
+	Name       string `sql:"primary_key"`
+	Comment    string
+	Attributes []Column
+}
@@ -20,6 +20,11 @@ type DialectQuerySet interface {
 	GetEnumsMetaData(db *sql.DB, schemaName string) ([]Enum, error)
 }
 
+// CompositeTypesQuerySet is implemented by the dialect query sets of the databases with composite types
+type CompositeTypesQuerySet interface {
+	GetCompositesMetaData(db *sql.DB, schemaName string) ([]Composite, error)
+}
+
 // GetSchema retrieves Schema information from database
 func GetSchema(db *sql.DB, querySet DialectQuerySet, schemaName string) (Schema, error) {
 	tablesMetaData, err := querySet.GetTablesMetaData(db, schemaName, BaseTable)
@@ -44,8 +49,25 @@ func GetSchema(db *sql.DB, querySet DialectQuerySet, schemaName string) (Schema,
 		EnumsMetaData:  enumsMetaData,
 	}
 
-	fmt.Println("	FOUND", len(ret.TablesMetaData), "table(s),", len(ret.ViewsMetaData), "view(s),",
-		len(ret.EnumsMetaData), "enum(s)")
+	if compositeTypesQuerySet, ok := querySet.(CompositeTypesQuerySet); ok {
+		ret.CompositesMetaData, err = compositeTypesQuerySet.GetCompositesMetaData(db, schemaName)
+		if err != nil {
+			return Schema{}, fmt.Errorf("failed to get %s composite types metadata: %w", schemaName, err)
+		}
+	}
+
+	printFound(ret)
 
 	return ret, nil
 }
+
+func printFound(schema Schema) {
+	found := fmt.Sprint("	FOUND ", len(schema.TablesMetaData), " table(s), ", len(schema.ViewsMetaData), " view(s), ",
+		len(schema.EnumsMetaData), " enum(s)")
+
+	if len(schema.CompositesMetaData) > 0 {
+		found += fmt.Sprint(", ", len(schema.CompositesMetaData), " composite type(s)")
+	}
+
+	fmt.Println(found)
+}
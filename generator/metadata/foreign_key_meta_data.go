@@ -0,0 +1,41 @@
+package metadata
+
+// ForeignKey metadata struct
+type ForeignKey struct {
+	Name              string
+	Columns           []string
+	ReferencedSchema  string
+	ReferencedTable   string
+	ReferencedColumns []string
+}
+
+// ForeignKeyColumn is a single column of the foreign key constraint
+type ForeignKeyColumn struct {
+	Name             string
+	Column           string
+	ReferencedSchema string
+	ReferencedTable  string
+	ReferencedColumn string
+}
+
+// NewForeignKeys groups list of foreign key columns into list of foreign keys. Columns of the same
+// foreign key have to be next to each other, ordered by the position in the constraint.
+func NewForeignKeys(columns []ForeignKeyColumn) []ForeignKey {
+	var ret []ForeignKey
+
+	for i, column := range columns {
+		if i == 0 || column.Name != columns[i-1].Name {
+			ret = append(ret, ForeignKey{
+				Name:             column.Name,
+				ReferencedSchema: column.ReferencedSchema,
+				ReferencedTable:  column.ReferencedTable,
+			})
+		}
+
+		foreignKey := &ret[len(ret)-1]
+		foreignKey.Columns = append(foreignKey.Columns, column.Column)
+		foreignKey.ReferencedColumns = append(foreignKey.ReferencedColumns, column.ReferencedColumn)
+	}
+
+	return ret
+}
@@ -0,0 +1,67 @@
+package metadata
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Index metadata struct
+type Index struct {
+	Name         string `sql:"primary_key"`
+	IsPrimary    bool
+	IsUnique     bool
+	IsConstraint bool   // index is created for PRIMARY KEY or UNIQUE constraint
+	Predicate    string // partial index predicate
+	Columns      []string
+}
+
+// CheckConstraint metadata struct
+type CheckConstraint struct {
+	Name       string
+	Expression string
+	Columns    []string
+}
+
+// NewCheckConstraint creates new check constraint from the expression. Check constraint columns are table columns
+// referenced in the expression.
+func NewCheckConstraint(name, expression string, tableColumns []Column) CheckConstraint {
+	ret := CheckConstraint{
+		Name:       name,
+		Expression: expression,
+	}
+
+	for _, column := range tableColumns {
+		if referencesColumn(expression, column.Name) {
+			ret.Columns = append(ret.Columns, column.Name)
+		}
+	}
+
+	return ret
+}
+
+// referencesColumn returns true if expression contains quoted or unquoted column name, which is not part of other
+// identifier or string literal, and is not a function name.
+func referencesColumn(expression, columnName string) bool {
+	quote := `"` + "`"
+	identifier := regexp.MustCompile(`(?i)(?:^|[^\w'` + quote + `])[` + quote + `]?` + regexp.QuoteMeta(columnName) + `[` + quote + `]?(?:$|[^\w'` + quote + `])`)
+
+	for _, match := range identifier.FindAllStringIndex(expression, -1) {
+		rest := strings.TrimLeft(expression[match[1]-1:], " \t\n")
+		if !strings.HasPrefix(rest, "(") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TrimIdentifierQuotes removes double quotes or backticks around identifier
+func TrimIdentifierQuotes(identifier string) string {
+	if len(identifier) >= 2 &&
+		(strings.HasPrefix(identifier, `"`) && strings.HasSuffix(identifier, `"`) ||
+			strings.HasPrefix(identifier, "`") && strings.HasSuffix(identifier, "`")) {
+		return identifier[1 : len(identifier)-1]
+	}
+
+	return identifier
+}
@@ -1,14 +1,79 @@
 package metadata
 
+import (
+	"path"
+	"slices"
+	"strings"
+)
+
 // Schema struct
 type Schema struct {
 	Name           string
 	TablesMetaData []Table
 	ViewsMetaData  []Table
 	EnumsMetaData  []Enum
+	// CompositesMetaData contains composite types metadata (PostgreSQL only)
+	CompositesMetaData []Composite
 }
 
-// IsEmpty returns true if schema info does not contain any table, views or enums metadata
+// IsEmpty returns true if schema info does not contain any table, views, enums or composite types metadata
 func (s Schema) IsEmpty() bool {
-	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.EnumsMetaData) == 0
+	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.EnumsMetaData) == 0 &&
+		len(s.CompositesMetaData) == 0
+}
+
+// Table returns schema table with the name, or false if the schema does not have such table
+func (s Schema) Table(name string) (Table, bool) {
+	for _, table := range s.TablesMetaData {
+		if table.Name == name {
+			return table, true
+		}
+	}
+
+	return Table{}, false
+}
+
+// Composite returns schema composite type with the name, or false if the schema does not have such type
+func (s Schema) Composite(name string) (Composite, bool) {
+	for _, compositeType := range s.CompositesMetaData {
+		if compositeType.Name == name {
+			return compositeType, true
+		}
+	}
+
+	return Composite{}, false
+}
+
+// MatchSchemaNames returns schema names selected with comma-separated list of schema names and glob patterns
+// (for instance 'public,sales_*'). Glob patterns are matched against the existing schema names, while the names
+// without pattern characters are always selected. Returned names are in the list order, with the names matching
+// the same pattern sorted.
+func MatchSchemaNames(schemaList string, existingSchemaNames []string) []string {
+	var ret []string
+
+	for _, pattern := range strings.Split(schemaList, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		if !strings.ContainsAny(pattern, "*?[\\") {
+			if !slices.Contains(ret, pattern) {
+				ret = append(ret, pattern)
+			}
+			continue
+		}
+
+		var matches []string
+		for _, schemaName := range existingSchemaNames {
+			if ok, _ := path.Match(pattern, schemaName); ok && !slices.Contains(ret, schemaName) && !slices.Contains(matches, schemaName) {
+				matches = append(matches, schemaName)
+			}
+		}
+
+		slices.Sort(matches)
+		ret = append(ret, matches...)
+	}
+
+	return ret
 }
@@ -0,0 +1,17 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchSchemaNames(t *testing.T) {
+	existing := []string{"public", "sales_eu", "sales_us", "audit"}
+
+	require.Equal(t, []string{"public"}, MatchSchemaNames("public", nil))
+	require.Equal(t, []string{"public", "missing"}, MatchSchemaNames("public, missing", existing))
+	require.Equal(t, []string{"public", "sales_eu", "sales_us"}, MatchSchemaNames("public,sales_*", existing))
+	require.Equal(t, []string{"sales_eu", "sales_us"}, MatchSchemaNames("sales_??,sales_eu", existing))
+	require.Empty(t, MatchSchemaNames("hr_*", existing))
+}
@@ -2,9 +2,77 @@ package metadata
 
 // Table metadata struct
 type Table struct {
-	Name    string `sql:"primary_key"`
-	Comment string
-	Columns []Column
+	Name             string `sql:"primary_key"`
+	Comment          string
+	Columns          []Column
+	ForeignKeys      []ForeignKey
+	Indexes          []Index
+	CheckConstraints []CheckConstraint
+}
+
+// Column returns table column with the name, or false if the table does not have such column
+func (t Table) Column(name string) (Column, bool) {
+	for _, column := range t.Columns {
+		if column.Name == name {
+			return column, true
+		}
+	}
+
+	return Column{}, false
+}
+
+// UniqueIndexes returns list of table unique indexes and unique constraints, excluding primary key
+func (t Table) UniqueIndexes() []Index {
+	var ret []Index
+
+	for _, index := range t.Indexes {
+		if index.IsUnique && !index.IsPrimary {
+			ret = append(ret, index)
+		}
+	}
+
+	return ret
+}
+
+// ColumnCheckConstraints returns list of check constraints referencing only the column with the name
+func (t Table) ColumnCheckConstraints(columnName string) []CheckConstraint {
+	var ret []CheckConstraint
+
+	for _, checkConstraint := range t.CheckConstraints {
+		if len(checkConstraint.Columns) == 1 && checkConstraint.Columns[0] == columnName {
+			ret = append(ret, checkConstraint)
+		}
+	}
+
+	return ret
+}
+
+// VisibleColumns returns list of table columns without hidden columns of the virtual table
+func (t Table) VisibleColumns() []Column {
+	var ret []Column
+
+	for _, column := range t.Columns {
+		if column.IsHidden {
+			continue
+		}
+
+		ret = append(ret, column)
+	}
+
+	return ret
+}
+
+// HiddenColumns returns list of hidden columns of the virtual table
+func (t Table) HiddenColumns() []Column {
+	var ret []Column
+
+	for _, column := range t.Columns {
+		if column.IsHidden {
+			ret = append(ret, column)
+		}
+	}
+
+	return ret
 }
 
 // MutableColumns returns list of mutable columns for table
@@ -12,7 +80,7 @@ func (t Table) MutableColumns() []Column {
 	var ret []Column
 
 	for _, column := range t.Columns {
-		if column.IsPrimaryKey || column.IsGenerated {
+		if column.IsPrimaryKey || column.IsGenerated || column.IsHidden {
 			continue
 		}
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/go-jet/jet/v2/generator/ddl"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/generator/template"
 	"github.com/go-jet/jet/v2/mysql"
@@ -118,3 +119,30 @@ func GenerateDB(db *sql.DB, dbName, destDir string, templates ...template.Templa
 
 	return nil
 }
+
+// GenerateFiles generates jet files for the database from the sql files (schema dump or migrations), without
+// the database connection. If sql file path is a directory, all the .sql files from the directory are used.
+func GenerateFiles(sqlFiles []string, dbName, destDir string, templates ...template.Template) error {
+	sqlScript, err := ddl.ReadFiles(sqlFiles...)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Parsing database information...")
+	schemaMetaData, err := ddl.GetSchema(mysql.Dialect, dbName, sqlScript)
+	if err != nil {
+		return fmt.Errorf("failed to get '%s' database metadata: %w", dbName, err)
+	}
+
+	genTemplate := template.Default(mysql.Dialect)
+	if len(templates) > 0 {
+		genTemplate = templates[0]
+	}
+
+	err = template.ProcessSchema(destDir, schemaMetaData, genTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to process '%s' database: %w", schemaMetaData.Name, err)
+	}
+
+	return nil
+}
@@ -32,6 +32,7 @@ SELECT
 		IF (col.DATA_TYPE = 'enum', 'enum', 'base') AS "dataType.Kind",
 		col.COLUMN_TYPE LIKE '%unsigned%' AS "dataType.IsUnsigned",
 		col.EXTRA LIKE '%VIRTUAL GENERATED%' AS "column.isGenerated",
+		col.EXTRA LIKE '%auto_increment%' AS "column.IsAutoIncrement",
 		? AS "dataType.SourceDialect"
 FROM INFORMATION_SCHEMA.tables AS t
 INNER JOIN
@@ -58,9 +59,141 @@ ORDER BY
 		return nil, fmt.Errorf("failed to query column meta data: %w", err)
 	}
 
+	if tableType != metadata.BaseTable {
+		return tables, nil
+	}
+
+	hasCheckConstraints, err := hasCheckConstraintsTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range tables {
+		tables[i].ForeignKeys, err = getForeignKeysMetaData(db, schemaName, tables[i].Name)
+		if err != nil {
+			return nil, err
+		}
+
+		tables[i].Indexes, err = getIndexesMetaData(db, schemaName, tables[i].Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !hasCheckConstraints {
+			continue
+		}
+
+		tables[i].CheckConstraints, err = getCheckConstraintsMetaData(db, schemaName, tables[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return tables, nil
 }
 
+func getForeignKeysMetaData(db *sql.DB, schemaName string, tableName string) ([]metadata.ForeignKey, error) {
+	query := `
+SELECT
+		k.constraint_name AS "foreign_key_column.name",
+		k.column_name AS "foreign_key_column.column",
+		k.referenced_table_schema AS "foreign_key_column.referenced_schema",
+		k.referenced_table_name AS "foreign_key_column.referenced_table",
+		k.referenced_column_name AS "foreign_key_column.referenced_column"
+FROM information_schema.key_column_usage AS k
+WHERE k.table_schema = ?
+	AND k.table_name = ?
+	AND k.referenced_table_name IS NOT NULL
+ORDER BY
+		k.constraint_name,
+		k.ordinal_position;
+`
+	var columns []metadata.ForeignKeyColumn
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &columns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query '%s' foreign keys meta data: %w", tableName, err)
+	}
+
+	return metadata.NewForeignKeys(columns), nil
+}
+
+func getIndexesMetaData(db *sql.DB, schemaName string, tableName string) ([]metadata.Index, error) {
+	query := `
+SELECT
+		s.index_name AS "index.name",
+		s.index_name = 'PRIMARY' AS "index.isPrimary",
+		s.non_unique = 0 AS "index.isUnique",
+		s.non_unique = 0 AS "index.isConstraint",
+		'' AS "index.predicate",
+		COALESCE(s.column_name, '') AS "columns"
+FROM information_schema.statistics AS s
+WHERE s.table_schema = ?
+	AND s.table_name = ?
+ORDER BY
+		s.index_name,
+		s.seq_in_index;
+`
+	var indexes []metadata.Index
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &indexes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query '%s' indexes meta data: %w", tableName, err)
+	}
+
+	return indexes, nil
+}
+
+// hasCheckConstraintsTable returns true if information schema contains check constraints table (MySQL 8.0.16+ and MariaDB)
+func hasCheckConstraintsTable(db *sql.DB) (bool, error) {
+	query := `
+SELECT COUNT(*) 
+FROM information_schema.tables 
+WHERE table_schema = 'information_schema' AND table_name = 'CHECK_CONSTRAINTS';
+`
+	var count int
+
+	err := db.QueryRow(query).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to query check constraints table: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func getCheckConstraintsMetaData(db *sql.DB, schemaName string, table metadata.Table) ([]metadata.CheckConstraint, error) {
+	query := `
+SELECT
+		cc.constraint_name AS "name",
+		cc.check_clause AS "expression"
+FROM information_schema.table_constraints AS tc
+INNER JOIN information_schema.check_constraints AS cc
+		ON cc.constraint_schema = tc.constraint_schema AND cc.constraint_name = tc.constraint_name
+WHERE tc.table_schema = ?
+	AND tc.table_name = ?
+	AND tc.constraint_type = 'CHECK'
+ORDER BY
+		cc.constraint_name;
+`
+	var checkConstraints []struct {
+		Name       string
+		Expression string
+	}
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, table.Name}, &checkConstraints)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query '%s' check constraints meta data: %w", table.Name, err)
+	}
+
+	var ret []metadata.CheckConstraint
+
+	for _, checkConstraint := range checkConstraints {
+		ret = append(ret, metadata.NewCheckConstraint(checkConstraint.Name, checkConstraint.Expression, table.Columns))
+	}
+
+	return ret, nil
+}
+
 func (m mySqlQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) ([]metadata.Enum, error) {
 	query := `
 SELECT (CASE c.DATA_TYPE WHEN 'enum' then CONCAT(c.TABLE_NAME, '_', c.COLUMN_NAME) ELSE '' END ) as "name", 
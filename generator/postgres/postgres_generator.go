@@ -6,7 +6,9 @@ import (
 	"net/url"
 	"path/filepath"
 	"strconv"
+	"strings"
 
+	"github.com/go-jet/jet/v2/generator/ddl"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/generator/template"
 	"github.com/go-jet/jet/v2/postgres"
@@ -66,24 +68,75 @@ func GenerateDSN(dsn, schema, destDir string, templates ...template.Template) er
 	return GenerateDB(db, schema, filepath.Join(destDir, dbName), templates...)
 }
 
-// GenerateDB generates jet files using the provided *sql.DB
+// GenerateDB generates jet files using the provided *sql.DB. Schema can also be a comma-separated list of schema
+// names and glob patterns (for instance 'public,sales_*'), in which case each schema is generated into its own
+// package tree, and enums and tables referenced from the other listed schemas are imported from their packages.
 func GenerateDB(db *sql.DB, schema, destDir string, templates ...template.Template) error {
 	generatorTemplate := template.Default(postgres.Dialect)
 	if len(templates) > 0 {
 		generatorTemplate = templates[0]
 	}
 
-	schemaMetadata, err := metadata.GetSchema(db, &postgresQuerySet{}, schema)
+	var schemaNames []string
+
+	if hasSchemaPattern(schema) {
+		var err error
+		if schemaNames, err = getSchemaNames(db); err != nil {
+			return err
+		}
+	}
+
+	var schemasMetadata []metadata.Schema
+
+	for _, schemaName := range metadata.MatchSchemaNames(schema, schemaNames) {
+		fmt.Printf("Schema %s:\n", schemaName)
+
+		schemaMetadata, err := metadata.GetSchema(db, &postgresQuerySet{}, schemaName)
+		if err != nil {
+			return fmt.Errorf("failed to get '%s' schema metadata: %w", schemaName, err)
+		}
+
+		schemasMetadata = append(schemasMetadata, schemaMetadata)
+	}
+
+	if len(schemasMetadata) == 0 {
+		return fmt.Errorf("no schema matches '%s'", schema)
+	}
+
+	return template.ProcessSchemas(destDir, schemasMetadata, generatorTemplate)
+}
+
+// GenerateFiles generates jet files for the schema from the sql files (schema dump or migrations), without
+// the database connection. If sql file path is a directory, all the .sql files from the directory are used.
+// As in GenerateDB, schema can be a comma-separated list of schema names and glob patterns.
+func GenerateFiles(sqlFiles []string, schema, destDir string, templates ...template.Template) error {
+	generatorTemplate := template.Default(postgres.Dialect)
+	if len(templates) > 0 {
+		generatorTemplate = templates[0]
+	}
+
+	sqlScript, err := ddl.ReadFiles(sqlFiles...)
 	if err != nil {
-		return fmt.Errorf("failed to get '%s' schema metadata: %w", schema, err)
+		return err
 	}
 
-	err = template.ProcessSchema(destDir, schemaMetadata, generatorTemplate)
+	fmt.Println("Parsing schema information...")
+
+	schemasMetadata, err := ddl.GetSchemas(postgres.Dialect, schema, sqlScript)
 	if err != nil {
-		return fmt.Errorf("failed to generate schema %s: %w", schemaMetadata.Name, err)
+		return fmt.Errorf("failed to get '%s' schema metadata: %w", schema, err)
+	}
+
+	if len(schemasMetadata) == 0 {
+		return fmt.Errorf("no schema matches '%s'", schema)
 	}
 
-	return nil
+	return template.ProcessSchemas(destDir, schemasMetadata, generatorTemplate)
+}
+
+// hasSchemaPattern returns true if the list of schemas contains glob patterns
+func hasSchemaPattern(schema string) bool {
+	return strings.ContainsAny(schema, "*?[\\")
 }
 
 func openConnection(dsn string) (*sql.DB, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/go-jet/jet/v2/generator/metadata"
 	postgresdialect "github.com/go-jet/jet/v2/postgres"
@@ -49,6 +50,25 @@ ORDER BY table_name;
 		if err != nil {
 			return nil, fmt.Errorf("failed to query %s columns metadata: %w", tableType, err)
 		}
+
+		if tableType != metadata.BaseTable {
+			continue
+		}
+
+		tables[i].ForeignKeys, err = getForeignKeysMetaData(db, schemaName, tables[i].Name)
+		if err != nil {
+			return nil, err
+		}
+
+		tables[i].Indexes, err = getIndexesMetaData(db, schemaName, tables[i].Name)
+		if err != nil {
+			return nil, err
+		}
+
+		tables[i].CheckConstraints, err = getCheckConstraintsMetaData(db, schemaName, tables[i])
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return tables, nil
@@ -72,21 +92,25 @@ select
         end) as "dataType.dimensions",
     (case coalesce(elem.typtype, tp.typtype)
          when 'b' then 'base'
-         when 'd' then 'base'
          when 'e' then 'enum'
          when 'r' then 'range'
+         when 'c' then 'composite'
         end) as "dataType.Kind",
-    (case when tp.typtype = 'd' then (select pg_type.typname from pg_catalog.pg_type where pg_type.oid = tp.typbasetype)
-          when tp.typcategory = 'A' then elem.typname
-          else tp.typname
-        end) as "dataType.Name",
+    coalesce(elem.typname, tp.typname) as "dataType.Name",
+    (case when coalesce(elem.typtype, tp.typtype) in ('e', 'c')
+              then (select typ_ns.nspname from pg_catalog.pg_namespace typ_ns where typ_ns.oid = coalesce(elem.typnamespace, tp.typnamespace))
+          else ''
+        end) as "dataType.Schema",
+    coalesce(dom.typname, elem_domain.typname, '') as "dataType.Domain",
     false as "dataType.isUnsigned",
     $3::text as "dataType.SourceDialect"
 from pg_catalog.pg_attribute as attr
      join pg_catalog.pg_class as cls on cls.oid = attr.attrelid
      join pg_catalog.pg_namespace as ns on ns.oid = cls.relnamespace
-     join pg_catalog.pg_type as tp on tp.oid = attr.atttypid
-	 left join pg_catalog.pg_type elem ON tp.typelem = elem.oid -- only for arrays
+     left join pg_catalog.pg_type as dom on dom.oid = attr.atttypid and dom.typtype = 'd'
+     join pg_catalog.pg_type as tp on tp.oid = coalesce(dom.typbasetype, attr.atttypid) -- domain base type
+     left join pg_catalog.pg_type elem_domain on tp.typcategory = 'A' and elem_domain.oid = tp.typelem and elem_domain.typtype = 'd'
+     left join pg_catalog.pg_type elem on tp.typcategory = 'A' and elem.oid = coalesce(elem_domain.typbasetype, tp.typelem) -- only for arrays
 where 
     ns.nspname = $1 and
     cls.relname = $2 and 
@@ -104,6 +128,116 @@ order by
 	return columns, nil
 }
 
+func getForeignKeysMetaData(db *sql.DB, schemaName string, tableName string) ([]metadata.ForeignKey, error) {
+	query := `
+select 
+    con.conname as "foreign_key_column.name",
+    attr.attname as "foreign_key_column.column",
+    ref_ns.nspname as "foreign_key_column.referenced_schema",
+    ref_cls.relname as "foreign_key_column.referenced_table",
+    ref_attr.attname as "foreign_key_column.referenced_column"
+from pg_catalog.pg_constraint as con
+     join pg_catalog.pg_class as cls on cls.oid = con.conrelid
+     join pg_catalog.pg_namespace as ns on ns.oid = cls.relnamespace
+     join pg_catalog.pg_class as ref_cls on ref_cls.oid = con.confrelid
+     join pg_catalog.pg_namespace as ref_ns on ref_ns.oid = ref_cls.relnamespace
+     cross join lateral unnest(con.conkey, con.confkey) with ordinality as k(attnum, ref_attnum, ord)
+     join pg_catalog.pg_attribute as attr on attr.attrelid = con.conrelid and attr.attnum = k.attnum
+     join pg_catalog.pg_attribute as ref_attr on ref_attr.attrelid = con.confrelid and ref_attr.attnum = k.ref_attnum
+where 
+    con.contype = 'f' and
+    ns.nspname = $1 and
+    cls.relname = $2
+order by 
+    con.conname, k.ord;
+`
+	var columns []metadata.ForeignKeyColumn
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &columns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query '%s' foreign keys metadata: %w", tableName, err)
+	}
+
+	return metadata.NewForeignKeys(columns), nil
+}
+
+func getIndexesMetaData(db *sql.DB, schemaName string, tableName string) ([]metadata.Index, error) {
+	query := `
+select 
+    idx_cls.relname as "index.name",
+    idx.indisprimary as "index.isPrimary",
+    idx.indisunique as "index.isUnique",
+    exists(
+        select 1
+        from pg_catalog.pg_constraint con
+        where con.conindid = idx.indexrelid and con.conrelid = idx.indrelid and con.contype in ('p', 'u')
+    ) as "index.isConstraint",
+    coalesce(pg_get_expr(idx.indpred, idx.indrelid, true), '') as "index.predicate",
+    pg_get_indexdef(idx.indexrelid, k.ord, true) as "columns"
+from pg_catalog.pg_index as idx
+     join pg_catalog.pg_class as idx_cls on idx_cls.oid = idx.indexrelid
+     join pg_catalog.pg_class as cls on cls.oid = idx.indrelid
+     join pg_catalog.pg_namespace as ns on ns.oid = cls.relnamespace
+     cross join generate_series(1, idx.indnkeyatts) as k(ord)
+where 
+    ns.nspname = $1 and
+    cls.relname = $2
+order by 
+    idx_cls.relname, k.ord;
+`
+	var indexes []metadata.Index
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &indexes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query '%s' indexes metadata: %w", tableName, err)
+	}
+
+	for _, index := range indexes {
+		for i := range index.Columns {
+			index.Columns[i] = metadata.TrimIdentifierQuotes(index.Columns[i])
+		}
+	}
+
+	return indexes, nil
+}
+
+func getCheckConstraintsMetaData(db *sql.DB, schemaName string, table metadata.Table) ([]metadata.CheckConstraint, error) {
+	query := `
+select 
+    con.conname as "name",
+    pg_get_constraintdef(con.oid, true) as "definition"
+from pg_catalog.pg_constraint as con
+     join pg_catalog.pg_class as cls on cls.oid = con.conrelid
+     join pg_catalog.pg_namespace as ns on ns.oid = cls.relnamespace
+where 
+    con.contype = 'c' and
+    ns.nspname = $1 and
+    cls.relname = $2
+order by 
+    con.conname;
+`
+	var checkConstraints []struct {
+		Name       string
+		Definition string
+	}
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, table.Name}, &checkConstraints)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query '%s' check constraints metadata: %w", table.Name, err)
+	}
+
+	var ret []metadata.CheckConstraint
+
+	for _, checkConstraint := range checkConstraints {
+		// constraint definition format is 'CHECK (expression) [NO INHERIT] [NOT VALID]'
+		expression := strings.TrimPrefix(checkConstraint.Definition, "CHECK ")
+		expression = strings.TrimSuffix(expression, " NOT VALID")
+		expression = strings.TrimSuffix(expression, " NO INHERIT")
+
+		ret = append(ret, metadata.NewCheckConstraint(checkConstraint.Name, expression, table.Columns))
+	}
+
+	return ret, nil
+}
+
 func (p postgresQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) ([]metadata.Enum, error) {
 	query := `
 SELECT t.typname as "enum.name",  
@@ -124,3 +258,58 @@ ORDER BY n.nspname, t.typname, e.enumsortorder;`
 
 	return result, nil
 }
+
+func (p postgresQuerySet) GetCompositeTypesMetaData(db *sql.DB, schemaName string) ([]metadata.Composite, error) {
+	query := `
+select t.typname as "composite.name",
+       obj_description(t.oid, 'pg_type') as "composite.comment"
+from pg_catalog.pg_type t
+   join pg_catalog.pg_namespace n on n.oid = t.typnamespace
+   join pg_catalog.pg_class c on c.oid = t.typrelid
+where n.nspname = $1 and t.typtype = 'c' and c.relkind = 'c'
+order by t.typname;`
+
+	var result []metadata.Composite
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query composite types metadata for schema '%s': %w", schemaName, err)
+	}
+
+	for i := range result {
+		// composite type attributes are stored in pg_attribute, as the columns of the composite type relation
+		result[i].Attributes, err = getColumnsMetaData(db, schemaName, result[i].Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query composite type attributes metadata: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// getSchemaNames returns names of the database schemas, excluding system schemas
+func getSchemaNames(db *sql.DB) ([]string, error) {
+	query := `
+select nspname
+from pg_catalog.pg_namespace
+where nspname not like 'pg\_%' and nspname not in ('information_schema', 'crdb_internal')
+order by nspname;`
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schema names: %w", err)
+	}
+	defer rows.Close()
+
+	var ret []string
+
+	for rows.Next() {
+		var schemaName string
+		if err := rows.Scan(&schemaName); err != nil {
+			return nil, fmt.Errorf("failed to scan schema name: %w", err)
+		}
+		ret = append(ret, schemaName)
+	}
+
+	return ret, rows.Err()
+}
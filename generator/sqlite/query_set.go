@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/go-jet/jet/v2/generator/metadata"
@@ -17,7 +18,7 @@ type sqliteQuerySet struct{}
 
 func (p sqliteQuerySet) GetTablesMetaData(db *sql.DB, schemaName string, tableType metadata.TableType) ([]metadata.Table, error) {
 	query := `
-	SELECT name as "table.name" 
+	SELECT name, COALESCE(sql, '') as sql
 	FROM sqlite_master
 	WHERE type=? AND name != 'sqlite_sequence'
 	ORDER BY name;
@@ -28,23 +29,372 @@ func (p sqliteQuerySet) GetTablesMetaData(db *sql.DB, schemaName string, tableTy
 		sqlTableType = "view"
 	}
 
-	var tables []metadata.Table
+	var tableInfos []struct {
+		Name string
+		Sql  string
+	}
 
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{sqlTableType}, &tables)
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{sqlTableType}, &tableInfos)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query %s metadata: %w", schemaName, err)
 	}
 
-	for i := range tables {
-		tables[i].Columns, err = p.GetTableColumnsMetaData(db, schemaName, tables[i].Name)
+	virtualTableModules := map[string]string{}
+
+	for _, tableInfo := range tableInfos {
+		if module := virtualTableModule(tableInfo.Sql); module != "" {
+			virtualTableModules[tableInfo.Name] = module
+		}
+	}
+
+	var tables []metadata.Table
+
+	for _, tableInfo := range tableInfos {
+		if isShadowTable(tableInfo.Name, virtualTableModules) {
+			continue
+		}
+
+		columns, err := p.GetTableColumnsMetaData(db, schemaName, tableInfo.Name)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query column metadata: %w", err)
 		}
+
+		if virtualTableModules[tableInfo.Name] == "fts5" {
+			setFts5ColumnsMetaData(tableInfo.Name, columns)
+		}
+
+		table := metadata.Table{
+			Name:    tableInfo.Name,
+			Columns: columns,
+		}
+
+		if tableType == metadata.BaseTable {
+			if virtualTableModules[tableInfo.Name] == "" {
+				setRowidAliasMetaData(tableInfo.Sql, columns)
+			}
+
+			table.ForeignKeys, err = getForeignKeysMetaData(db, schemaName, tableInfo.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			table.Indexes, err = getIndexesMetaData(db, tableInfo.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, checkConstraint := range parseCheckConstraints(tableInfo.Sql) {
+				table.CheckConstraints = append(table.CheckConstraints,
+					metadata.NewCheckConstraint(checkConstraint.Name, checkConstraint.Expression, columns))
+			}
+		}
+
+		tables = append(tables, table)
 	}
 
 	return tables, nil
 }
 
+var virtualTableRegex = regexp.MustCompile(`(?is)^\s*CREATE\s+VIRTUAL\s+TABLE\s+.*?\s+USING\s+(\w+)`)
+
+// virtualTableModule returns lowercase module name of the virtual table, or empty string for ordinary tables
+func virtualTableModule(createTableSql string) string {
+	match := virtualTableRegex.FindStringSubmatch(createTableSql)
+
+	if match == nil {
+		return ""
+	}
+
+	return strings.ToLower(match[1])
+}
+
+// shadowTableSuffixes are suffixes of the shadow tables, virtual table modules use to store their data
+var shadowTableSuffixes = map[string][]string{
+	"fts3":  {"content", "segments", "segdir", "docsize", "stat"},
+	"fts4":  {"content", "segments", "segdir", "docsize", "stat"},
+	"fts5":  {"data", "idx", "content", "docsize", "config"},
+	"rtree": {"node", "parent", "rowid"},
+}
+
+func isShadowTable(tableName string, virtualTableModules map[string]string) bool {
+	for virtualTableName, module := range virtualTableModules {
+		for _, suffix := range shadowTableSuffixes[module] {
+			if strings.EqualFold(tableName, virtualTableName+"_"+suffix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// setFts5ColumnsMetaData sets types of the FTS5 table columns. FTS5 columns are declared without type and
+// can contain only text, while hidden columns (with the same name as the table, and rank) are used for
+// full-text queries and sorting by relevance.
+func setFts5ColumnsMetaData(tableName string, columns []metadata.Column) {
+	for i := range columns {
+		column := &columns[i]
+
+		switch {
+		case column.IsHidden && strings.EqualFold(column.Name, tableName):
+			column.DataType.Name = "FTS5"
+		case column.IsHidden && strings.EqualFold(column.Name, "rank"):
+			column.DataType.Name = "REAL"
+		case column.DataType.Name == "":
+			column.DataType.Name = "TEXT"
+		}
+	}
+}
+
+var withoutRowidRegex = regexp.MustCompile(`(?i)\bWITHOUT\s+ROWID\b`)
+
+// setRowidAliasMetaData marks rowid alias column as auto increment column. Column is an alias for the rowid if it is
+// the only primary key column, of the INTEGER type, and the table is not WITHOUT ROWID table.
+func setRowidAliasMetaData(createTableSql string, columns []metadata.Column) {
+	start := 0
+	for start < len(createTableSql) && createTableSql[start] != '(' {
+		switch createTableSql[start] {
+		case '\'', '"', '`', '[':
+			start = skipQuoted(createTableSql, start)
+		}
+		start++
+	}
+
+	end := matchingParenthesis(createTableSql, start)
+	if end < 0 || withoutRowidRegex.MatchString(createTableSql[end+1:]) {
+		return
+	}
+
+	var primaryKey *metadata.Column
+
+	for i := range columns {
+		if !columns[i].IsPrimaryKey {
+			continue
+		}
+
+		if primaryKey != nil { // composite primary key
+			return
+		}
+
+		primaryKey = &columns[i]
+	}
+
+	if primaryKey != nil && strings.EqualFold(primaryKey.DataType.Name, "INTEGER") {
+		primaryKey.IsAutoIncrement = true
+	}
+}
+
+// getForeignKeysMetaData returns foreign keys of the table. Foreign key constraints in sqlite are usually unnamed,
+// and if referenced columns are omitted, foreign key references primary key of the parent table.
+func getForeignKeysMetaData(db *sql.DB, schemaName string, tableName string) ([]metadata.ForeignKey, error) {
+	query := `
+	SELECT fk.id, 
+	       fk."table" as referenced_table, 
+	       fk."from" as "column", 
+	       COALESCE(fk."to", pk.name) as referenced_column
+	FROM pragma_foreign_key_list(?) AS fk
+		LEFT JOIN pragma_table_info(fk."table") AS pk ON fk."to" IS NULL AND pk.pk = fk.seq + 1
+	ORDER BY fk.id DESC, fk.seq;
+`
+	var foreignKeyInfos []struct {
+		ID               int32
+		ReferencedTable  string
+		Column           string
+		ReferencedColumn string
+	}
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{tableName}, &foreignKeyInfos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query '%s' foreign keys metadata: %w", tableName, err)
+	}
+
+	var columns []metadata.ForeignKeyColumn
+
+	for _, foreignKeyInfo := range foreignKeyInfos {
+		columns = append(columns, metadata.ForeignKeyColumn{
+			Name:             fmt.Sprintf("%s_fk_%d", tableName, foreignKeyInfo.ID),
+			Column:           foreignKeyInfo.Column,
+			ReferencedSchema: schemaName,
+			ReferencedTable:  foreignKeyInfo.ReferencedTable,
+			ReferencedColumn: foreignKeyInfo.ReferencedColumn,
+		})
+	}
+
+	return metadata.NewForeignKeys(columns), nil
+}
+
+func getIndexesMetaData(db *sql.DB, tableName string) ([]metadata.Index, error) {
+	query := `
+	SELECT il.name, 
+	       il.origin = 'pk' as is_primary, 
+	       il."unique" as is_unique, 
+	       il.origin != 'c' as is_constraint, 
+	       COALESCE(m.sql, '') as sql,
+	       COALESCE(ii.name, '') as "column"
+	FROM pragma_index_list(?) AS il
+		INNER JOIN pragma_index_info(il.name) AS ii
+		LEFT JOIN sqlite_master AS m ON m.type = 'index' AND m.name = il.name
+	ORDER BY il.name, ii.seqno;
+`
+	var indexInfos []struct {
+		Name         string
+		IsPrimary    int32
+		IsUnique     int32
+		IsConstraint int32
+		Sql          string
+		Column       string
+	}
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{tableName}, &indexInfos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query '%s' indexes metadata: %w", tableName, err)
+	}
+
+	var indexes []metadata.Index
+
+	for i, indexInfo := range indexInfos {
+		if i == 0 || indexInfo.Name != indexInfos[i-1].Name {
+			indexes = append(indexes, metadata.Index{
+				Name:         indexInfo.Name,
+				IsPrimary:    indexInfo.IsPrimary == 1,
+				IsUnique:     indexInfo.IsUnique == 1,
+				IsConstraint: indexInfo.IsConstraint == 1,
+				Predicate:    indexPredicate(indexInfo.Sql),
+			})
+		}
+
+		index := &indexes[len(indexes)-1]
+		index.Columns = append(index.Columns, indexInfo.Column)
+	}
+
+	return indexes, nil
+}
+
+var indexPredicateRegex = regexp.MustCompile(`(?is)\)\s*WHERE\s+(.*?)\s*;?\s*$`)
+
+// indexPredicate returns partial index predicate from CREATE INDEX statement
+func indexPredicate(createIndexSql string) string {
+	match := indexPredicateRegex.FindStringSubmatch(createIndexSql)
+
+	if match == nil {
+		return ""
+	}
+
+	return match[1]
+}
+
+var constraintNameRegex = regexp.MustCompile(`(?is)CONSTRAINT\s+("[^"]+"|` + "`[^`]+`" + `|\[[^\]]+\]|\w+)\s*$`)
+
+// parseCheckConstraints returns CHECK constraints from CREATE TABLE statement, because sqlite does not
+// provide check constraints metadata.
+func parseCheckConstraints(createTableSql string) []metadata.CheckConstraint {
+	var ret []metadata.CheckConstraint
+
+	for i := 0; i < len(createTableSql); i++ {
+		switch createTableSql[i] {
+		case '\'', '"', '`', '[':
+			i = skipQuoted(createTableSql, i)
+			continue
+		}
+
+		if !isKeywordAt(createTableSql, i, "CHECK") {
+			continue
+		}
+
+		start := i + len("CHECK")
+		for start < len(createTableSql) && isSpace(createTableSql[start]) {
+			start++
+		}
+
+		if start == len(createTableSql) || createTableSql[start] != '(' {
+			continue
+		}
+
+		end := matchingParenthesis(createTableSql, start)
+		if end < 0 {
+			break
+		}
+
+		var name string
+		if match := constraintNameRegex.FindStringSubmatch(createTableSql[:i]); match != nil {
+			name = strings.Trim(metadata.TrimIdentifierQuotes(match[1]), "[]")
+		}
+
+		ret = append(ret, metadata.CheckConstraint{
+			Name:       name,
+			Expression: strings.TrimSpace(createTableSql[start+1 : end]),
+		})
+
+		i = end
+	}
+
+	return ret
+}
+
+func isKeywordAt(text string, pos int, keyword string) bool {
+	if pos+len(keyword) > len(text) || !strings.EqualFold(text[pos:pos+len(keyword)], keyword) {
+		return false
+	}
+
+	if pos > 0 && isIdentifierChar(text[pos-1]) {
+		return false
+	}
+
+	return pos+len(keyword) == len(text) || !isIdentifierChar(text[pos+len(keyword)])
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// skipQuoted returns position of the closing quote for the string literal or quoted identifier starting at pos
+func skipQuoted(text string, pos int) int {
+	closingQuote := text[pos]
+	if closingQuote == '[' {
+		closingQuote = ']'
+	}
+
+	for i := pos + 1; i < len(text); i++ {
+		if text[i] != closingQuote {
+			continue
+		}
+
+		if closingQuote != ']' && i+1 < len(text) && text[i+1] == closingQuote { // escaped quote
+			i++
+			continue
+		}
+
+		return i
+	}
+
+	return len(text)
+}
+
+// matchingParenthesis returns position of the closing parenthesis for the opening parenthesis at pos, or -1
+func matchingParenthesis(text string, pos int) int {
+	depth := 0
+
+	for i := pos; i < len(text); i++ {
+		switch text[i] {
+		case '\'', '"', '`', '[':
+			i = skipQuoted(text, i)
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
 func getTableInfoQuery(db *sql.DB) (string, error) {
 	var version string
 	err := db.QueryRow("select sqlite_version();").Scan(&version)
@@ -94,6 +444,7 @@ func (p sqliteQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, t
 	for _, columnInfo := range columnInfos {
 		columnType := strings.TrimSuffix(getColumnType(columnInfo.Type), " GENERATED ALWAYS")
 		isGenerated := columnInfo.Hidden == 2 || columnInfo.Hidden == 3 // stored or virtual column
+		isHidden := columnInfo.Hidden == 1                              // hidden column of the virtual table
 		hasDefault := columnInfo.DfltValue != ""
 
 		columns = append(columns, metadata.Column{
@@ -101,6 +452,7 @@ func (p sqliteQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, t
 			IsPrimaryKey: columnInfo.Pk != 0,
 			IsNullable:   columnInfo.NotNull != 1,
 			IsGenerated:  isGenerated,
+			IsHidden:     isHidden,
 			HasDefault:   hasDefault,
 			DataType: metadata.DataType{
 				Name:          columnType,
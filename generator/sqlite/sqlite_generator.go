@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/go-jet/jet/v2/generator/ddl"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/generator/template"
 	"github.com/go-jet/jet/v2/sqlite"
@@ -21,6 +22,32 @@ func GenerateDSN(dsn, destDir string, templates ...template.Template) error {
 	return GenerateDB(db, destDir, templates...)
 }
 
+// GenerateFiles generates jet files from the sql files (schema dump or migrations), without the database file.
+// Sql files are applied to the new in-memory database, so sqlite3 database driver has to be registered.
+// If sql file path is a directory, all the .sql files from the directory are used.
+func GenerateFiles(sqlFiles []string, destDir string, templates ...template.Template) error {
+	sqlScript, err := ddl.ReadFiles(sqlFiles...)
+	if err != nil {
+		return err
+	}
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return fmt.Errorf("failed to open sqlite connection: %w", err)
+	}
+	defer db.Close()
+
+	db.SetMaxOpenConns(1) // each connection has its own in-memory database
+
+	_, err = db.Exec(sqlScript)
+	if err != nil {
+		return fmt.Errorf("failed to execute sql files: %w", err)
+	}
+
+	fmt.Println("Retrieving schema information...")
+	return GenerateDB(db, destDir, templates...)
+}
+
 // GenerateDB generates jet files using the provided *sql.DB
 func GenerateDB(db *sql.DB, destDir string, templates ...template.Template) error {
 	generatorTemplate := template.Default(sqlite.Dialect)
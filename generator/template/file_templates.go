@@ -15,6 +15,9 @@ package {{package}}
 
 import (
 	"github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+{{- range sqlBuilderImports}}
+	{{.}}
+{{- end}}
 )
 
 var {{tableTemplate.InstanceName}} = new{{tableTemplate.TypeName}}("{{schemaName}}", "{{.Name}}", "{{tableTemplate.DefaultAlias}}")
@@ -34,6 +37,13 @@ type {{structImplName}} struct {
 	AllColumns     {{dialect.PackageName}}.ColumnList
 	MutableColumns {{dialect.PackageName}}.ColumnList
 	DefaultColumns {{dialect.PackageName}}.ColumnList
+{{- with conflictTargets}}
+
+	// Conflict targets
+{{- range .}}
+	{{.Name}} {{dialect.PackageName}}.ConflictTarget
+{{- end}}
+{{- end}}
 }
 
 type {{tableTemplate.TypeName}} struct {
@@ -62,6 +72,20 @@ func (a {{tableTemplate.TypeName}}) WithSuffix(suffix string) *{{tableTemplate.T
 	return new{{tableTemplate.TypeName}}(a.SchemaName(), a.TableName()+suffix, a.TableName())
 }
 
+{{- range joinHelpers}}
+
+// {{.Name}} returns join condition between {{tableTemplate.InstanceName}} and {{.ReferencedInstanceName}} tables, defined by '{{.ForeignKeyName}}' foreign key.
+// Optional table parameter can be used to join aliased {{.ReferencedInstanceName}} table.
+func (a {{tableTemplate.TypeName}}) {{.Name}}(table ...*{{.ReferencedTypeName}}) {{dialect.PackageName}}.BoolExpression {
+	ref := {{.ReferencedInstanceName}}
+	if len(table) > 0 {
+		ref = table[0]
+	}
+
+	return {{range $i, $c := .Conditions}}{{if $i}}.AND({{end}}a.{{$c.Column}}.EQ(ref.{{$c.ReferencedColumn}}){{if $i}}){{end}}{{end}}
+}
+{{- end}}
+
 func new{{tableTemplate.TypeName}}(schemaName, tableName, alias string) *{{tableTemplate.TypeName}} {
 	return &{{tableTemplate.TypeName}}{
 		{{structImplName}}: new{{tableTemplate.TypeName}}Impl(schemaName, tableName, alias),
@@ -77,13 +101,17 @@ func new{{tableTemplate.TypeName}}Impl(schemaName, tableName, alias string) {{st
 		{{$field.Name}}Column = {{dialect.PackageName}}.{{$field.Type}}Column("{{$c.Name}}")
 {{- end}}
 {{- end}}
-		allColumns     = {{dialect.PackageName}}.ColumnList{ {{columnList .Columns}} }
+		allColumns     = {{dialect.PackageName}}.ColumnList{ {{columnList .VisibleColumns}} }
 		mutableColumns = {{dialect.PackageName}}.ColumnList{ {{columnList .MutableColumns}} }
 		defaultColumns = {{dialect.PackageName}}.ColumnList{ {{columnList .DefaultColumns}} }
 	)
 
 	return {{structImplName}}{
+{{- if .HiddenColumns}}
+		Table: {{dialect.PackageName}}.NewVirtualTable(schemaName, tableName, alias, allColumns, {{columnList .HiddenColumns}}),
+{{- else}}
 		Table: {{dialect.PackageName}}.NewTable(schemaName, tableName, alias, allColumns...),
+{{- end}}
 
 		//Columns
 {{- range $i, $c := .Columns}}
@@ -96,6 +124,13 @@ func new{{tableTemplate.TypeName}}Impl(schemaName, tableName, alias string) {{st
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
 		DefaultColumns: defaultColumns,
+{{- with conflictTargets}}
+
+		// Conflict targets
+{{- range .}}
+		{{.Name}}: {{dialect.PackageName}}.NewConflictTarget({{if .Predicate}}{{dialect.PackageName}}.RawBool({{printf "%q" .Predicate}}){{else}}nil{{end}}, {{.Columns}}),
+{{- end}}
+{{- end}}
 	}
 }
 `
@@ -116,7 +151,7 @@ var tableModelFileTemplate = `package {{package}}
 {{ with modelImports }}
 import (
 {{- range .}}
-	"{{.}}"
+	{{.}}
 {{- end}}
 )
 {{end}}
@@ -130,6 +165,9 @@ type {{$modelTableTemplate.TypeName}} struct {
 	{{$field.Name}} {{$field.Type.Name}} ` + "{{$field.TagsString}}" + ` {{golangComment .Comment}}
 {{- end }}
 {{- end}}
+{{- range relationFields}}
+	{{.Name}} *{{.TypeName}} ` + "{{.TagsString}}" + `
+{{- end}}
 }
 
 `
@@ -148,6 +186,13 @@ var {{enumTemplate.InstanceName}} = &struct {
 	{{enumValueName $value}}: {{dialect.PackageName}}.NewEnumValue("{{$value}}"),
 {{- end}}
 }
+{{- with enumArrayType}}
+
+// {{enumTemplate.InstanceName}}Array creates {{.}} array expression from the enum values
+func {{enumTemplate.InstanceName}}Array(values ...postgres.EnumExpression) postgres.Array[postgres.EnumExpression] {
+	return postgres.ArrayExp[postgres.EnumExpression](postgres.CAST(postgres.ARRAY(values...)).AS({{printf "%q" .}}))
+}
+{{- end}}
 `
 
 var enumModelTemplate = `package {{package}}
@@ -198,3 +243,264 @@ func (e {{$enumTemplate.TypeName}}) String() string {
 }
 
 `
+var compositeSQLBuilderTemplate = `package {{package}}
+{{- $compositeTemplate := compositeTemplate}}
+
+import "github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+
+{{golangComment .Comment}}
+type {{$compositeTemplate.TypeName}} struct {
+	{{dialect.PackageName}}.RowExpression
+}
+
+// {{$compositeTemplate.InstanceName}} wraps row expression of the '{{.Name}}' composite type, for instance '{{.Name}}' column, into {{$compositeTemplate.TypeName}}
+func {{$compositeTemplate.InstanceName}}(row {{dialect.PackageName}}.RowExpression) {{$compositeTemplate.TypeName}} {
+	return {{$compositeTemplate.TypeName}}{RowExpression: row}
+}
+{{- range fieldAccessors}}
+
+// {{.Name}} returns '{{.FieldName}}' field of the composite type value
+func (r {{$compositeTemplate.TypeName}}) {{.Name}}() {{.Type}} {
+	return {{.Expression}}
+}
+{{- end}}
+`
+
+var compositeModelTemplate = `package {{package}}
+{{- $compositeTemplate := compositeTemplate}}
+
+import (
+	"database/sql/driver"
+{{ range modelImports}}
+	{{.}}
+{{- end}}
+)
+
+{{golangComment .Comment}}
+type {{$compositeTemplate.TypeName}} struct {
+{{- range .Attributes}}
+{{- $field := structField .}}
+{{- if not $field.Skip}}
+	{{$field.Name}} {{$field.Type.Name}} ` + "{{$field.TagsString}}" + ` {{golangComment .Comment}}
+{{- end }}
+{{- end}}
+}
+
+// Scan implements sql.Scanner interface, for the '{{.Name}}' composite type value
+func (c *{{$compositeTemplate.TypeName}}) Scan(value interface{}) error {
+	return pgtype.ScanComposite(value{{range .Attributes}}{{$field := structField .}}, {{if $field.Skip}}nil{{else}}&c.{{$field.Name}}{{end}}{{end}})
+}
+
+// Value implements driver.Valuer interface, for the '{{.Name}}' composite type value
+func (c {{$compositeTemplate.TypeName}}) Value() (driver.Value, error) {
+	return pgtype.CompositeValue({{range $i, $a := .Attributes}}{{$field := structField $a}}{{if $i}}, {{end}}{{if $field.Skip}}nil{{else}}c.{{$field.Name}}{{end}}{{end}})
+}
+`
+
+var tableRepositoryTemplate = `package {{package}}
+
+import (
+	"context"
+{{- range repositoryImports}}
+	{{.}}
+{{- end}}
+)
+
+{{- $dialect := dialect.PackageName}}
+{{- define "primaryKeyCondition"}}
+{{- $table := .Table}}{{$dialect := .Dialect}}{{$row := .Row}}
+{{- range $i, $pk := .PrimaryKeys}}{{if $i}}.AND({{end}}{{$table}}.{{$pk.Column}}.EQ({{$dialect}}.{{$pk.Literal}}({{if $row}}{{$pk.RowValue}}{{else}}{{$pk.Param}}{{end}})){{if $i}}){{end}}{{end}}
+{{- end}}
+
+// {{.TypeName}} is data access layer for the {{.TableName}} table. Statements are executed over the database
+// connection or transaction the repository is created with.
+{{- range .SkippedMethods}}
+//
+// {{.}}
+{{- end}}
+type {{.TypeName}} struct {
+	db qrm.DB
+}
+
+// New{{.TypeName}} creates new {{.TypeName}}, executing statements over db connection or transaction
+func New{{.TypeName}}(db qrm.DB) {{.TypeName}} {
+	return {{.TypeName}}{db: db}
+}
+
+{{- if .PrimaryKeys}}
+
+// FindByID returns {{.TableName}} row with the primary key, or qrm.ErrNoRows if the row does not exist
+func (r {{.TypeName}}) FindByID(ctx context.Context{{range .PrimaryKeys}}, {{.Param}} {{.Type}}{{end}}) ({{.ModelType}}, error) {
+	var dest {{.ModelType}}
+
+	err := {{$dialect}}.SELECT({{.Table}}.AllColumns).
+		FROM({{.Table}}).
+		WHERE({{template "primaryKeyCondition" primaryKeyCondition false}}).
+		QueryContext(ctx, r.db, &dest)
+
+	return dest, err
+}
+{{- end}}
+
+{{- if eq (len .PrimaryKeys) 1}}
+{{- $pk := index .PrimaryKeys 0}}
+
+// ListByIDs returns {{.TableName}} rows with the primary keys
+func (r {{.TypeName}}) ListByIDs(ctx context.Context, ids ...{{$pk.Type}}) ([]{{.ModelType}}, error) {
+	var dest []{{.ModelType}}
+
+	if len(ids) == 0 {
+		return dest, nil
+	}
+
+	values := make([]{{$dialect}}.Expression, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, {{$dialect}}.{{$pk.Literal}}(id))
+	}
+
+	err := {{$dialect}}.SELECT({{.Table}}.AllColumns).
+		FROM({{.Table}}).
+		WHERE({{.Table}}.{{$pk.Column}}.IN(values...)).
+		QueryContext(ctx, r.db, &dest)
+
+	return dest, err
+}
+{{- end}}
+
+{{- if .HasInsert}}
+
+// Insert inserts {{.TableName}} row. Columns with default values are set by the database
+{{- if .Returning}}, and row is updated
+// with the inserted values.
+{{- else if .AutoIncrement}}, and row primary key
+// is set to the generated value.
+{{- else}}.
+{{- end}}
+func (r {{.TypeName}}) Insert(ctx context.Context, row *{{.ModelType}}) error {
+{{- if .Returning}}
+	return {{.Table}}.INSERT({{.InsertColumns}}).
+		MODEL(row).
+		RETURNING({{.Table}}.AllColumns).
+		QueryContext(ctx, r.db, row)
+{{- else}}
+	{{if .AutoIncrement}}result{{else}}_{{end}}, err := {{.Table}}.INSERT({{.InsertColumns}}).
+		MODEL(row).
+		ExecContext(ctx, r.db)
+{{- if .AutoIncrement}}
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+{{- with .AutoIncrement}}
+{{- $id := "id"}}{{if ne .Type "int64"}}{{$id = printf "%s(id)" .Type}}{{end}}
+{{if eq .Nullable "pointer"}}
+	{{if ne .Type "int64"}}value := {{$id}}
+	row.{{.ModelField}} = &value{{else}}row.{{.ModelField}} = &id{{end}}
+{{- else if .Nullable}}
+	row.{{.ModelField}}.V, row.{{.ModelField}}.Valid = {{$id}}, true
+{{- else}}
+	row.{{.ModelField}} = {{$id}}
+{{- end}}
+{{- end}}
+
+	return nil
+{{- else}}
+
+	return err
+{{- end}}
+{{- end}}
+}
+{{- end}}
+
+{{- if and .PrimaryKeys .HasMutable}}
+
+// Update updates mutable columns of {{.TableName}} row with the row primary key
+{{- if .Returning}}, and row is updated with the updated values.
+// If the row does not exist, qrm.ErrNoRows is returned.
+{{- else}}.
+{{- end}}
+func (r {{.TypeName}}) Update(ctx context.Context, row *{{.ModelType}}) error {
+{{- with .NullPrimaryKeyCondition}}
+	if {{.}} {
+		return {{if $.Returning}}qrm.ErrNoRows{{else}}nil{{end}}
+	}
+{{end}}
+{{- if .Returning}}
+	return {{.Table}}.UPDATE({{.Table}}.MutableColumns).
+		MODEL(row).
+		WHERE({{template "primaryKeyCondition" primaryKeyCondition true}}).
+		RETURNING({{.Table}}.AllColumns).
+		QueryContext(ctx, r.db, row)
+{{- else}}
+	_, err := {{.Table}}.UPDATE({{.Table}}.MutableColumns).
+		MODEL(row).
+		WHERE({{template "primaryKeyCondition" primaryKeyCondition true}}).
+		ExecContext(ctx, r.db)
+
+	return err
+{{- end}}
+}
+{{- end}}
+
+{{- if .HasUpsert}}
+
+// Upsert inserts {{.TableName}} row, or updates mutable columns of the existing row with the same primary key
+{{- if .Returning}}.
+// Row is updated with the inserted or updated values.
+{{- else}}.
+{{- end}}
+func (r {{.TypeName}}) Upsert(ctx context.Context, row *{{.ModelType}}) error {
+{{- if .Returning}}
+	return {{.Table}}.INSERT({{range .PrimaryKeys}}{{$.Table}}.{{.Column}}, {{end}}{{.Table}}.MutableColumns).
+		MODEL(row).
+		ON_CONFLICT({{range $i, $pk := .PrimaryKeys}}{{if $i}}, {{end}}{{$.Table}}.{{$pk.Column}}{{end}}).
+		DO_UPDATE({{$dialect}}.SET({{.Table}}.MutableColumns.SET({{.Table}}.EXCLUDED.MutableColumns))).
+		RETURNING({{.Table}}.AllColumns).
+		QueryContext(ctx, r.db, row)
+{{- else}}
+	_, err := {{.Table}}.INSERT({{range .PrimaryKeys}}{{$.Table}}.{{.Column}}, {{end}}{{.Table}}.MutableColumns).
+		MODEL(row).
+		ON_DUPLICATE_KEY_UPDATE(
+{{- range .UpsertColumns}}
+			{{$.Table}}.{{.Column}}.SET({{$dialect}}.{{.Expression}}({{$dialect}}.Func("VALUES", {{$.Table}}.{{.Column}}))),
+{{- end}}
+		).
+		ExecContext(ctx, r.db)
+
+	return err
+{{- end}}
+}
+{{- end}}
+
+{{- if .PrimaryKeys}}
+
+// DeleteByID deletes {{.TableName}} row with the primary key, and returns the number of deleted rows
+func (r {{.TypeName}}) DeleteByID(ctx context.Context{{range .PrimaryKeys}}, {{.Param}} {{.Type}}{{end}}) (int64, error) {
+	result, err := {{.Table}}.DELETE().
+		WHERE({{template "primaryKeyCondition" primaryKeyCondition false}}).
+		ExecContext(ctx, r.db)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+{{- end}}
+
+// Count returns the number of {{.TableName}} rows
+func (r {{.TypeName}}) Count(ctx context.Context) (int64, error) {
+	var dest struct {
+		Count int64
+	}
+
+	err := {{$dialect}}.SELECT({{$dialect}}.COUNT({{$dialect}}.STAR).AS("count")).
+		FROM({{.Table}}).
+		QueryContext(ctx, r.db, &dest)
+
+	return dest.Count, err
+}
+`
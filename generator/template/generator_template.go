@@ -9,6 +9,8 @@ import (
 type Template struct {
 	Dialect jet.Dialect
 	Schema  func(schemaMetaData metadata.Schema) Schema
+	// Output, if set, receives generated files instead of the destination directory
+	Output *MemoryOutput
 }
 
 // Default is default generator template implementation
@@ -25,11 +27,19 @@ func (t Template) UseSchema(schemaFunc func(schemaMetaData metadata.Schema) Sche
 	return t
 }
 
-// Schema is schema generator template used to generate schema(model and sql builder) files
+// UseOutput returns new generator template which renders generated files into the memory output, leaving the
+// destination directory untouched
+func (t Template) UseOutput(output *MemoryOutput) Template {
+	t.Output = output
+	return t
+}
+
+// Schema is schema generator template used to generate schema(model, sql builder and repository) files
 type Schema struct {
 	Path       string
 	Model      Model
 	SQLBuilder SQLBuilder
+	Repository Repository
 }
 
 // UsePath replaces path and returns new schema template
@@ -50,11 +60,18 @@ func (s Schema) UseSQLBuilder(sqlBuilder SQLBuilder) Schema {
 	return s
 }
 
+// UseRepository returns new schema with replaced template for repository files generation
+func (s Schema) UseRepository(repository Repository) Schema {
+	s.Repository = repository
+	return s
+}
+
 // DefaultSchema returns default schema template implementation
 func DefaultSchema(schemaMetaData metadata.Schema) Schema {
 	return Schema{
 		Path:       schemaMetaData.Name,
 		Model:      DefaultModel(),
 		SQLBuilder: DefaultSQLBuilder(),
+		Repository: DefaultRepository(),
 	}
 }
@@ -2,6 +2,7 @@ package template
 
 import (
 	"fmt"
+	"maps"
 	"path/filepath"
 	"reflect"
 	"slices"
@@ -22,8 +23,26 @@ type Model struct {
 	Table func(table metadata.Table) TableModel
 	View  func(table metadata.Table) ViewModel
 	Enum  func(enum metadata.Enum) EnumModel
+	// Composite is template for composite type model files generation (PostgreSQL only)
+	Composite func(composite metadata.Composite) CompositeModel
+	// Nullable is representation of the nullable column fields, in table, view and composite type models
+	Nullable NullableStrategy
 }
 
+// NullableStrategy is representation of the nullable columns in the generated model types
+type NullableStrategy string
+
+// Nullable strategies
+const (
+	NullablePointer   NullableStrategy = "pointer"  // pointer to the column type, for instance *int32
+	NullableSQLNull   NullableStrategy = "sql-null" // sql.Null[T], for instance sql.Null[int32]
+	NullableOptional  NullableStrategy = "optional" // qrm.Optional[T], sql.Null[T] with JSON support
+	NullableZeroValue NullableStrategy = "zero"     // column type with 'omitempty' json tag, NULL is a zero value
+)
+
+// NullableStrategies is the list of supported nullable strategies
+var NullableStrategies = []NullableStrategy{NullablePointer, NullableSQLNull, NullableOptional, NullableZeroValue}
+
 // PackageName returns package name of model types
 func (m Model) PackageName() string {
 	return filepath.Base(m.Path)
@@ -53,6 +72,19 @@ func (m Model) UseEnum(enumFunc func(enumMetaData metadata.Enum) EnumModel) Mode
 	return m
 }
 
+// UseComposite returns new Model template with replaced template for composite type model files generation
+func (m Model) UseComposite(compositeFunc func(compositeMetaData metadata.Composite) CompositeModel) Model {
+	m.Composite = compositeFunc
+	return m
+}
+
+// UseNullable returns new Model template with new representation of the nullable column fields. Only the fields
+// of the pointer type are changed, so the fields with custom non-pointer types are left as they are.
+func (m Model) UseNullable(strategy NullableStrategy) Model {
+	m.Nullable = strategy
+	return m
+}
+
 // ShouldSkip returns new Model template with new skip flag set
 func (m Model) ShouldSkip(skip bool) Model {
 	m.Skip = skip
@@ -67,15 +99,20 @@ func DefaultModel() Model {
 		Table: DefaultTableModel,
 		View:  DefaultViewModel,
 		Enum:  DefaultEnumModel,
+
+		Composite: DefaultCompositeModel,
+		Nullable:  NullablePointer,
 	}
 }
 
 // TableModel is template for table model files generation
 type TableModel struct {
-	Skip     bool
-	FileName string
-	TypeName string
-	Field    func(columnMetaData metadata.Column) TableModelField
+	Skip       bool
+	FileName   string
+	TypeName   string
+	Field      func(columnMetaData metadata.Column) TableModelField
+	Relation   func(foreignKey metadata.ForeignKey) TableModelRelation
+	Validation func(columnMetaData metadata.Column, checkConstraints []metadata.CheckConstraint) []string
 }
 
 // ViewModel is template for view model files generation
@@ -111,10 +148,91 @@ func (t TableModel) UseField(structFieldFunc func(columnMetaData metadata.Column
 	return t
 }
 
-func getTableModelImports(modelType TableModel, tableMetaData metadata.Table) []string {
+// UseRelation returns new TableModel with new TableModelRelation template function. Relation fields are
+// not generated by default, DefaultTableModelRelation can be used to enable them:
+//
+//	DefaultTableModel(table).UseRelation(DefaultTableModelRelation)
+func (t TableModel) UseRelation(relationFunc func(foreignKey metadata.ForeignKey) TableModelRelation) TableModel {
+	t.Relation = relationFunc
+	return t
+}
+
+// UseValidation returns new TableModel with new validation template function. Validation function returns
+// additional field tags for the column and its check constraints. Validation tags are not generated by default,
+// DefaultTableModelValidation can be used to enable them:
+//
+//	DefaultTableModel(table).UseValidation(DefaultTableModelValidation)
+func (t TableModel) UseValidation(validationFunc func(columnMetaData metadata.Column, checkConstraints []metadata.CheckConstraint) []string) TableModel {
+	t.Validation = validationFunc
+	return t
+}
+
+// TableModelRelation is template for table model relation field generation. Relation field is a pointer to the
+// model type of the table referenced by the foreign key.
+type TableModelRelation struct {
+	Name string
+	Tags []string
+	Skip bool
+}
+
+// DefaultTableModelRelation returns default TableModelRelation implementation. For single column foreign keys,
+// field name is column name without '_id' suffix (for instance, LanguageID column produces Language field),
+// otherwise field name is referenced table name. If field name differs from referenced table name,
+// alias tag is added, so the relation can be scanned from aliased table.
+func DefaultTableModelRelation(foreignKey metadata.ForeignKey) TableModelRelation {
+	name := foreignKey.ReferencedTable
+
+	if len(foreignKey.Columns) == 1 {
+		columnName := foreignKey.Columns[0]
+
+		for _, suffix := range []string{"_id", "_ID", "Id", "ID"} {
+			if len(columnName) > len(suffix) && strings.HasSuffix(columnName, suffix) {
+				name = columnName[:len(columnName)-len(suffix)]
+				break
+			}
+		}
+	}
+
+	var tags []string
+
+	if dbidentifier.ToGoIdentifier(name) != dbidentifier.ToGoIdentifier(foreignKey.ReferencedTable) {
+		tags = append(tags, fmt.Sprintf("alias:%q", name))
+	}
+
+	return TableModelRelation{
+		Name: dbidentifier.ToGoIdentifier(name),
+		Tags: tags,
+	}
+}
+
+// UseName returns new TableModelRelation with new field name set
+func (r TableModelRelation) UseName(name string) TableModelRelation {
+	r.Name = name
+	return r
+}
+
+// UseTags returns new TableModelRelation with additional tags added
+func (r TableModelRelation) UseTags(tags ...string) TableModelRelation {
+	r.Tags = append(r.Tags, tags...)
+	return r
+}
+
+// TagsString returns tags string representation
+func (r TableModelRelation) TagsString() string {
+	return TableModelField{Tags: r.Tags}.TagsString()
+}
+
+func getTableModelImports(columns []metadata.Column,
+	structField func(columnMetaData metadata.Column) TableModelField,
+	relationFields []tableModelRelationField,
+	packages *schemaPackages) []string {
+
 	importPaths := map[string]bool{}
-	for _, columnMetaData := range tableMetaData.Columns {
-		field := modelType.Field(columnMetaData)
+	for _, columnMetaData := range columns {
+		field := structField(columnMetaData)
+		if field.Skip {
+			continue
+		}
 		for _, importPath := range append([]string{field.Type.ImportPath}, field.Type.AdditionalImportPaths...) {
 			if importPath != "" {
 				importPaths[importPath] = true
@@ -122,9 +240,15 @@ func getTableModelImports(modelType TableModel, tableMetaData metadata.Table) []
 		}
 	}
 
+	for _, relationField := range relationFields {
+		if relationField.ImportPath != "" {
+			importPaths[relationField.ImportPath] = true
+		}
+	}
+
 	var ret []string
-	for importPath := range importPaths {
-		ret = append(ret, importPath)
+	for _, importPath := range slices.Sorted(maps.Keys(importPaths)) {
+		ret = append(ret, packages.importSpec(importPath))
 	}
 
 	return ret
@@ -163,6 +287,54 @@ func DefaultEnumModel(enumMetaData metadata.Enum) EnumModel {
 	}
 }
 
+// CompositeModel is template for composite type model files generation. Composite type model is a struct,
+// with a field for each composite type attribute, implementing sql.Scanner and driver.Valuer interfaces.
+type CompositeModel struct {
+	Skip     bool
+	FileName string
+	TypeName string
+	Field    func(attributeMetaData metadata.Column) TableModelField
+}
+
+// DefaultCompositeModel returns default implementation for CompositeModel
+func DefaultCompositeModel(compositeMetaData metadata.Composite) CompositeModel {
+	return CompositeModel{
+		FileName: dbidentifier.ToGoFileName(compositeMetaData.Name),
+		TypeName: dbidentifier.ToGoIdentifier(compositeMetaData.Name),
+		Field:    DefaultCompositeModelField,
+	}
+}
+
+// DefaultCompositeModelField returns default composite type model field implementation. Fields named Scan
+// or Value are suffixed with an underscore, to not clash with the sql.Scanner and driver.Valuer methods.
+func DefaultCompositeModelField(attributeMetaData metadata.Column) TableModelField {
+	field := DefaultTableModelField(attributeMetaData)
+
+	if field.Name == "Scan" || field.Name == "Value" {
+		field.Name += "_"
+	}
+
+	return field
+}
+
+// UseFileName returns new CompositeModel with new file name set
+func (c CompositeModel) UseFileName(fileName string) CompositeModel {
+	c.FileName = fileName
+	return c
+}
+
+// UseTypeName returns new CompositeModel with new type name set
+func (c CompositeModel) UseTypeName(typeName string) CompositeModel {
+	c.TypeName = typeName
+	return c
+}
+
+// UseField returns new CompositeModel with new TableModelField template function
+func (c CompositeModel) UseField(fieldFunc func(attributeMetaData metadata.Column) TableModelField) CompositeModel {
+	c.Field = fieldFunc
+	return c
+}
+
 // TableModelField is template for table model field generation
 type TableModelField struct {
 	Name string
@@ -173,6 +345,10 @@ type TableModelField struct {
 
 // DefaultTableModelField returns default TableModelField implementation
 func DefaultTableModelField(columnMetaData metadata.Column) TableModelField {
+	if columnMetaData.IsHidden {
+		return TableModelField{Skip: true}
+	}
+
 	var tags []string
 
 	if columnMetaData.IsPrimaryKey {
@@ -205,6 +381,66 @@ func (f TableModelField) UseTags(tags ...string) TableModelField {
 	return f
 }
 
+// UseNullable returns new TableModelField with the pointer type replaced with the nullable strategy
+// representation, for instance *int32 field is sql.Null[int32] field with NullableSQLNull strategy.
+// Fields of non-pointer type are returned unchanged.
+func (f TableModelField) UseNullable(strategy NullableStrategy) TableModelField {
+	if !strings.HasPrefix(f.Type.Name, "*") {
+		return f
+	}
+
+	elemType := f.Type
+	elemType.Name = strings.TrimPrefix(elemType.Name, "*")
+
+	switch strategy {
+	case NullableSQLNull:
+		f.Type = wrapType(elemType, "sql.Null", "database/sql")
+	case NullableOptional:
+		f.Type = wrapType(elemType, "qrm.Optional", "github.com/go-jet/jet/v2/qrm")
+	case NullableZeroValue:
+		f.Type = elemType
+		f.Tags = addOmitEmptyTag(f.Tags)
+	}
+
+	return f
+}
+
+// wrapType returns generic type genericName[elemType], declared in the package at importPath
+func wrapType(elemType Type, genericName, importPath string) Type {
+	ret := Type{
+		Name:       genericName + "[" + elemType.Name + "]",
+		ImportPath: importPath,
+	}
+
+	for _, elemImportPath := range append([]string{elemType.ImportPath}, elemType.AdditionalImportPaths...) {
+		if elemImportPath != "" {
+			ret.AdditionalImportPaths = append(ret.AdditionalImportPaths, elemImportPath)
+		}
+	}
+
+	return ret
+}
+
+// addOmitEmptyTag adds omitempty option to the json tag, or adds json tag with omitempty option only
+func addOmitEmptyTag(tags []string) []string {
+	ret := slices.Clone(tags)
+
+	for i, tag := range ret {
+		if !strings.HasPrefix(tag, `json:"`) || !strings.HasSuffix(tag, `"`) {
+			continue
+		}
+
+		if tag == `json:"-"` || strings.Contains(tag, ",omitempty") {
+			return ret
+		}
+
+		ret[i] = strings.TrimSuffix(tag, `"`) + `,omitempty"`
+		return ret
+	}
+
+	return append(ret, `json:",omitempty"`)
+}
+
 // TagsString returns tags string representation
 func (f TableModelField) TagsString() string {
 	if len(f.Tags) == 0 {
@@ -268,29 +504,48 @@ func getType(columnMetadata metadata.Column) Type {
 	userDefinedType := getUserDefinedType(columnMetadata)
 
 	if userDefinedType != "" {
-		var importPath string
+		ret := Type{Name: userDefinedType}
 
 		if columnMetadata.DataType.IsArray() {
-			userDefinedType = "pq.StringArray"
-			importPath = "github.com/lib/pq"
+			ret = getUserDefinedArrayType(columnMetadata, ret)
 		}
 
 		if columnMetadata.IsNullable {
-			userDefinedType = "*" + userDefinedType
+			ret.Name = "*" + ret.Name
 		}
 
-		return Type{
-			Name:       userDefinedType,
-			ImportPath: importPath,
-		}
+		return ret
 	}
 
 	return getGoType(columnMetadata)
 }
 
+// getUserDefinedArrayType returns model type of the user defined type array. Enum arrays are slices of the enum
+// model type, other user defined type arrays are string slices.
+func getUserDefinedArrayType(column metadata.Column, elemType Type) Type {
+	if column.DataType.Kind != metadata.EnumType {
+		elemType = NewType("")
+	}
+
+	if elemType.Name == "string" && column.DataType.Dimensions <= 1 {
+		return NewType(pq.StringArray{})
+	}
+
+	return getArrayValueType(elemType, column.DataType.Dimensions)
+}
+
+const pgtypeImportPath = "github.com/go-jet/jet/v2/postgres/pgtype"
+
+// getArrayValueType returns pgtype.ArrayValue model type, for the array of elemType elements with dimensions
+func getArrayValueType(elemType Type, dimensions int) Type {
+	elemType.Name = strings.Repeat("[]", max(dimensions-1, 0)) + elemType.Name
+
+	return wrapType(elemType, "pgtype.ArrayValue", pgtypeImportPath)
+}
+
 func getUserDefinedType(column metadata.Column) string {
 	switch column.DataType.Kind {
-	case metadata.EnumType:
+	case metadata.EnumType, metadata.CompositeType:
 		return dbidentifier.ToGoIdentifier(column.DataType.Name)
 	case metadata.UserDefinedType:
 		return "string"
@@ -315,7 +570,10 @@ func getGoType(column metadata.Column) Type {
 
 func toGoArrayType(elemType Type, column metadata.Column) Type {
 	if column.DataType.Dimensions > 1 {
-		return NewType("") // unsupported multidimensional arrays
+		if column.DataType.SourceDialect != "PostgreSQL" {
+			return NewType("") // unsupported multidimensional arrays
+		}
+		return getArrayValueType(elemType, column.DataType.Dimensions)
 	}
 
 	switch elemType.Name {
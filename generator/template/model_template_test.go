@@ -1,9 +1,12 @@
 package template
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,6 +46,16 @@ func TestTableModelField(t *testing.T) {
 		},
 		Tags: nil,
 	})
+
+	require.Equal(t, DefaultTableModelField(metadata.Column{
+		Name:       "rank",
+		IsNullable: true,
+		IsHidden:   true,
+		DataType: metadata.DataType{
+			Name: "REAL",
+			Kind: "base",
+		},
+	}), TableModelField{Skip: true})
 }
 
 func TestAddColumnTag(t *testing.T) {
@@ -167,6 +180,23 @@ func TestTableModelFieldSourceDialect(t *testing.T) {
 			expectedType:       "pq.StringArray",
 			expectedImportPath: "github.com/lib/pq",
 		},
+		{
+			name:               "postgres two-dimensional int array",
+			dataTypeName:       "int4",
+			sourceDialect:      "PostgreSQL",
+			dimensions:         2,
+			expectedType:       "pgtype.ArrayValue[[]int32]",
+			expectedImportPath: "github.com/go-jet/jet/v2/postgres/pgtype",
+		},
+		{
+			name:               "postgres nullable three-dimensional numeric array",
+			dataTypeName:       "numeric",
+			sourceDialect:      "PostgreSQL",
+			dimensions:         3,
+			isNullable:         true,
+			expectedType:       "*pgtype.ArrayValue[[][]decimal.Decimal]",
+			expectedImportPath: "github.com/go-jet/jet/v2/postgres/pgtype",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -229,3 +259,364 @@ func TestTableModelFieldPostgresRangeTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultTableModelRelation(t *testing.T) {
+	require.Equal(t, TableModelRelation{Name: "Language"}, DefaultTableModelRelation(metadata.ForeignKey{
+		Columns:         []string{"language_id"},
+		ReferencedTable: "language",
+	}))
+	require.Equal(t, TableModelRelation{Name: "BillingAddress", Tags: []string{`alias:"billing_address"`}}, DefaultTableModelRelation(metadata.ForeignKey{
+		Columns:         []string{"billing_address_id"},
+		ReferencedTable: "address",
+	}))
+	require.Equal(t, TableModelRelation{Name: "Pair"}, DefaultTableModelRelation(metadata.ForeignKey{
+		Columns:         []string{"pa", "pb"},
+		ReferencedTable: "pair",
+	}))
+}
+
+func TestGetTableModelRelationFields(t *testing.T) {
+	film, _ := foreignKeysSchema.Table("film")
+
+	require.Empty(t, getTableModelRelationFields(film, DefaultTableModel(film), foreignKeysSchema, DefaultModel(), "", nil))
+
+	relationFields := getTableModelRelationFields(film, DefaultTableModel(film).UseRelation(DefaultTableModelRelation),
+		foreignKeysSchema, DefaultModel(), "", nil)
+
+	// relation for the film_store_fk has the same name as billing address relation, and references other schema
+	require.Equal(t, []tableModelRelationField{
+		{TableModelRelation: TableModelRelation{Name: "Language"}, TypeName: "Language"},
+		{TableModelRelation: TableModelRelation{Name: "BillingAddress", Tags: []string{`alias:"billing_address"`}}, TypeName: "Address"},
+		{TableModelRelation: TableModelRelation{Name: "ShippingAddress", Tags: []string{`alias:"shipping_address"`}}, TypeName: "Address"},
+	}, relationFields)
+	require.Equal(t, "`alias:\"billing_address\"`", relationFields[1].TagsString())
+}
+
+func TestDefaultTableModelValidation(t *testing.T) {
+	var (
+		integerType = metadata.DataType{Name: "integer", Kind: metadata.BaseType}
+		numericType = metadata.DataType{Name: "numeric", Kind: metadata.BaseType}
+		textType    = metadata.DataType{Name: "text", Kind: metadata.BaseType}
+	)
+
+	testCases := []struct {
+		name        string
+		column      metadata.Column
+		expressions []string
+		expected    []string
+	}{
+		{
+			name:        "postgres comparison",
+			column:      metadata.Column{Name: "rental_duration", DataType: integerType},
+			expressions: []string{"(rental_duration >= (0)::integer)", "rental_duration < 100::integer"},
+			expected:    []string{`validate:"gte=0,lt=100"`},
+		},
+		{
+			name:        "postgres any array",
+			column:      metadata.Column{Name: "rating", IsNullable: true, DataType: textType},
+			expressions: []string{"(rating = ANY (ARRAY['G'::text, 'PG'::text, 'NC-17'::text]))"},
+			expected:    []string{`validate:"omitempty,oneof=G PG NC-17"`},
+		},
+		{
+			name:        "mysql length and in list",
+			column:      metadata.Column{Name: "code", DataType: textType},
+			expressions: []string{"(char_length(`code`) > 2)", "(`code` in (_utf8mb4'AB',_utf8mb4'CD'))"},
+			expected:    []string{`validate:"min=3"`},
+		},
+		{
+			name:        "sqlite between and not equal",
+			column:      metadata.Column{Name: "length", DataType: integerType},
+			expressions: []string{`"length" BETWEEN 1 AND 500 AND length <> 13`},
+			expected:    []string{`validate:"gte=1,lte=500,ne=13"`},
+		},
+		{
+			name:        "not empty text",
+			column:      metadata.Column{Name: "title", DataType: textType},
+			expressions: []string{"title <> ''"},
+			expected:    []string{`validate:"min=1"`},
+		},
+		{
+			name:        "flipped comparison",
+			column:      metadata.Column{Name: "amount", DataType: integerType},
+			expressions: []string{"0 < amount AND amount IS NOT NULL"},
+			expected:    []string{`validate:"gt=0"`},
+		},
+		{
+			name:        "numeric in list",
+			column:      metadata.Column{Name: "amount", DataType: integerType},
+			expressions: []string{"amount IN (1, 2, 5)"},
+			expected:    []string{`validate:"oneof=1 2 5"`},
+		},
+		{
+			name:        "or expression",
+			column:      metadata.Column{Name: "amount", DataType: integerType},
+			expressions: []string{"amount > 0 OR amount IS NULL"},
+			expected:    nil,
+		},
+		{
+			name:        "in list with spaces",
+			column:      metadata.Column{Name: "status", DataType: textType},
+			expressions: []string{"status IN ('in progress', 'done')"},
+			expected:    nil,
+		},
+		{
+			name:        "unrecognized condition",
+			column:      metadata.Column{Name: "amount", DataType: integerType},
+			expressions: []string{"amount > 0 AND amount % 2 = 0", "amount < 100"},
+			expected:    []string{`validate:"lt=100"`},
+		},
+		{
+			name:        "function call",
+			column:      metadata.Column{Name: "email", DataType: textType},
+			expressions: []string{"lower(email) <> ''", "email LIKE '%@%'"},
+			expected:    nil,
+		},
+		{
+			name:        "value comparison of text column",
+			column:      metadata.Column{Name: "code", DataType: textType},
+			expressions: []string{"code > 5", "code IN (1, 2)"},
+			expected:    nil,
+		},
+		{
+			name:        "length comparison of numeric column",
+			column:      metadata.Column{Name: "amount", DataType: integerType},
+			expressions: []string{"length(amount) < 5", "amount <> ''"},
+			expected:    nil,
+		},
+		{
+			name:        "text values in numeric in list",
+			column:      metadata.Column{Name: "amount", DataType: integerType},
+			expressions: []string{"amount IN ('1', '2')"},
+			expected:    nil,
+		},
+		{
+			name:        "decimal column",
+			column:      metadata.Column{Name: "rental_rate", DataType: numericType},
+			expressions: []string{"rental_rate >= 0"},
+			expected:    nil,
+		},
+		{
+			name:        "empty length",
+			column:      metadata.Column{Name: "code", DataType: textType},
+			expressions: []string{"length(code) < 0"},
+			expected:    nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var checkConstraints []metadata.CheckConstraint
+			for _, expression := range testCase.expressions {
+				checkConstraints = append(checkConstraints, metadata.NewCheckConstraint("", expression, []metadata.Column{testCase.column}))
+				require.Equal(t, []string{testCase.column.Name}, checkConstraints[len(checkConstraints)-1].Columns)
+			}
+
+			require.Equal(t, testCase.expected, DefaultTableModelValidation(testCase.column, checkConstraints))
+		})
+	}
+
+	t.Run("multiple columns", func(t *testing.T) {
+		amount := metadata.Column{Name: "amount", DataType: integerType}
+		total := metadata.Column{Name: "total", DataType: integerType}
+		checkConstraint := metadata.NewCheckConstraint("", "amount > 0 AND total > 0", []metadata.Column{amount, total})
+
+		require.Nil(t, DefaultTableModelValidation(amount, []metadata.CheckConstraint{checkConstraint}))
+	})
+}
+
+func TestProcessSchemaComposite(t *testing.T) {
+	dataType := func(name string, kind metadata.DataTypeKind) metadata.DataType {
+		return metadata.DataType{Name: name, Kind: kind, Schema: "public", SourceDialect: "PostgreSQL"}
+	}
+	linesType := dataType("text", metadata.BaseType)
+	linesType.Dimensions = 2
+
+	schema := metadata.Schema{
+		Name: "public",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "customer",
+				Columns: []metadata.Column{
+					{Name: "id", IsPrimaryKey: true, DataType: dataType("int4", metadata.BaseType)},
+					{Name: "address", IsNullable: true, DataType: dataType("address", metadata.CompositeType)},
+				},
+			},
+		},
+		CompositesMetaData: []metadata.Composite{
+			{
+				Name:    "address",
+				Comment: "Postal address",
+				Attributes: []metadata.Column{
+					{Name: "city", IsNullable: true, DataType: dataType("text", metadata.BaseType)},
+					{Name: "value", IsNullable: true, DataType: dataType("numeric", metadata.BaseType)},
+					{Name: "secret", IsNullable: true, DataType: dataType("text", metadata.BaseType)},
+					{Name: "lines", DataType: linesType},
+				},
+			},
+		},
+	}
+
+	output := NewMemoryOutput()
+	generatorTemplate := Default(postgres.Dialect).UseOutput(output).
+		UseSchema(func(schema metadata.Schema) Schema {
+			return DefaultSchema(schema).UseModel(DefaultModel().
+				UseComposite(func(composite metadata.Composite) CompositeModel {
+					return DefaultCompositeModel(composite).UseField(func(attribute metadata.Column) TableModelField {
+						field := DefaultCompositeModelField(attribute)
+						field.Skip = attribute.Name == "secret"
+						return field
+					})
+				}))
+		})
+
+	err := ProcessSchema("gen", schema, generatorTemplate)
+	require.NoError(t, err)
+
+	customerModel := string(output.Files[filepath.Join("gen", "public", "model", "customer.go")])
+	require.Contains(t, customerModel, "Address *Address")
+
+	addressModel := string(output.Files[filepath.Join("gen", "public", "model", "address.go")])
+	require.Contains(t, addressModel, `"github.com/shopspring/decimal"`)
+	require.Equal(t, 1, strings.Count(addressModel, `"github.com/go-jet/jet/v2/postgres/pgtype"`))
+	require.Contains(t, addressModel, `// Postal address
+type Address struct {
+	City   *string
+	Value_ *decimal.Decimal
+	Lines  pgtype.ArrayValue[[]string]
+}`)
+	require.Contains(t, addressModel, `return pgtype.ScanComposite(value, &c.City, &c.Value_, nil, &c.Lines)`)
+	require.Contains(t, addressModel, `return pgtype.CompositeValue(c.City, c.Value_, nil, c.Lines)`)
+
+	customerTable := string(output.Files[filepath.Join("gen", "public", "table", "customer.go")])
+	require.Contains(t, customerTable, `Address postgres.ColumnRow`)
+	require.Contains(t, customerTable, `AddressColumn  = postgres.RowColumn("address")`)
+
+	addressSQLBuilder := string(output.Files[filepath.Join("gen", "public", "composite", "address.go")])
+	require.Contains(t, addressSQLBuilder, `func Address(row postgres.RowExpression) AddressRow {`)
+	require.Contains(t, addressSQLBuilder, `func (r AddressRow) Secret() postgres.StringExpression {`)
+}
+
+func TestProcessSchemaEnumArray(t *testing.T) {
+	dataType := func(name string, kind metadata.DataTypeKind, dimensions int) metadata.DataType {
+		return metadata.DataType{Name: name, Kind: kind, Dimensions: dimensions, Schema: "public", SourceDialect: "PostgreSQL"}
+	}
+
+	schema := metadata.Schema{
+		Name: "public",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "person",
+				Columns: []metadata.Column{
+					{Name: "id", IsPrimaryKey: true, DataType: dataType("int4", metadata.BaseType, 0)},
+					{Name: "moods", DataType: dataType("mood", metadata.EnumType, 1)},
+					{Name: "mood_history", IsNullable: true, DataType: dataType("mood", metadata.EnumType, 2)},
+					{Name: "tags", DataType: dataType("hstore", metadata.UserDefinedType, 1)},
+				},
+			},
+		},
+		EnumsMetaData: []metadata.Enum{
+			{Name: "mood", Values: []string{"happy", "sad"}},
+		},
+	}
+
+	output := NewMemoryOutput()
+
+	err := ProcessSchema("gen", schema, Default(postgres.Dialect).UseOutput(output))
+	require.NoError(t, err)
+
+	personModel := string(output.Files[filepath.Join("gen", "public", "model", "person.go")])
+	require.Contains(t, personModel, `"github.com/go-jet/jet/v2/postgres/pgtype"`)
+	require.Contains(t, personModel, `Moods       pgtype.ArrayValue[Mood]`)
+	require.Contains(t, personModel, `MoodHistory *pgtype.ArrayValue[[]Mood]`)
+	require.Contains(t, personModel, `Tags        pq.StringArray`)
+
+	personTable := string(output.Files[filepath.Join("gen", "public", "table", "person.go")])
+	require.Contains(t, personTable, `Moods       postgres.ColumnEnumArray`)
+	require.Contains(t, personTable, `MoodHistory postgres.ColumnEnumArray`)
+
+	moodSQLBuilder := string(output.Files[filepath.Join("gen", "public", "enum", "mood.go")])
+	require.Contains(t, moodSQLBuilder, `func MoodArray(values ...postgres.EnumExpression) postgres.Array[postgres.EnumExpression] {
+	return postgres.ArrayExp[postgres.EnumExpression](postgres.CAST(postgres.ARRAY(values...)).AS("mood[]"))
+}`)
+}
+
+func TestTableModelFieldUseNullable(t *testing.T) {
+	field := DefaultTableModelField(metadata.Column{
+		Name:       "price",
+		IsNullable: true,
+		DataType:   metadata.DataType{Name: "numeric", Kind: metadata.BaseType, SourceDialect: "PostgreSQL"},
+	}).UseTags(`json:"price"`)
+
+	require.Equal(t, field, field.UseNullable(NullablePointer))
+	require.Equal(t, Type{
+		Name:                  "sql.Null[decimal.Decimal]",
+		ImportPath:            "database/sql",
+		AdditionalImportPaths: []string{"github.com/shopspring/decimal"},
+	}, field.UseNullable(NullableSQLNull).Type)
+	require.Equal(t, Type{
+		Name:                  "qrm.Optional[decimal.Decimal]",
+		ImportPath:            "github.com/go-jet/jet/v2/qrm",
+		AdditionalImportPaths: []string{"github.com/shopspring/decimal"},
+	}, field.UseNullable(NullableOptional).Type)
+
+	zeroField := field.UseNullable(NullableZeroValue)
+	require.Equal(t, Type{Name: "decimal.Decimal", ImportPath: "github.com/shopspring/decimal"}, zeroField.Type)
+	require.Equal(t, []string{`json:"price,omitempty"`}, zeroField.Tags)
+	require.Equal(t, []string{`json:"price"`}, field.Tags)
+
+	require.Equal(t, []string{`sql:"primary_key"`, `json:",omitempty"`}, addOmitEmptyTag([]string{`sql:"primary_key"`}))
+	require.Equal(t, []string{`json:"-"`}, addOmitEmptyTag([]string{`json:"-"`}))
+
+	// non-pointer fields are not changed
+	nonPointerField := field.UseType(Type{Name: "string"})
+	require.Equal(t, nonPointerField, nonPointerField.UseNullable(NullableSQLNull))
+}
+
+func TestProcessSchemaNullable(t *testing.T) {
+	schema := metadata.Schema{
+		Name: "public",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "film",
+				Columns: []metadata.Column{
+					{Name: "id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "int4", Kind: metadata.BaseType}},
+					{Name: "title", IsNullable: true, DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+					{Name: "release_date", IsNullable: true, DataType: metadata.DataType{Name: "date", Kind: metadata.BaseType}},
+				},
+			},
+		},
+	}
+
+	generate := func(strategy NullableStrategy) string {
+		output := NewMemoryOutput()
+		generatorTemplate := Default(postgres.Dialect).UseOutput(output).
+			UseSchema(func(schema metadata.Schema) Schema {
+				return DefaultSchema(schema).UseModel(DefaultModel().UseNullable(strategy))
+			})
+
+		require.NoError(t, ProcessSchema("gen", schema, generatorTemplate))
+
+		return string(output.Files[filepath.Join("gen", "public", "model", "film.go")])
+	}
+
+	sqlNullModel := generate(NullableSQLNull)
+	require.Contains(t, sqlNullModel, `"database/sql"`)
+	require.Contains(t, sqlNullModel, `"time"`)
+	require.Contains(t, sqlNullModel, `Title       sql.Null[string]
+	ReleaseDate sql.Null[time.Time]`)
+
+	optionalModel := generate(NullableOptional)
+	require.Contains(t, optionalModel, `"github.com/go-jet/jet/v2/qrm"`)
+	require.Contains(t, optionalModel, `Title       qrm.Optional[string]`)
+
+	zeroModel := generate(NullableZeroValue)
+	require.Contains(t, zeroModel, "ID          int32     `sql:\"primary_key\"`")
+	require.Contains(t, zeroModel, "Title       string    `json:\",omitempty\"`")
+	require.Contains(t, zeroModel, "ReleaseDate time.Time `json:\",omitempty\"`")
+
+	err := ProcessSchema("gen", schema, Default(postgres.Dialect).UseOutput(NewMemoryOutput()).
+		UseSchema(func(schema metadata.Schema) Schema {
+			return DefaultSchema(schema).UseModel(DefaultModel().UseNullable("nil"))
+		}))
+	require.ErrorContains(t, err, "unsupported nullable strategy 'nil'")
+}
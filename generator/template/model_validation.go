@@ -0,0 +1,265 @@
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+)
+
+// DefaultTableModelValidation returns validation tag (https://github.com/go-playground/validator format) derived
+// from column check constraints. Only check constraints referencing just the column, with all the conditions
+// recognized, are used. Recognized conditions are: comparison of numeric column with a number, comparison of text
+// column length with a number, BETWEEN, IN list of values, non-empty text check and IS NOT NULL check. Check
+// constraints with any other condition, or with conditions combined with OR, are ignored.
+//
+//	CHECK (rental_duration >= 0 AND rental_duration < 100) -> `validate:"gte=0,lt=100"`
+func DefaultTableModelValidation(columnMetaData metadata.Column, checkConstraints []metadata.CheckConstraint) []string {
+	var rules []string
+
+	for _, checkConstraint := range checkConstraints {
+		if len(checkConstraint.Columns) != 1 || checkConstraint.Columns[0] != columnMetaData.Name {
+			continue
+		}
+
+		constraintRules, ok := checkValidationRules(columnMetaData, checkConstraint.Expression)
+		if !ok {
+			continue
+		}
+
+		rules = append(rules, constraintRules...)
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	if columnMetaData.IsNullable {
+		rules = append([]string{"omitempty"}, rules...)
+	}
+
+	return []string{fmt.Sprintf(`validate:"%s"`, strings.Join(rules, ","))}
+}
+
+const numberPattern = `(-?\d+(?:\.\d+)?)`
+
+var (
+	castRegex            = regexp.MustCompile(`::(?:"\w+"|\w+)(?: \w+)*(?:\[\])?`)
+	parenthesisedNumber  = regexp.MustCompile(`\(\s*` + numberPattern + `\s*\)`)
+	comparisonOperators  = `(>=|<=|<>|!=|>|<)`
+	validationOperators  = map[string]string{">": "gt", ">=": "gte", "<": "lt", "<=": "lte", "<>": "ne", "!=": "ne"}
+	flippedOperators     = map[string]string{">": "<", ">=": "<=", "<": ">", "<=": ">=", "<>": "<>", "!=": "!="}
+	numberRegex          = regexp.MustCompile(`^` + numberPattern + `$`)
+	quotedTextRegex      = regexp.MustCompile(`^'([^'\s,]+)'$`)
+	spaceRegex           = regexp.MustCompile(`\s+`)
+	logicalOperatorRegex = regexp.MustCompile(`(?i)\s(AND|OR)\s`)
+)
+
+// checkValidationRules returns validation rules for the check constraint expression. If any of the expression
+// conditions is not recognized, or can not be validated for the column go type, false is returned.
+func checkValidationRules(columnMetaData metadata.Column, expression string) ([]string, bool) {
+	columnName := columnMetaData.Name
+	expression = normalizeCheckExpression(columnName, expression)
+
+	column := `(?:` + regexp.QuoteMeta(columnName) + `)`
+	between := regexp.MustCompile(`(?i)` + column + ` BETWEEN ` + numberPattern + ` AND ` + numberPattern)
+	expression = between.ReplaceAllString(expression, columnName+" >= $1 AND "+columnName+" <= $2")
+
+	conditions, ok := splitConjunction(expression)
+	if !ok {
+		return nil, false
+	}
+
+	var (
+		comparison         = regexp.MustCompile(`(?i)^` + column + ` ?` + comparisonOperators + ` ?` + numberPattern + `$`)
+		flippedComparison  = regexp.MustCompile(`(?i)^` + numberPattern + ` ?` + comparisonOperators + ` ?` + column + `$`)
+		lengthComparison   = regexp.MustCompile(`(?i)^(?:length|char_length|character_length)\(` + column + `\) ?` + comparisonOperators + ` ?(\d+)$`)
+		inList             = regexp.MustCompile(`(?i)^` + column + ` IN \((.*)\)$`)
+		anyArray           = regexp.MustCompile(`(?i)^` + column + ` ?= ?ANY ?\(ARRAY\[(.*)\]\)$`)
+		notEmptyComparison = regexp.MustCompile(`(?i)^` + column + ` ?(?:<>|!=) ?''$`)
+		notNull            = regexp.MustCompile(`(?i)^` + column + ` IS NOT NULL$`)
+	)
+
+	// validator compares numbers by value, but strings by length, so value rules are allowed only for numeric types
+	goType := strings.TrimPrefix(getGoType(columnMetaData).Name, "*")
+	isNumeric := strings.HasPrefix(goType, "int") || strings.HasPrefix(goType, "uint") || strings.HasPrefix(goType, "float")
+	isText := goType == "string"
+
+	var rules []string
+
+	for _, condition := range conditions {
+		condition = trimParentheses(condition)
+
+		var rule string
+
+		if match := comparison.FindStringSubmatch(condition); match != nil && isNumeric {
+			rule = comparisonRule(match[1], match[2])
+		} else if match := flippedComparison.FindStringSubmatch(condition); match != nil && isNumeric {
+			rule = comparisonRule(flippedOperators[match[2]], match[1])
+		} else if match := lengthComparison.FindStringSubmatch(condition); match != nil && isText {
+			rule = lengthRule(match[1], match[2])
+		} else if match := inList.FindStringSubmatch(condition); match != nil && (isNumeric || isText) {
+			rule = oneOfRule(match[1], isNumeric)
+		} else if match := anyArray.FindStringSubmatch(condition); match != nil && (isNumeric || isText) {
+			rule = oneOfRule(match[1], isNumeric)
+		} else if notEmptyComparison.MatchString(condition) && isText {
+			rule = "min=1"
+		} else if notNull.MatchString(condition) {
+			continue // field is not nullable, and there is nothing to validate
+		}
+
+		if rule == "" {
+			return nil, false
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, true
+}
+
+// normalizeCheckExpression removes type casts, column name quotes and redundant parentheses from the check expression
+func normalizeCheckExpression(columnName, expression string) string {
+	expression = castRegex.ReplaceAllString(expression, "")
+
+	for _, quote := range []string{`"`, "`", "[]"} {
+		openQuote, closeQuote := quote[:1], quote[len(quote)-1:]
+		expression = strings.ReplaceAll(expression, openQuote+columnName+closeQuote, columnName)
+	}
+
+	for parenthesisedNumber.MatchString(expression) {
+		expression = parenthesisedNumber.ReplaceAllString(expression, "$1")
+	}
+
+	expression = spaceRegex.ReplaceAllString(expression, " ")
+	expression = strings.ReplaceAll(expression, "( ", "(")
+	expression = strings.ReplaceAll(expression, " )", ")")
+
+	return trimParentheses(strings.TrimSpace(expression))
+}
+
+// splitConjunction splits expression on the top level AND operators. If expression contains top level OR operator,
+// false is returned.
+func splitConjunction(expression string) ([]string, bool) {
+	var (
+		ret   []string
+		start int
+		depth int
+	)
+
+	for i := 0; i < len(expression); i++ {
+		switch expression[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '\'':
+			if end := strings.IndexByte(expression[i+1:], '\''); end >= 0 {
+				i += end + 1
+			}
+		case ' ':
+			if depth != 0 {
+				continue
+			}
+
+			match := logicalOperatorRegex.FindStringSubmatchIndex(expression[i:])
+			if match == nil || match[0] != 0 {
+				continue
+			}
+
+			if strings.EqualFold(expression[i+match[2]:i+match[3]], "OR") {
+				return nil, false
+			}
+
+			ret = append(ret, strings.TrimSpace(expression[start:i]))
+			start = i + match[1]
+			i = start - 1
+		}
+	}
+
+	return append(ret, strings.TrimSpace(expression[start:])), true
+}
+
+// trimParentheses removes parentheses enclosing whole expression
+func trimParentheses(expression string) string {
+	for strings.HasPrefix(expression, "(") && strings.HasSuffix(expression, ")") {
+		depth := 0
+
+		for i := 0; i < len(expression); i++ {
+			switch expression[i] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+
+			if depth == 0 && i < len(expression)-1 {
+				return expression // first parenthesis is closed before the end of expression
+			}
+		}
+
+		expression = strings.TrimSpace(expression[1 : len(expression)-1])
+	}
+
+	return expression
+}
+
+func comparisonRule(operator, number string) string {
+	validationOperator, ok := validationOperators[operator]
+	if !ok {
+		return ""
+	}
+
+	return validationOperator + "=" + number
+}
+
+func lengthRule(operator, number string) string {
+	length, err := strconv.Atoi(number)
+	if err != nil {
+		return ""
+	}
+
+	switch operator {
+	case ">":
+		return "min=" + strconv.Itoa(length+1)
+	case ">=":
+		return "min=" + number
+	case "<":
+		if length == 0 {
+			return ""
+		}
+		return "max=" + strconv.Itoa(length-1)
+	case "<=":
+		return "max=" + number
+	}
+
+	return ""
+}
+
+// oneOfRule returns oneof rule for the list of numbers or the list of quoted texts. Texts containing spaces or
+// commas can not be used in oneof rule.
+func oneOfRule(valueList string, numeric bool) string {
+	var values []string
+
+	for _, value := range strings.Split(valueList, ",") {
+		value = strings.TrimSpace(value)
+
+		if numeric {
+			if !numberRegex.MatchString(value) {
+				return ""
+			}
+			values = append(values, value)
+			continue
+		}
+
+		match := quotedTextRegex.FindStringSubmatch(value)
+		if match == nil {
+			return ""
+		}
+		values = append(values, match[1])
+	}
+
+	return "oneof=" + strings.Join(values, " ")
+}
@@ -0,0 +1,84 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/utils/filesys"
+)
+
+// MemoryOutput is generator output which keeps generated files in memory, instead of saving them to the
+// destination directory. It is used to compare generated files with the files already saved to the destination
+// directory, without modifying them.
+type MemoryOutput struct {
+	// Dirs are the schema directories ProcessSchema would clean up before the generation
+	Dirs []string
+	// Files are formatted generated files content, keyed by file path
+	Files map[string][]byte
+}
+
+// NewMemoryOutput creates new empty memory output
+func NewMemoryOutput() *MemoryOutput {
+	return &MemoryOutput{
+		Files: map[string][]byte{},
+	}
+}
+
+// fileWriter saves generated files to the file system, or to the memory output if one is set
+type fileWriter struct {
+	memory *MemoryOutput
+}
+
+func (w fileWriter) cleanUp(dirPath string) error {
+	if w.memory == nil {
+		fmt.Println("Cleaning up destination directory...")
+		if err := os.RemoveAll(dirPath); err != nil {
+			return errors.New("failed to cleanup generated files")
+		}
+		return nil
+	}
+
+	w.memory.Dirs = append(w.memory.Dirs, dirPath)
+
+	for filePath := range w.memory.Files {
+		if strings.HasPrefix(filePath, dirPath+string(filepath.Separator)) {
+			delete(w.memory.Files, filePath)
+		}
+	}
+
+	return nil
+}
+
+func (w fileWriter) ensureDirPathExist(dirPath string) error {
+	if w.memory == nil {
+		return filesys.EnsureDirPathExist(dirPath)
+	}
+
+	return nil
+}
+
+func (w fileWriter) formatAndSaveGoFile(dirPath, fileName string, text []byte) error {
+	if w.memory == nil {
+		return filesys.FormatAndSaveGoFile(dirPath, fileName, text)
+	}
+
+	newGoFilePath := filepath.Join(dirPath, fileName)
+
+	if !strings.HasSuffix(newGoFilePath, ".go") {
+		newGoFilePath += ".go"
+	}
+
+	p, err := format.Source(text)
+	if err != nil {
+		w.memory.Files[newGoFilePath] = text
+		return fmt.Errorf("failed to format '%s': %w", fileName, err)
+	}
+
+	w.memory.Files[newGoFilePath] = p
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package template
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessSchemaMemoryOutput(t *testing.T) {
+	destDir := t.TempDir()
+	schemaDir := filepath.Join(destDir, "public")
+	require.NoError(t, os.MkdirAll(schemaDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(schemaDir, "existing.go"), []byte("package public\n"), 0o600))
+
+	schema := metadata.Schema{
+		Name: "public",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "film",
+				Columns: []metadata.Column{
+					{Name: "film_id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "int4", Kind: metadata.BaseType}},
+				},
+			},
+		},
+		EnumsMetaData: []metadata.Enum{
+			{Name: "mpaa_rating", Values: []string{"G", "PG"}},
+		},
+	}
+
+	output := NewMemoryOutput()
+	output.Files[filepath.Join(schemaDir, "stale.go")] = []byte("package public\n")
+
+	err := ProcessSchema(destDir, schema, Default(postgres.Dialect).UseOutput(output))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{schemaDir}, output.Dirs)
+
+	var filePaths []string
+	for filePath := range output.Files {
+		rel, err := filepath.Rel(schemaDir, filePath)
+		require.NoError(t, err)
+		filePaths = append(filePaths, filepath.ToSlash(rel))
+	}
+	slices.Sort(filePaths)
+
+	require.Equal(t, []string{
+		"enum/mpaa_rating.go",
+		"model/film.go",
+		"model/mpaa_rating.go",
+		"table/film.go",
+		"table/table_use_schema.go",
+	}, filePaths)
+	require.Contains(t, string(output.Files[filepath.Join(schemaDir, "model", "film.go")]), "type Film struct {\n\tFilmID int32 `sql:\"primary_key\"`\n}")
+
+	// destination directory is not modified
+	entries, err := os.ReadDir(schemaDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "existing.go", entries[0].Name())
+}
@@ -2,48 +2,81 @@ package template
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
-	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"text/template"
 
-	"github.com/go-jet/jet/v2/internal/utils/filesys"
-
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/internal/utils/filesys"
 )
 
 // ProcessSchema will process schema metadata and constructs go files using generator Template
 func ProcessSchema(dirPath string, schemaMetaData metadata.Schema, generatorTemplate Template) error {
+	packages := newSchemaPackages(dirPath, []metadata.Schema{schemaMetaData}, generatorTemplate)
+
+	return processSchema(dirPath, schemaMetaData, packages.templates[0], generatorTemplate, packages)
+}
+
+// ProcessSchemas will process metadata of multiple schemas and constructs go files for each schema using generator
+// Template. Enums, composite types and tables from the other processed schemas, referenced by the columns and
+// foreign keys, are resolved to the types from the other schema packages. Import paths of the generated packages
+// are determined from the go.mod file of the destination directory module.
+func ProcessSchemas(dirPath string, schemasMetaData []metadata.Schema, generatorTemplate Template) error {
+	packages := newSchemaPackages(dirPath, schemasMetaData, generatorTemplate)
+
+	packages.resolveUserTypes()
+
+	for i, schemaMetaData := range schemasMetaData {
+		err := processSchema(dirPath, schemaMetaData, packages.templates[i], generatorTemplate, packages)
+		if err != nil {
+			return fmt.Errorf("failed to generate schema %s: %w", schemaMetaData.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func processSchema(dirPath string,
+	schemaMetaData metadata.Schema,
+	schemaTemplate Schema,
+	generatorTemplate Template,
+	packages *schemaPackages) error {
+
 	if schemaMetaData.IsEmpty() {
 		return nil
 	}
 
-	schemaTemplate := generatorTemplate.Schema(schemaMetaData)
 	schemaPath := filepath.Join(dirPath, schemaTemplate.Path)
 
+	writer := fileWriter{memory: generatorTemplate.Output}
+
 	fmt.Println("Destination directory:", schemaPath)
-	fmt.Println("Cleaning up destination directory...")
-	if err := os.RemoveAll(schemaPath); err != nil {
-		return errors.New("failed to cleanup generated files")
+	if err := writer.cleanUp(schemaPath); err != nil {
+		return err
 	}
 
-	err := processModel(schemaPath, schemaMetaData, schemaTemplate)
+	err := processModel(writer, schemaPath, schemaMetaData, schemaTemplate, packages)
 	if err != nil {
 		return fmt.Errorf("failed to generate model types: %w", err)
 	}
 
-	err = processSQLBuilder(schemaPath, generatorTemplate.Dialect, schemaMetaData, schemaTemplate)
+	err = processSQLBuilder(writer, schemaPath, generatorTemplate.Dialect, schemaMetaData, schemaTemplate, packages)
 	if err != nil {
 		return fmt.Errorf("failed to generate sql builder types: %w", err)
 	}
 
+	err = processRepository(writer, schemaPath, generatorTemplate.Dialect, schemaMetaData, schemaTemplate, packages)
+	if err != nil {
+		return fmt.Errorf("failed to generate repository types: %w", err)
+	}
+
 	return nil
 }
 
-func processModel(dirPath string, schemaMetaData metadata.Schema, schemaTemplate Schema) error {
+func processModel(writer fileWriter, dirPath string, schemaMetaData metadata.Schema, schemaTemplate Schema, packages *schemaPackages) error {
 	modelTemplate := schemaTemplate.Model
 
 	if modelTemplate.Skip {
@@ -51,32 +84,41 @@ func processModel(dirPath string, schemaMetaData metadata.Schema, schemaTemplate
 		return nil
 	}
 
+	if modelTemplate.Nullable != "" && !slices.Contains(NullableStrategies, modelTemplate.Nullable) {
+		return fmt.Errorf("unsupported nullable strategy '%s'", modelTemplate.Nullable)
+	}
+
 	modelDirPath := filepath.Join(dirPath, modelTemplate.Path)
 
-	err := filesys.EnsureDirPathExist(modelDirPath)
+	err := writer.ensureDirPathExist(modelDirPath)
 	if err != nil {
 		return fmt.Errorf("destination dir path does not exist: %w", err)
 	}
 
-	err = processTableModels("table", modelDirPath, schemaMetaData.TablesMetaData, modelTemplate)
+	err = processTableModels(writer, "table", modelDirPath, schemaMetaData, schemaMetaData.TablesMetaData, modelTemplate, packages)
 	if err != nil {
 		return fmt.Errorf("failed to generate table model types: %w", err)
 	}
 
-	err = processTableModels("view", modelDirPath, schemaMetaData.ViewsMetaData, modelTemplate)
+	err = processTableModels(writer, "view", modelDirPath, schemaMetaData, schemaMetaData.ViewsMetaData, modelTemplate, packages)
 	if err != nil {
 		return fmt.Errorf("failed to generate view model types: %w", err)
 	}
 
-	err = processEnumModels(modelDirPath, schemaMetaData.EnumsMetaData, modelTemplate)
+	err = processEnumModels(writer, modelDirPath, schemaMetaData.EnumsMetaData, modelTemplate)
 	if err != nil {
 		return fmt.Errorf("failed to process enum types: %w", err)
 	}
 
+	err = processCompositeModels(writer, modelDirPath, schemaMetaData, modelTemplate, packages)
+	if err != nil {
+		return fmt.Errorf("failed to process composite types: %w", err)
+	}
+
 	return nil
 }
 
-func processSQLBuilder(dirPath string, dialect jet.Dialect, schemaMetaData metadata.Schema, schemaTemplate Schema) error {
+func processSQLBuilder(writer fileWriter, dirPath string, dialect jet.Dialect, schemaMetaData metadata.Schema, schemaTemplate Schema, packages *schemaPackages) error {
 	sqlBuilderTemplate := schemaTemplate.SQLBuilder
 
 	if sqlBuilderTemplate.Skip {
@@ -86,25 +128,112 @@ func processSQLBuilder(dirPath string, dialect jet.Dialect, schemaMetaData metad
 
 	sqlBuilderPath := filepath.Join(dirPath, sqlBuilderTemplate.Path)
 
-	err := processTableSQLBuilder("table", sqlBuilderPath, dialect, schemaMetaData, schemaMetaData.TablesMetaData, sqlBuilderTemplate)
+	err := processTableSQLBuilder(writer, "table", sqlBuilderPath, dialect, schemaMetaData, schemaMetaData.TablesMetaData, sqlBuilderTemplate, packages)
 	if err != nil {
 		return fmt.Errorf("failed to process table sql builder types: %w", err)
 	}
 
-	err = processTableSQLBuilder("view", sqlBuilderPath, dialect, schemaMetaData, schemaMetaData.ViewsMetaData, sqlBuilderTemplate)
+	err = processTableSQLBuilder(writer, "view", sqlBuilderPath, dialect, schemaMetaData, schemaMetaData.ViewsMetaData, sqlBuilderTemplate, packages)
 	if err != nil {
 		return fmt.Errorf("failed to process view sql builder types: %w", err)
 	}
 
-	err = processEnumSQLBuilder(sqlBuilderPath, dialect, schemaMetaData.EnumsMetaData, sqlBuilderTemplate)
+	err = processEnumSQLBuilder(writer, sqlBuilderPath, dialect, schemaMetaData.EnumsMetaData, sqlBuilderTemplate)
 	if err != nil {
 		return fmt.Errorf("failed to process enum types: %w", err)
 	}
 
+	err = processCompositeSQLBuilder(writer, sqlBuilderPath, dialect, schemaMetaData, sqlBuilderTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to process composite types: %w", err)
+	}
+
+	return nil
+}
+
+func processRepository(writer fileWriter, dirPath string, dialect jet.Dialect, schemaMetaData metadata.Schema, schemaTemplate Schema,
+	packages *schemaPackages) error {
+	repositoryTemplate := schemaTemplate.Repository
+
+	if repositoryTemplate.Skip || repositoryTemplate.Table == nil || len(schemaMetaData.TablesMetaData) == 0 {
+		return nil
+	}
+
+	if schemaTemplate.Model.Skip || schemaTemplate.SQLBuilder.Skip {
+		fmt.Println("Skipping the generation of repository types, model and SQL Builder types are not generated.")
+		return nil
+	}
+
+	repositoryPath := filepath.Join(dirPath, repositoryTemplate.Path)
+	modelPath := filepath.Join(dirPath, schemaTemplate.Model.Path)
+
+	modelImportPath, err := filesys.GoImportPath(modelPath)
+	if err != nil {
+		return fmt.Errorf("failed to determine model package import path: %w", err)
+	}
+
+	fmt.Printf("Generating table repository files\n")
+
+	for _, tableMetaData := range schemaMetaData.TablesMetaData {
+		repository := repositoryTemplate.Table(tableMetaData)
+		tableModel := schemaTemplate.Model.Table(tableMetaData)
+		tableSQLBuilder := schemaTemplate.SQLBuilder.Table(tableMetaData)
+
+		if repository.Skip || tableModel.Skip || tableSQLBuilder.Skip {
+			continue
+		}
+
+		tableImportPath, err := filesys.GoImportPath(filepath.Join(dirPath, schemaTemplate.SQLBuilder.Path, tableSQLBuilder.Path))
+		if err != nil {
+			return fmt.Errorf("failed to determine table sql builder package import path: %w", err)
+		}
+
+		err = writer.ensureDirPathExist(repositoryPath)
+		if err != nil {
+			return fmt.Errorf("failed to create repository directory - %s: %w", repositoryPath, err)
+		}
+
+		structField := tableModelStructField(tableMetaData, tableModel, schemaTemplate.Model, modelPath, schemaMetaData, packages)
+
+		data := getTableRepository(dialect.Name(), tableMetaData, repository, tableModel, structField, tableSQLBuilder,
+			schemaTemplate.Model.PackageName(), tableSQLBuilder.PackageName())
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+tableRepositoryTemplate,
+			data,
+			template.FuncMap{
+				"package": func() string {
+					return repositoryTemplate.PackageName()
+				},
+				"dialect": func() jet.Dialect {
+					return dialect
+				},
+				"repositoryImports": func() []string {
+					return getTableRepositoryImports(dialect, data, modelImportPath, tableImportPath)
+				},
+				"primaryKeyCondition": func(row bool) any {
+					return struct {
+						Table       string
+						Dialect     string
+						Row         bool
+						PrimaryKeys []repositoryPrimaryKey
+					}{data.Table, dialect.PackageName(), row, data.PrimaryKeys}
+				},
+			})
+		if err != nil {
+			return fmt.Errorf("failed to generate table repository type %s: %w", data.TypeName, err)
+		}
+
+		err = writer.formatAndSaveGoFile(repositoryPath, repository.FileName, text)
+		if err != nil {
+			return fmt.Errorf("failed to format and save generated repository type '%s': %w", repository.FileName, err)
+		}
+	}
+
 	return nil
 }
 
-func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []metadata.Enum, sqlBuilder SQLBuilder) error {
+func processEnumSQLBuilder(writer fileWriter, dirPath string, dialect jet.Dialect, enumsMetaData []metadata.Enum, sqlBuilder SQLBuilder) error {
 	if len(enumsMetaData) == 0 {
 		return nil
 	}
@@ -120,7 +249,7 @@ func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []
 
 		enumSQLBuilderPath := filepath.Join(dirPath, enumTemplate.Path)
 
-		err := filesys.EnsureDirPathExist(enumSQLBuilderPath)
+		err := writer.ensureDirPathExist(enumSQLBuilderPath)
 		if err != nil {
 			return fmt.Errorf("failed to create enum sql builder directory - %s: %w", enumSQLBuilderPath, err)
 		}
@@ -141,13 +270,19 @@ func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []
 				"enumValueName": func(enumValue string) string {
 					return enumTemplate.ValueName(enumValue)
 				},
+				"enumArrayType": func() string {
+					if dialect.Name() != "PostgreSQL" {
+						return ""
+					}
+					return enumArrayType(enumMetaData.Name)
+				},
 				"golangComment": formatGolangComment,
 			})
 		if err != nil {
 			return fmt.Errorf("failed to generete enum type %s: %w", enumTemplate.FileName, err)
 		}
 
-		err = filesys.FormatAndSaveGoFile(enumSQLBuilderPath, enumTemplate.FileName, text)
+		err = writer.formatAndSaveGoFile(enumSQLBuilderPath, enumTemplate.FileName, text)
 		if err != nil {
 			return fmt.Errorf("failed to format and save '%s' enum type : %w", enumTemplate.FileName, err)
 		}
@@ -156,11 +291,67 @@ func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []
 	return nil
 }
 
-func processTableSQLBuilder(fileTypes, dirPath string,
+func processCompositeSQLBuilder(writer fileWriter, dirPath string, dialect jet.Dialect, schemaMetaData metadata.Schema,
+	sqlBuilder SQLBuilder) error {
+
+	if len(schemaMetaData.CompositesMetaData) == 0 || sqlBuilder.Composite == nil {
+		return nil
+	}
+
+	fmt.Printf("Generating composite type sql builder files\n")
+
+	for _, compositeMetaData := range schemaMetaData.CompositesMetaData {
+		compositeTemplate := sqlBuilder.Composite(compositeMetaData)
+
+		if compositeTemplate.Skip {
+			continue
+		}
+
+		compositeSQLBuilderPath := filepath.Join(dirPath, compositeTemplate.Path)
+
+		err := writer.ensureDirPathExist(compositeSQLBuilderPath)
+		if err != nil {
+			return fmt.Errorf("failed to create composite type sql builder directory - %s: %w", compositeSQLBuilderPath, err)
+		}
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+compositeSQLBuilderTemplate,
+			compositeMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return compositeTemplate.PackageName()
+				},
+				"dialect": func() jet.Dialect {
+					return dialect
+				},
+				"compositeTemplate": func() CompositeSQLBuilder {
+					return compositeTemplate
+				},
+				"fieldAccessors": func() []compositeFieldAccessor {
+					return getCompositeFieldAccessors(dialect.PackageName(), compositeMetaData, compositeTemplate,
+						schemaMetaData, sqlBuilder)
+				},
+				"golangComment": formatGolangComment,
+			})
+		if err != nil {
+			return fmt.Errorf("failed to generate composite type %s: %w", compositeTemplate.FileName, err)
+		}
+
+		err = writer.formatAndSaveGoFile(compositeSQLBuilderPath, compositeTemplate.FileName, text)
+		if err != nil {
+			return fmt.Errorf("failed to format and save '%s' composite type : %w", compositeTemplate.FileName, err)
+		}
+	}
+
+	return nil
+}
+
+func processTableSQLBuilder(writer fileWriter, fileTypes, dirPath string,
 	dialect jet.Dialect,
 	schemaMetaData metadata.Schema,
 	tablesMetaData []metadata.Table,
-	sqlBuilderTemplate SQLBuilder) error {
+	sqlBuilderTemplate SQLBuilder,
+	packages *schemaPackages) error {
 
 	if len(tablesMetaData) == 0 {
 		return nil
@@ -185,11 +376,14 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 
 		tableSQLBuilderPath := filepath.Join(dirPath, tableSQLBuilder.Path)
 
-		err := filesys.EnsureDirPathExist(tableSQLBuilderPath)
+		err := writer.ensureDirPathExist(tableSQLBuilderPath)
 		if err != nil {
 			return fmt.Errorf("failed to create table sql builder directory - %s: %w", tableSQLBuilderPath, err)
 		}
 
+		joinHelpers := getTableJoinHelpers(dialect, tableMetaData, tableSQLBuilder, schemaMetaData, sqlBuilderTemplate,
+			tableSQLBuilderPath, packages)
+
 		text, err := generateTemplate(
 			autoGenWarningTemplate+tableSQLBuilderTemplate,
 			tableMetaData,
@@ -218,6 +412,15 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 					return insertedRowAlias(dialect)
 				},
 				"golangComment": formatGolangComment,
+				"conflictTargets": func() []tableConflictTarget {
+					return getTableConflictTargets(dialect, tableMetaData, tableSQLBuilder)
+				},
+				"joinHelpers": func() []tableJoinHelper {
+					return joinHelpers
+				},
+				"sqlBuilderImports": func() []string {
+					return getTableJoinHelpersImports(joinHelpers, packages)
+				},
 				"columnList": func(columns []metadata.Column) string {
 					names := []string{}
 					for _, col := range columns {
@@ -234,7 +437,7 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 			return fmt.Errorf("failed to generate table sql builder type %s: %w", tableSQLBuilder.TypeName, err)
 		}
 
-		err = filesys.FormatAndSaveGoFile(tableSQLBuilderPath, tableSQLBuilder.FileName, text)
+		err = writer.formatAndSaveGoFile(tableSQLBuilderPath, tableSQLBuilder.FileName, text)
 		if err != nil {
 			return fmt.Errorf("failed to format and save generated sql builder type '%s': %w", tableSQLBuilder.FileName, err)
 		}
@@ -242,7 +445,7 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 		generatedBuilders = append(generatedBuilders, tableSQLBuilder)
 	}
 
-	err := generateUseSchemaFunc(dirPath, fileTypes, generatedBuilders)
+	err := generateUseSchemaFunc(writer, dirPath, fileTypes, generatedBuilders)
 	if err != nil {
 		return fmt.Errorf("failed to generate UseSchema function")
 	}
@@ -250,7 +453,208 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 	return nil
 }
 
-func generateUseSchemaFunc(dirPath, fileTypes string, builders []TableSQLBuilder) error {
+// tableConflictTarget is conflict target field generated for table unique index
+type tableConflictTarget struct {
+	Name      string
+	Columns   string
+	Predicate string
+}
+
+// getTableConflictTargets returns conflict targets for table unique indexes. Expression indexes and indexes
+// containing skipped columns are ignored.
+func getTableConflictTargets(dialect jet.Dialect, tableMetaData metadata.Table, tableSQLBuilder TableSQLBuilder) []tableConflictTarget {
+	if tableSQLBuilder.ConflictTarget == nil || dialect.Name() == "MySQL" {
+		return nil
+	}
+
+	usedNames := map[string]bool{}
+	for _, reservedName := range append(reservedKeywords, strings.ToUpper(insertedRowAlias(dialect))) {
+		usedNames[reservedName] = true
+	}
+	for _, column := range tableMetaData.Columns {
+		usedNames[tableSQLBuilder.Column(column).Name] = true
+	}
+
+	var ret []tableConflictTarget
+
+	for _, index := range tableMetaData.UniqueIndexes() {
+		conflictTargetTemplate := tableSQLBuilder.ConflictTarget(index)
+
+		if conflictTargetTemplate.Skip || usedNames[conflictTargetTemplate.Name] {
+			continue
+		}
+
+		var columns []string
+
+		for _, columnName := range index.Columns {
+			column, ok := tableMetaData.Column(columnName)
+			if !ok {
+				break
+			}
+
+			columnField := tableSQLBuilder.Column(column)
+			if columnField.Skip {
+				break
+			}
+
+			columns = append(columns, columnField.Name+"Column")
+		}
+
+		if len(columns) == 0 || len(columns) != len(index.Columns) {
+			continue
+		}
+
+		usedNames[conflictTargetTemplate.Name] = true
+		ret = append(ret, tableConflictTarget{
+			Name:      conflictTargetTemplate.Name,
+			Columns:   strings.Join(columns, ", "),
+			Predicate: index.Predicate,
+		})
+	}
+
+	return ret
+}
+
+// tableJoinHelper is join helper method generated for table foreign key
+type tableJoinHelper struct {
+	Name                   string
+	ForeignKeyName         string
+	ReferencedTypeName     string
+	ReferencedInstanceName string
+	ImportPath             string // import path of the referenced table package, if the table is from the other schema
+	Conditions             []tableJoinCondition
+}
+
+type tableJoinCondition struct {
+	Column           string
+	ReferencedColumn string
+}
+
+// getTableJoinHelpers returns join helpers for table foreign keys. Foreign keys referencing tables from schemas
+// not processed in the same run, skipped tables, tables generated in a different package of the same schema or
+// tables whose package can not be imported are ignored, as well as foreign keys whose column pairs are of different
+// sql builder types.
+func getTableJoinHelpers(dialect jet.Dialect,
+	tableMetaData metadata.Table,
+	tableSQLBuilder TableSQLBuilder,
+	schemaMetaData metadata.Schema,
+	sqlBuilderTemplate SQLBuilder,
+	tableSQLBuilderPath string,
+	packages *schemaPackages) []tableJoinHelper {
+
+	if tableSQLBuilder.ForeignKey == nil {
+		return nil
+	}
+
+	usedNames := map[string]bool{"AS": true, "FromSchema": true, "WithPrefix": true, "WithSuffix": true}
+	for _, reservedName := range append(reservedKeywords, strings.ToUpper(insertedRowAlias(dialect))) {
+		usedNames[reservedName] = true
+	}
+	for _, column := range tableMetaData.Columns {
+		usedNames[tableSQLBuilder.Column(column).Name] = true
+	}
+	for _, conflictTarget := range getTableConflictTargets(dialect, tableMetaData, tableSQLBuilder) {
+		usedNames[conflictTarget.Name] = true
+	}
+
+	var ret []tableJoinHelper
+
+	for _, foreignKey := range tableMetaData.ForeignKeys {
+		foreignKeyTemplate := tableSQLBuilder.ForeignKey(foreignKey)
+
+		if foreignKeyTemplate.Skip || usedNames[foreignKeyTemplate.Name] {
+			continue
+		}
+
+		isOtherSchema := foreignKey.ReferencedSchema != schemaMetaData.Name
+		referencedSchema, referencedSchemaTemplate := schemaMetaData, Schema{SQLBuilder: sqlBuilderTemplate}
+
+		if isOtherSchema {
+			otherSchema, otherSchemaTemplate, ok := packages.schema(foreignKey.ReferencedSchema)
+			if !ok || otherSchemaTemplate.SQLBuilder.Skip {
+				continue
+			}
+
+			referencedSchema, referencedSchemaTemplate = otherSchema, otherSchemaTemplate
+		}
+
+		referencedTable, ok := referencedSchema.Table(foreignKey.ReferencedTable)
+		if !ok {
+			continue
+		}
+
+		referencedSQLBuilder := referencedSchemaTemplate.SQLBuilder.Table(referencedTable)
+		if referencedSQLBuilder.Skip || (!isOtherSchema && referencedSQLBuilder.Path != tableSQLBuilder.Path) {
+			continue
+		}
+
+		joinHelper := tableJoinHelper{
+			Name:                   foreignKeyTemplate.Name,
+			ForeignKeyName:         foreignKey.Name,
+			ReferencedTypeName:     referencedSQLBuilder.TypeName,
+			ReferencedInstanceName: referencedSQLBuilder.InstanceName,
+		}
+
+		for i, columnName := range foreignKey.Columns {
+			column, ok1 := tableMetaData.Column(columnName)
+			referencedColumn, ok2 := referencedTable.Column(foreignKey.ReferencedColumns[i])
+			if !ok1 || !ok2 {
+				break
+			}
+
+			columnField := tableSQLBuilder.Column(column)
+			referencedColumnField := referencedSQLBuilder.Column(referencedColumn)
+			if columnField.Skip || referencedColumnField.Skip || columnField.Type != referencedColumnField.Type {
+				break
+			}
+
+			joinHelper.Conditions = append(joinHelper.Conditions, tableJoinCondition{
+				Column:           columnField.Name,
+				ReferencedColumn: referencedColumnField.Name,
+			})
+		}
+
+		if len(joinHelper.Conditions) != len(foreignKey.Columns) {
+			continue
+		}
+
+		if isOtherSchema {
+			referencedSQLBuilderPath := filepath.Join(packages.dirPath, referencedSchemaTemplate.Path,
+				referencedSchemaTemplate.SQLBuilder.Path, referencedSQLBuilder.Path)
+
+			qualifier, importPath, ok := packages.packageQualifier(tableSQLBuilderPath, referencedSQLBuilderPath,
+				referencedSchema.Name, referencedSQLBuilder.PackageName())
+			if !ok {
+				continue
+			}
+
+			joinHelper.ReferencedTypeName = qualifier + referencedSQLBuilder.TypeName
+			joinHelper.ReferencedInstanceName = qualifier + referencedSQLBuilder.InstanceName
+			joinHelper.ImportPath = importPath
+		}
+
+		usedNames[joinHelper.Name] = true
+		ret = append(ret, joinHelper)
+	}
+
+	return ret
+}
+
+func getTableJoinHelpersImports(joinHelpers []tableJoinHelper, packages *schemaPackages) []string {
+	var ret []string
+
+	for _, joinHelper := range joinHelpers {
+		importSpec := packages.importSpec(joinHelper.ImportPath)
+
+		if joinHelper.ImportPath != "" && !slices.Contains(ret, importSpec) {
+			ret = append(ret, importSpec)
+		}
+	}
+
+	return ret
+}
+
+func generateUseSchemaFunc(writer fileWriter, dirPath, fileTypes string, builders []TableSQLBuilder) error {
 	if len(builders) == 0 {
 		return nil
 	}
@@ -270,7 +674,7 @@ func generateUseSchemaFunc(dirPath, fileTypes string, builders []TableSQLBuilder
 	basePath := filepath.Join(dirPath, builders[0].Path)
 	fileName := fileTypes + "_use_schema"
 
-	err = filesys.FormatAndSaveGoFile(basePath, fileName, text)
+	err = writer.formatAndSaveGoFile(basePath, fileName, text)
 	if err != nil {
 		return fmt.Errorf("failed to save %s file: %w", fileName, err)
 	}
@@ -286,7 +690,12 @@ func insertedRowAlias(dialect jet.Dialect) string {
 	return "excluded"
 }
 
-func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadata.Table, modelTemplate Model) error {
+func processTableModels(writer fileWriter, fileTypes, modelDirPath string,
+	schemaMetaData metadata.Schema,
+	tablesMetaData []metadata.Table,
+	modelTemplate Model,
+	packages *schemaPackages) error {
+
 	if len(tablesMetaData) == 0 {
 		return nil
 	}
@@ -305,6 +714,11 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 			continue
 		}
 
+		structField := tableModelStructField(tableMetaData, tableTemplate, modelTemplate, modelDirPath, schemaMetaData, packages)
+
+		relationFields := getTableModelRelationFields(tableMetaData, tableTemplate, schemaMetaData, modelTemplate,
+			modelDirPath, packages)
+
 		text, err := generateTemplate(
 			autoGenWarningTemplate+tableModelFileTemplate,
 			tableMetaData,
@@ -313,16 +727,14 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 					return modelTemplate.PackageName()
 				},
 				"modelImports": func() []string {
-					return getTableModelImports(tableTemplate, tableMetaData)
+					return getTableModelImports(tableMetaData.Columns, structField, relationFields, packages)
 				},
 				"tableTemplate": func() TableModel {
 					return tableTemplate
 				},
-				"structField": func(columnMetaData metadata.Column) TableModelField {
-					return addColumnTag(
-						tableTemplate.Field(columnMetaData),
-						columnMetaData,
-					)
+				"structField": structField,
+				"relationFields": func() []tableModelRelationField {
+					return relationFields
 				},
 				"golangComment": formatGolangComment,
 			})
@@ -330,7 +742,7 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 			return fmt.Errorf("failed to generate model type '%s': %w", tableMetaData.Name, err)
 		}
 
-		err = filesys.FormatAndSaveGoFile(modelDirPath, tableTemplate.FileName, text)
+		err = writer.formatAndSaveGoFile(modelDirPath, tableTemplate.FileName, text)
 		if err != nil {
 			return fmt.Errorf("failed to save '%s' model type: %w", tableTemplate.FileName, err)
 		}
@@ -339,7 +751,120 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 	return nil
 }
 
-func processEnumModels(modelDir string, enumsMetaData []metadata.Enum, modelTemplate Model) error {
+// tableModelStructField returns the function returning model field of the table column, as generated in the table
+// model file. Field type is resolved to the type from the other schema package, if needed, nullable strategy is applied
+// to the nullable column fields, and column and validation tags are added.
+func tableModelStructField(tableMetaData metadata.Table,
+	tableTemplate TableModel,
+	modelTemplate Model,
+	modelDirPath string,
+	schemaMetaData metadata.Schema,
+	packages *schemaPackages) func(columnMetaData metadata.Column) TableModelField {
+
+	return func(columnMetaData metadata.Column) TableModelField {
+		field := addColumnTag(
+			packages.resolveFieldType(tableTemplate.Field(columnMetaData), modelDirPath, schemaMetaData, columnMetaData),
+			columnMetaData,
+		)
+
+		if columnMetaData.IsNullable {
+			field = field.UseNullable(modelTemplate.Nullable)
+		}
+
+		if tableTemplate.Validation != nil {
+			field = field.UseTags(tableTemplate.Validation(
+				columnMetaData,
+				tableMetaData.ColumnCheckConstraints(columnMetaData.Name),
+			)...)
+		}
+
+		return field
+	}
+}
+
+// tableModelRelationField is model field pointing to the model of the table referenced by the foreign key
+type tableModelRelationField struct {
+	TableModelRelation
+	TypeName   string
+	ImportPath string // import path of the referenced model package, if the table is from the other schema
+}
+
+// getTableModelRelationFields returns relation fields of the table model. Self-references, references to tables
+// from schemas not processed in the same run, skipped tables or tables whose model package can not be imported,
+// and relations with already used field names are ignored.
+func getTableModelRelationFields(tableMetaData metadata.Table,
+	tableTemplate TableModel,
+	schemaMetaData metadata.Schema,
+	modelTemplate Model,
+	modelDirPath string,
+	packages *schemaPackages) []tableModelRelationField {
+
+	if tableTemplate.Relation == nil {
+		return nil
+	}
+
+	usedNames := map[string]bool{}
+	for _, column := range tableMetaData.Columns {
+		usedNames[tableTemplate.Field(column).Name] = true
+	}
+
+	var ret []tableModelRelationField
+
+	for _, foreignKey := range tableMetaData.ForeignKeys {
+		relation := tableTemplate.Relation(foreignKey)
+
+		isOtherSchema := foreignKey.ReferencedSchema != schemaMetaData.Name
+
+		if relation.Skip || usedNames[relation.Name] || (!isOtherSchema && foreignKey.ReferencedTable == tableMetaData.Name) {
+			continue
+		}
+
+		referencedSchema, referencedModelTemplate, referencedModelDirPath := schemaMetaData, modelTemplate, modelDirPath
+
+		if isOtherSchema {
+			otherSchema, otherSchemaTemplate, ok := packages.schema(foreignKey.ReferencedSchema)
+			if !ok || otherSchemaTemplate.Model.Skip {
+				continue
+			}
+
+			referencedSchema, referencedModelTemplate = otherSchema, otherSchemaTemplate.Model
+			referencedModelDirPath = filepath.Join(packages.dirPath, otherSchemaTemplate.Path, otherSchemaTemplate.Model.Path)
+		}
+
+		referencedTable, ok := referencedSchema.Table(foreignKey.ReferencedTable)
+		if !ok {
+			continue
+		}
+
+		referencedModel := referencedModelTemplate.Table(referencedTable)
+		if referencedModel.Skip {
+			continue
+		}
+
+		relationField := tableModelRelationField{
+			TableModelRelation: relation,
+			TypeName:           referencedModel.TypeName,
+		}
+
+		if isOtherSchema {
+			qualifier, importPath, ok := packages.packageQualifier(modelDirPath, referencedModelDirPath,
+				referencedSchema.Name, referencedModelTemplate.PackageName())
+			if !ok {
+				continue
+			}
+
+			relationField.TypeName = qualifier + referencedModel.TypeName
+			relationField.ImportPath = importPath
+		}
+
+		usedNames[relation.Name] = true
+		ret = append(ret, relationField)
+	}
+
+	return ret
+}
+
+func processEnumModels(writer fileWriter, modelDir string, enumsMetaData []metadata.Enum, modelTemplate Model) error {
 	if len(enumsMetaData) == 0 {
 		return nil
 	}
@@ -372,7 +897,7 @@ func processEnumModels(modelDir string, enumsMetaData []metadata.Enum, modelTemp
 			return fmt.Errorf("failed to generate enum type '%s': %w", enumMetaData.Name, err)
 		}
 
-		err = filesys.FormatAndSaveGoFile(modelDir, enumTemplate.FileName, text)
+		err = writer.formatAndSaveGoFile(modelDir, enumTemplate.FileName, text)
 		if err != nil {
 			return fmt.Errorf("failed to save '%s' enum type: %w", enumTemplate.FileName, err)
 		}
@@ -381,6 +906,66 @@ func processEnumModels(modelDir string, enumsMetaData []metadata.Enum, modelTemp
 	return nil
 }
 
+func processCompositeModels(writer fileWriter, modelDir string, schemaMetaData metadata.Schema, modelTemplate Model,
+	packages *schemaPackages) error {
+
+	if len(schemaMetaData.CompositesMetaData) == 0 || modelTemplate.Composite == nil {
+		return nil
+	}
+	fmt.Print("Generating composite type model files...\n")
+
+	for _, compositeMetaData := range schemaMetaData.CompositesMetaData {
+		compositeTemplate := modelTemplate.Composite(compositeMetaData)
+
+		if compositeTemplate.Skip {
+			continue
+		}
+
+		structField := func(attributeMetaData metadata.Column) TableModelField {
+			field := packages.resolveFieldType(compositeTemplate.Field(attributeMetaData), modelDir, schemaMetaData,
+				attributeMetaData)
+
+			if attributeMetaData.IsNullable {
+				field = field.UseNullable(modelTemplate.Nullable)
+			}
+
+			return field
+		}
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+compositeModelTemplate,
+			compositeMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return modelTemplate.PackageName()
+				},
+				"modelImports": func() []string {
+					imports := getTableModelImports(compositeMetaData.Attributes, structField, nil, packages)
+					if pgtypeImport := packages.importSpec(pgtypeImportPath); !slices.Contains(imports, pgtypeImport) {
+						imports = append(imports, pgtypeImport)
+					}
+					return imports
+				},
+				"compositeTemplate": func() CompositeModel {
+					return compositeTemplate
+				},
+				"structField":   structField,
+				"golangComment": formatGolangComment,
+			})
+
+		if err != nil {
+			return fmt.Errorf("failed to generate composite type '%s': %w", compositeMetaData.Name, err)
+		}
+
+		err = writer.formatAndSaveGoFile(modelDir, compositeTemplate.FileName, text)
+		if err != nil {
+			return fmt.Errorf("failed to save '%s' composite type: %w", compositeTemplate.FileName, err)
+		}
+	}
+
+	return nil
+}
+
 func generateTemplate(templateText string, templateData interface{}, funcMap template.FuncMap) ([]byte, error) {
 	t, err := template.New("sqlBuilderTableTemplate").Funcs(funcMap).Parse(templateText)
 
@@ -0,0 +1,356 @@
+package template
+
+import (
+	"fmt"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/internal/utils/dbidentifier"
+)
+
+// Repository is template for repository files generation. Repository type is generated for each table, with
+// typed data access methods on top of the table SQL builder and model types. Repository files are not generated
+// by default, DefaultRepository().ShouldSkip(false) can be used to enable them.
+type Repository struct {
+	Skip  bool
+	Path  string
+	Table func(table metadata.Table) TableRepository
+}
+
+// DefaultRepository returns default Repository template implementation
+func DefaultRepository() Repository {
+	return Repository{
+		Skip:  true,
+		Path:  "/repository",
+		Table: DefaultTableRepository,
+	}
+}
+
+// PackageName returns package name of repository types
+func (r Repository) PackageName() string {
+	return filepath.Base(r.Path)
+}
+
+// UsePath returns new Repository template with new relative path set
+func (r Repository) UsePath(path string) Repository {
+	r.Path = path
+	return r
+}
+
+// UseTable returns new Repository template with new TableRepository template function set
+func (r Repository) UseTable(tableFunc func(table metadata.Table) TableRepository) Repository {
+	r.Table = tableFunc
+	return r
+}
+
+// ShouldSkip returns new Repository template with new skip flag set
+func (r Repository) ShouldSkip(skip bool) Repository {
+	r.Skip = skip
+	return r
+}
+
+// TableRepository is template for table repository file generation
+type TableRepository struct {
+	Skip     bool
+	FileName string
+	TypeName string
+}
+
+// DefaultTableRepository returns default TableRepository implementation
+func DefaultTableRepository(tableMetaData metadata.Table) TableRepository {
+	return TableRepository{
+		FileName: dbidentifier.ToGoFileName(tableMetaData.Name),
+		TypeName: dbidentifier.ToGoIdentifier(tableMetaData.Name) + "Repository",
+	}
+}
+
+// UseFileName returns new TableRepository with new file name set
+func (t TableRepository) UseFileName(fileName string) TableRepository {
+	t.FileName = fileName
+	return t
+}
+
+// UseTypeName returns new TableRepository with new type name set
+func (t TableRepository) UseTypeName(typeName string) TableRepository {
+	t.TypeName = typeName
+	return t
+}
+
+// tableRepository is template data of the table repository file
+type tableRepository struct {
+	TableRepository
+	TableName   string
+	ModelType   string // qualified model type, for instance 'model.Film'
+	Table       string // qualified table SQL builder instance, for instance 'table.Film'
+	PrimaryKeys []repositoryPrimaryKey
+	// InsertColumns are columns set by the Insert method, primary key columns without default value and
+	// mutable columns without default value. Insert method is not generated if there are no such columns.
+	InsertColumns string
+	HasInsert     bool
+	HasMutable    bool
+	HasUpsert     bool
+	Returning     bool                  // dialect supports RETURNING clause
+	AutoIncrement *repositoryPrimaryKey // MySQL auto increment or SQLite rowid primary key
+	// UpsertColumns are mutable columns updated by MySQL Upsert method with VALUES(column) values. Unlike the
+	// row alias (AS_NEW), VALUES function is supported by both MySQL and MariaDB.
+	UpsertColumns []repositoryUpsertColumn
+	// SkippedMethods are notes about the methods not generated for the table, documented on the repository type
+	SkippedMethods []string
+}
+
+// repositoryPrimaryKey is primary key column of the table repository
+type repositoryPrimaryKey struct {
+	Column     string // SQL builder column field name
+	ModelField string
+	Param      string
+	Type       string
+	Literal    string           // dialect literal constructor for the Type values
+	Nullable   NullableStrategy // representation of the nullable model field, empty if model field is of the Type
+}
+
+// RowValue returns the primary key value of the model row
+func (p repositoryPrimaryKey) RowValue() string {
+	switch p.Nullable {
+	case NullablePointer:
+		return "*row." + p.ModelField
+	case NullableSQLNull, NullableOptional:
+		return "row." + p.ModelField + ".V"
+	default:
+		return "row." + p.ModelField
+	}
+}
+
+// NullCondition returns the condition checking whether the primary key value of the model row is NULL, or
+// empty string if model field can not be NULL
+func (p repositoryPrimaryKey) NullCondition() string {
+	switch p.Nullable {
+	case NullablePointer:
+		return "row." + p.ModelField + " == nil"
+	case NullableSQLNull, NullableOptional:
+		return "!row." + p.ModelField + ".Valid"
+	default:
+		return ""
+	}
+}
+
+// repositoryFieldType returns the type of the model field value, and the representation of the nullable model field,
+// for instance 'int32' and NullableSQLNull for sql.Null[int32] field. Zero value nullable fields are of the value type.
+func repositoryFieldType(fieldType string) (string, NullableStrategy) {
+	switch {
+	case strings.HasPrefix(fieldType, "*"):
+		return strings.TrimPrefix(fieldType, "*"), NullablePointer
+	case strings.HasPrefix(fieldType, "sql.Null[") && strings.HasSuffix(fieldType, "]"):
+		return strings.TrimSuffix(strings.TrimPrefix(fieldType, "sql.Null["), "]"), NullableSQLNull
+	case strings.HasPrefix(fieldType, "qrm.Optional[") && strings.HasSuffix(fieldType, "]"):
+		return strings.TrimSuffix(strings.TrimPrefix(fieldType, "qrm.Optional["), "]"), NullableOptional
+	default:
+		return fieldType, ""
+	}
+}
+
+// repositoryUpsertColumn is mutable column of the MySQL table repository Upsert method
+type repositoryUpsertColumn struct {
+	Column     string // SQL builder column field name
+	Expression string // dialect expression wrapper for the column type, for instance 'StringExp'
+}
+
+// repositoryUpsertExpressions are MySQL expression wrappers for the SQL builder column types
+var repositoryUpsertExpressions = map[string]string{
+	"Bool":      "BoolExp",
+	"Integer":   "IntExp",
+	"Float":     "FloatExp",
+	"String":    "StringExp",
+	"Blob":      "BlobExp",
+	"Date":      "DateExp",
+	"Time":      "TimeExp",
+	"Timestamp": "TimestampExp",
+	"Json":      "JsonExp",
+}
+
+// repositoryLiterals are dialect literal constructors for the supported primary key types
+var repositoryLiterals = map[string]string{
+	"bool":      "Bool",
+	"int8":      "Int8",
+	"int16":     "Int16",
+	"int32":     "Int32",
+	"int64":     "Int64",
+	"uint8":     "Uint8",
+	"uint16":    "Uint16",
+	"uint32":    "Uint32",
+	"uint64":    "Uint64",
+	"string":    "String",
+	"uuid.UUID": "UUID",
+}
+
+// getTableRepository returns template data of the table repository. Primary key methods are generated only if
+// all primary key columns have model fields and SQL builder columns, of the type with dialect literal constructor.
+// Model fields are the fields generated in the table model file, returned by structField.
+func getTableRepository(dialectName string,
+	tableMetaData metadata.Table,
+	repositoryTemplate TableRepository,
+	tableModel TableModel,
+	structField func(column metadata.Column) TableModelField,
+	tableSQLBuilder TableSQLBuilder,
+	modelPackage, tablePackage string) tableRepository {
+
+	ret := tableRepository{
+		TableRepository: repositoryTemplate,
+		TableName:       tableMetaData.Name,
+		ModelType:       modelPackage + "." + tableModel.TypeName,
+		Table:           tablePackage + "." + tableSQLBuilder.InstanceName,
+		Returning:       dialectName != "MySQL",
+	}
+
+	var primaryKeyColumns []metadata.Column
+	for _, column := range tableMetaData.Columns {
+		if column.IsPrimaryKey {
+			primaryKeyColumns = append(primaryKeyColumns, column)
+		}
+	}
+
+	var insertColumns []string
+	usedParams := map[string]bool{modelPackage: true, tablePackage: true}
+
+	for _, column := range primaryKeyColumns {
+		modelField := structField(column)
+		sqlBuilderColumn := tableSQLBuilder.Column(column)
+		fieldType, nullable := repositoryFieldType(modelField.Type.Name)
+		literal, ok := repositoryLiterals[fieldType]
+
+		if modelField.Skip || sqlBuilderColumn.Skip || !ok {
+			note := "Primary key methods are not generated, primary key column '" + column.Name + "' is not supported."
+			fmt.Println("- [Repository] Unsupported primary key column '" + tableMetaData.Name + "." + column.Name +
+				"', primary key methods are not generated.")
+			ret.SkippedMethods = append(ret.SkippedMethods, note)
+			ret.PrimaryKeys = nil
+			insertColumns = nil
+			break
+		}
+
+		primaryKey := repositoryPrimaryKey{
+			Column:     sqlBuilderColumn.Name,
+			ModelField: modelField.Name,
+			Param:      repositoryParamName(modelField.Name, usedParams),
+			Type:       fieldType,
+			Literal:    literal,
+			Nullable:   nullable,
+		}
+
+		ret.PrimaryKeys = append(ret.PrimaryKeys, primaryKey)
+
+		switch {
+		case column.IsAutoIncrement:
+			ret.AutoIncrement = &primaryKey
+		case column.HasDefault:
+		default:
+			insertColumns = append(insertColumns, ret.Table+"."+primaryKey.Column)
+		}
+	}
+
+	ret.HasInsert = len(insertColumns) > 0
+	ret.HasUpsert = len(ret.PrimaryKeys) > 0
+
+	for _, column := range tableMetaData.MutableColumns() {
+		sqlBuilderColumn := tableSQLBuilder.Column(column)
+
+		if sqlBuilderColumn.Skip {
+			continue
+		}
+
+		ret.HasMutable = true
+
+		if !column.HasDefault {
+			ret.HasInsert = true
+		}
+
+		if ret.Returning {
+			continue
+		}
+
+		expression, ok := repositoryUpsertExpressions[sqlBuilderColumn.Type]
+
+		if !ok && ret.HasUpsert {
+			fmt.Println("- [Repository] Unsupported column type '" + sqlBuilderColumn.Type + "' of the column '" +
+				tableMetaData.Name + "." + column.Name + "', Upsert method is not generated.")
+			ret.SkippedMethods = append(ret.SkippedMethods, "Upsert method is not generated, column '"+column.Name+
+				"' of type '"+sqlBuilderColumn.Type+"' is not supported.")
+			ret.HasUpsert = false
+		}
+
+		ret.UpsertColumns = append(ret.UpsertColumns, repositoryUpsertColumn{
+			Column:     sqlBuilderColumn.Name,
+			Expression: expression,
+		})
+	}
+
+	ret.HasUpsert = ret.HasUpsert && ret.HasMutable
+
+	ret.InsertColumns = strings.Join(append(insertColumns,
+		ret.Table+".MutableColumns.Except("+ret.Table+".DefaultColumns)"), ", ")
+
+	return ret
+}
+
+// NullPrimaryKeyCondition returns the condition checking whether any nullable primary key field of the row is NULL.
+// SQLite allows NULL values in the primary key columns, unless the column is declared NOT NULL.
+func (t tableRepository) NullPrimaryKeyCondition() string {
+	var conditions []string
+
+	for _, primaryKey := range t.PrimaryKeys {
+		if condition := primaryKey.NullCondition(); condition != "" {
+			conditions = append(conditions, condition)
+		}
+	}
+
+	return strings.Join(conditions, " || ")
+}
+
+// repositoryReservedNames are names used in the repository methods, which can not be used as parameter names
+var repositoryReservedNames = []string{"ctx", "r", "row", "dest", "err", "result", "ids", "values", "context", "qrm",
+	"uuid", "model", "table", "postgres", "mysql", "sqlite"}
+
+// repositoryParamName returns method parameter name for the model field name, for instance 'filmID' for 'FilmID'
+func repositoryParamName(fieldName string, usedParams map[string]bool) string {
+	runes := []rune(fieldName)
+
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+
+	if upper > 1 && upper < len(runes) {
+		upper-- // keep upper case initial of the next word, for instance URLPath -> urlPath
+	}
+
+	name := strings.ToLower(string(runes[:upper])) + string(runes[upper:])
+
+	for token.IsKeyword(name) || !token.IsIdentifier(name) || usedParams[name] || slices.Contains(repositoryReservedNames, name) {
+		name += "_"
+	}
+
+	usedParams[name] = true
+
+	return name
+}
+
+func getTableRepositoryImports(dialect jet.Dialect, repository tableRepository, modelImportPath, tableImportPath string) []string {
+	ret := []string{
+		`"github.com/go-jet/jet/v2/` + dialect.PackageName() + `"`,
+		`"github.com/go-jet/jet/v2/qrm"`,
+		`"` + modelImportPath + `"`,
+		`"` + tableImportPath + `"`,
+	}
+
+	for _, primaryKey := range repository.PrimaryKeys {
+		if primaryKey.Type == "uuid.UUID" {
+			return append(ret, `"github.com/google/uuid"`)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,326 @@
+package template
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryParamName(t *testing.T) {
+	usedParams := map[string]bool{"model": true}
+
+	require.Equal(t, "filmID", repositoryParamName("FilmID", usedParams))
+	require.Equal(t, "id", repositoryParamName("ID", usedParams))
+	require.Equal(t, "urlPath", repositoryParamName("URLPath", usedParams))
+	require.Equal(t, "type_", repositoryParamName("Type", usedParams))
+	require.Equal(t, "model_", repositoryParamName("Model", usedParams))
+	require.Equal(t, "row_", repositoryParamName("Row", usedParams))
+	require.Equal(t, "filmID_", repositoryParamName("FilmID", usedParams))
+}
+
+func TestGetTableRepository(t *testing.T) {
+	intType := metadata.DataType{Name: "int4", Kind: metadata.BaseType}
+	textType := metadata.DataType{Name: "text", Kind: metadata.BaseType}
+
+	film := metadata.Table{
+		Name: "film",
+		Columns: []metadata.Column{
+			{Name: "film_id", IsPrimaryKey: true, HasDefault: true, DataType: intType},
+			{Name: "title", DataType: textType},
+			{Name: "rating", HasDefault: true, DataType: intType},
+		},
+	}
+
+	structField := func(table metadata.Table, model Model) func(column metadata.Column) TableModelField {
+		return tableModelStructField(table, model.Table(table), model, "model", metadata.Schema{}, nil)
+	}
+
+	getRepository := func(dialectName string, table metadata.Table) tableRepository {
+		return getTableRepository(dialectName, table, DefaultTableRepository(table), DefaultTableModel(table),
+			structField(table, DefaultModel()), DefaultTableSQLBuilder(table), "model", "table")
+	}
+
+	repository := getRepository("PostgreSQL", film)
+	require.Equal(t, "FilmRepository", repository.TypeName)
+	require.Equal(t, "model.Film", repository.ModelType)
+	require.Equal(t, "table.Film", repository.Table)
+	require.Equal(t, []repositoryPrimaryKey{
+		{Column: "FilmID", ModelField: "FilmID", Param: "filmID", Type: "int32", Literal: "Int32"},
+	}, repository.PrimaryKeys)
+	require.Equal(t, "table.Film.MutableColumns.Except(table.Film.DefaultColumns)", repository.InsertColumns)
+	require.True(t, repository.HasInsert)
+	require.True(t, repository.HasMutable)
+	require.True(t, repository.Returning)
+	require.Nil(t, repository.AutoIncrement)
+
+	film.Columns[0].HasDefault = false
+	repository = getRepository("MySQL", film)
+	require.False(t, repository.Returning)
+	require.Nil(t, repository.AutoIncrement)
+	require.Equal(t, "table.Film.FilmID, table.Film.MutableColumns.Except(table.Film.DefaultColumns)", repository.InsertColumns)
+	require.True(t, repository.HasUpsert)
+	require.Equal(t, []repositoryUpsertColumn{
+		{Column: "Title", Expression: "StringExp"},
+		{Column: "Rating", Expression: "IntExp"},
+	}, repository.UpsertColumns)
+
+	film.Columns[0].IsAutoIncrement = true
+	repository = getRepository("MySQL", film)
+	require.Equal(t, &repository.PrimaryKeys[0], repository.AutoIncrement)
+	require.Equal(t, "table.Film.MutableColumns.Except(table.Film.DefaultColumns)", repository.InsertColumns)
+
+	repository = getTableRepository("MySQL", film, DefaultTableRepository(film), DefaultTableModel(film),
+		structField(film, DefaultModel()), DefaultTableSQLBuilder(film).UseColumn(func(column metadata.Column) TableSQLBuilderColumn {
+			sqlBuilderColumn := DefaultTableSQLBuilderColumn(column)
+			if column.Name == "rating" {
+				sqlBuilderColumn.Type = "Rating"
+			}
+			return sqlBuilderColumn
+		}), "model", "table")
+	require.True(t, repository.HasMutable)
+	require.False(t, repository.HasUpsert)
+	require.Equal(t, []string{"Upsert method is not generated, column 'rating' of type 'Rating' is not supported."},
+		repository.SkippedMethods)
+
+	filmActor := metadata.Table{
+		Name: "film_actor",
+		Columns: []metadata.Column{
+			{Name: "film_id", IsPrimaryKey: true, DataType: intType},
+			{Name: "actor_id", IsPrimaryKey: true, DataType: intType},
+		},
+	}
+
+	repository = getRepository("MySQL", filmActor)
+	require.Len(t, repository.PrimaryKeys, 2)
+	require.Nil(t, repository.AutoIncrement)
+	require.Equal(t, "table.FilmActor.FilmID, table.FilmActor.ActorID, "+
+		"table.FilmActor.MutableColumns.Except(table.FilmActor.DefaultColumns)", repository.InsertColumns)
+	require.True(t, repository.HasInsert)
+	require.False(t, repository.HasMutable)
+
+	tag := metadata.Table{
+		Name: "tag",
+		Columns: []metadata.Column{
+			{Name: "name", IsPrimaryKey: true, IsNullable: true, DataType: textType},
+		},
+	}
+
+	repository = getRepository("SQLite", tag)
+	require.Equal(t, []repositoryPrimaryKey{
+		{Column: "Name", ModelField: "Name", Param: "name", Type: "string", Literal: "String", Nullable: NullablePointer},
+	}, repository.PrimaryKeys)
+	require.Equal(t, "row.Name == nil", repository.NullPrimaryKeyCondition())
+
+	document := metadata.Table{
+		Name: "document",
+		Columns: []metadata.Column{
+			{Name: "id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "numeric", Kind: metadata.BaseType}},
+			{Name: "body", DataType: textType},
+		},
+	}
+
+	repository = getRepository("PostgreSQL", document)
+	require.Empty(t, repository.PrimaryKeys)
+	require.Equal(t, []string{"Primary key methods are not generated, primary key column 'id' is not supported."},
+		repository.SkippedMethods)
+	require.True(t, repository.HasInsert)
+	require.True(t, repository.HasMutable)
+}
+
+func TestProcessSchemaRepository(t *testing.T) {
+	moduleDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com/app\n"), 0o600))
+	destDir := filepath.Join(moduleDir, "gen")
+
+	schema := metadata.Schema{
+		Name: "public",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "film",
+				Columns: []metadata.Column{
+					{Name: "id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "int4", Kind: metadata.BaseType}},
+					{Name: "title", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+				},
+			},
+		},
+	}
+
+	generate := func(dialect jet.Dialect, repository Repository) *MemoryOutput {
+		output := NewMemoryOutput()
+		generatorTemplate := Default(dialect).UseOutput(output).
+			UseSchema(func(schema metadata.Schema) Schema {
+				return DefaultSchema(schema).UseRepository(repository)
+			})
+
+		require.NoError(t, ProcessSchema(destDir, schema, generatorTemplate))
+
+		return output
+	}
+
+	repositoryFile := filepath.Join(destDir, "public", "repository", "film.go")
+
+	output := generate(postgres.Dialect, DefaultRepository())
+	require.NotContains(t, output.Files, repositoryFile)
+
+	output = generate(postgres.Dialect, DefaultRepository().ShouldSkip(false))
+	postgresRepository := string(output.Files[repositoryFile])
+	require.Contains(t, postgresRepository, `"example.com/app/gen/public/model"`)
+	require.Contains(t, postgresRepository, `"example.com/app/gen/public/table"`)
+	require.Contains(t, postgresRepository, `"github.com/go-jet/jet/v2/postgres"`)
+	require.Contains(t, postgresRepository, `
+func (r FilmRepository) FindByID(ctx context.Context, id int32) (model.Film, error) {
+	var dest model.Film
+
+	err := postgres.SELECT(table.Film.AllColumns).
+		FROM(table.Film).
+		WHERE(table.Film.ID.EQ(postgres.Int32(id))).
+		QueryContext(ctx, r.db, &dest)
+
+	return dest, err
+}`)
+	require.Contains(t, postgresRepository, `
+func (r FilmRepository) Upsert(ctx context.Context, row *model.Film) error {
+	return table.Film.INSERT(table.Film.ID, table.Film.MutableColumns).
+		MODEL(row).
+		ON_CONFLICT(table.Film.ID).
+		DO_UPDATE(postgres.SET(table.Film.MutableColumns.SET(table.Film.EXCLUDED.MutableColumns))).
+		RETURNING(table.Film.AllColumns).
+		QueryContext(ctx, r.db, row)
+}`)
+	require.Contains(t, postgresRepository, "func (r FilmRepository) ListByIDs(ctx context.Context, ids ...int32) ([]model.Film, error) {")
+	require.Contains(t, postgresRepository, "func (r FilmRepository) DeleteByID(ctx context.Context, id int32) (int64, error) {")
+	require.Contains(t, postgresRepository, "func (r FilmRepository) Count(ctx context.Context) (int64, error) {")
+
+	schema.TablesMetaData[0].Columns[0].IsAutoIncrement = true
+
+	output = generate(mysql.Dialect, DefaultRepository().ShouldSkip(false).UsePath("store").
+		UseTable(func(table metadata.Table) TableRepository {
+			return DefaultTableRepository(table).UseTypeName("Films")
+		}))
+	mysqlRepository := string(output.Files[filepath.Join(destDir, "public", "store", "film.go")])
+	require.Contains(t, mysqlRepository, "package store")
+	require.Contains(t, mysqlRepository, `
+func (r Films) Insert(ctx context.Context, row *model.Film) error {
+	result, err := table.Film.INSERT(table.Film.MutableColumns.Except(table.Film.DefaultColumns)).
+		MODEL(row).
+		ExecContext(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	row.ID = int32(id)
+
+	return nil
+}`)
+	require.Contains(t, mysqlRepository, `
+func (r Films) Upsert(ctx context.Context, row *model.Film) error {
+	_, err := table.Film.INSERT(table.Film.ID, table.Film.MutableColumns).
+		MODEL(row).
+		ON_DUPLICATE_KEY_UPDATE(
+			table.Film.Title.SET(mysql.StringExp(mysql.Func("VALUES", table.Film.Title))),
+		).
+		ExecContext(ctx, r.db)
+
+	return err
+}`)
+}
+
+func TestProcessSchemaRepositoryNullablePrimaryKey(t *testing.T) {
+	moduleDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com/app\n"), 0o600))
+	destDir := filepath.Join(moduleDir, "gen")
+
+	// SQLite rowid alias primary key column is nullable, unless declared NOT NULL
+	schema := metadata.Schema{
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "film",
+				Columns: []metadata.Column{
+					{Name: "id", IsPrimaryKey: true, IsNullable: true, IsAutoIncrement: true,
+						DataType: metadata.DataType{Name: "INTEGER", Kind: metadata.BaseType}},
+					{Name: "title", DataType: metadata.DataType{Name: "TEXT", Kind: metadata.BaseType}},
+				},
+			},
+		},
+	}
+
+	generate := func(dialect jet.Dialect, nullable NullableStrategy) (string, string) {
+		output := NewMemoryOutput()
+		generatorTemplate := Default(dialect).UseOutput(output).
+			UseSchema(func(schema metadata.Schema) Schema {
+				return DefaultSchema(schema).
+					UseModel(DefaultModel().UseNullable(nullable)).
+					UseRepository(DefaultRepository().ShouldSkip(false))
+			})
+
+		require.NoError(t, ProcessSchema(destDir, schema, generatorTemplate))
+
+		return string(output.Files[filepath.Join(destDir, "model", "film.go")]),
+			string(output.Files[filepath.Join(destDir, "repository", "film.go")])
+	}
+
+	model, repository := generate(sqlite.Dialect, NullableSQLNull)
+	require.Contains(t, model, "\tID    sql.Null[int32] `sql:\"primary_key\"`\n")
+	require.Contains(t, repository, "func (r FilmRepository) FindByID(ctx context.Context, id int32) (model.Film, error) {")
+	require.Contains(t, repository, `
+func (r FilmRepository) Update(ctx context.Context, row *model.Film) error {
+	if !row.ID.Valid {
+		return qrm.ErrNoRows
+	}
+
+	return table.Film.UPDATE(table.Film.MutableColumns).
+		MODEL(row).
+		WHERE(table.Film.ID.EQ(sqlite.Int32(row.ID.V))).
+		RETURNING(table.Film.AllColumns).
+		QueryContext(ctx, r.db, row)
+}`)
+
+	_, repository = generate(mysql.Dialect, NullableSQLNull)
+	require.Contains(t, repository, `
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	row.ID.V, row.ID.Valid = int32(id), true
+
+	return nil
+}`)
+
+	model, repository = generate(sqlite.Dialect, NullableOptional)
+	require.Contains(t, model, "\tID    qrm.Optional[int32] `sql:\"primary_key\"`\n")
+	require.Contains(t, repository, "\tif !row.ID.Valid {\n")
+	require.Contains(t, repository, "WHERE(table.Film.ID.EQ(sqlite.Int32(row.ID.V))).")
+
+	_, repository = generate(mysql.Dialect, NullableOptional)
+	require.Contains(t, repository, "\n\trow.ID.V, row.ID.Valid = int32(id), true\n")
+
+	model, repository = generate(sqlite.Dialect, NullableZeroValue)
+	require.Contains(t, model, "\tID    int32 `sql:\"primary_key\" json:\",omitempty\"`\n")
+	require.NotContains(t, repository, "\tif row.ID")
+	require.NotContains(t, repository, "\tif !row.ID")
+	require.Contains(t, repository, "WHERE(table.Film.ID.EQ(sqlite.Int32(row.ID))).")
+
+	_, repository = generate(mysql.Dialect, NullableZeroValue)
+	require.Contains(t, repository, "\n\trow.ID = int32(id)\n")
+
+	model, repository = generate(sqlite.Dialect, NullablePointer)
+	require.Contains(t, model, "\tID    *int32 `sql:\"primary_key\"`\n")
+	require.Contains(t, repository, "\tif row.ID == nil {\n")
+	require.Contains(t, repository, "WHERE(table.Film.ID.EQ(sqlite.Int32(*row.ID))).")
+
+	_, repository = generate(mysql.Dialect, NullablePointer)
+	require.Contains(t, repository, "\n\tvalue := int32(id)\n\trow.ID = &value\n")
+}
@@ -0,0 +1,348 @@
+package template
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/internal/utils/dbidentifier"
+	"github.com/go-jet/jet/v2/internal/utils/filesys"
+)
+
+// schemaPackages resolves references to the types generated for the other schemas processed in the same
+// generator run (enums, composite types and tables referenced by foreign keys), into the types from the imported packages.
+// A reference is not resolved if the import would create an import cycle between the generated packages, and
+// the cycle is reported as a warning.
+type schemaPackages struct {
+	dirPath   string
+	schemas   []metadata.Schema
+	templates []Schema
+
+	importPaths    map[string]string          // import paths by package dir
+	aliases        map[string]string          // import aliases by import path
+	imports        map[string]map[string]bool // imported package dirs by package dir
+	skippedImports map[string]map[string]bool // package dirs not imported because of the import cycle, by package dir
+	importFailed   bool
+}
+
+func newSchemaPackages(dirPath string, schemas []metadata.Schema, generatorTemplate Template) *schemaPackages {
+	ret := &schemaPackages{
+		dirPath:        dirPath,
+		schemas:        schemas,
+		importPaths:    map[string]string{},
+		aliases:        map[string]string{},
+		imports:        map[string]map[string]bool{},
+		skippedImports: map[string]map[string]bool{},
+	}
+
+	for _, schema := range schemas {
+		ret.templates = append(ret.templates, generatorTemplate.Schema(schema))
+	}
+
+	return ret
+}
+
+// schema returns metadata and template of the schema processed in the same run
+func (p *schemaPackages) schema(name string) (metadata.Schema, Schema, bool) {
+	if p == nil {
+		return metadata.Schema{}, Schema{}, false
+	}
+
+	for i, schema := range p.schemas {
+		if schema.Name == name {
+			return schema, p.templates[i], true
+		}
+	}
+
+	return metadata.Schema{}, Schema{}, false
+}
+
+// packageQualifier returns the qualifier ('alias.') for the names from the package at toDir, if the package
+// can be imported by the package at fromDir. Qualifier is empty if both dirs are the same package.
+func (p *schemaPackages) packageQualifier(fromDir, toDir, schemaName, packageName string) (string, string, bool) {
+	fromDir, toDir = filepath.Clean(fromDir), filepath.Clean(toDir)
+
+	if fromDir == toDir {
+		return "", "", true
+	}
+
+	importPath, ok := p.importPath(toDir)
+	if !ok || !p.addImport(fromDir, toDir) {
+		return "", "", false
+	}
+
+	alias, ok := p.aliases[importPath]
+	if !ok {
+		alias = p.newAlias(schemaName, packageName)
+		p.aliases[importPath] = alias
+	}
+
+	return alias + ".", importPath, true
+}
+
+// importSpec returns import declaration of the import path, with the alias if the import path is a package
+// generated for the other schema
+func (p *schemaPackages) importSpec(importPath string) string {
+	if p != nil {
+		if alias, ok := p.aliases[importPath]; ok {
+			return alias + ` "` + importPath + `"`
+		}
+	}
+
+	return `"` + importPath + `"`
+}
+
+func (p *schemaPackages) importPath(dir string) (string, bool) {
+	if importPath, ok := p.importPaths[dir]; ok {
+		return importPath, true
+	}
+
+	if p.importFailed {
+		return "", false
+	}
+
+	importPath, err := filesys.GoImportPath(dir)
+	if err != nil {
+		fmt.Println("WARNING: references to the other schemas are not generated:", err)
+		p.importFailed = true
+		return "", false
+	}
+
+	p.importPaths[dir] = importPath
+
+	return importPath, true
+}
+
+// addImport adds import of the package at toDir to the package at fromDir, unless it creates an import cycle.
+// References that would create an import cycle are reported once per pair of packages.
+func (p *schemaPackages) addImport(fromDir, toDir string) bool {
+	if p.imports[fromDir][toDir] {
+		return true
+	}
+
+	if cycle := p.importCycle(toDir, fromDir, map[string]bool{}); cycle != nil {
+		if !p.skippedImports[fromDir][toDir] {
+			if p.skippedImports[fromDir] == nil {
+				p.skippedImports[fromDir] = map[string]bool{}
+			}
+			p.skippedImports[fromDir][toDir] = true
+
+			fmt.Printf("WARNING: references from '%s' to '%s' are not generated, import would create a cycle: %s\n",
+				p.relDir(fromDir), p.relDir(toDir), p.formatCycle(append([]string{fromDir}, cycle...)))
+		}
+		return false
+	}
+
+	if p.imports[fromDir] == nil {
+		p.imports[fromDir] = map[string]bool{}
+	}
+
+	p.imports[fromDir][toDir] = true
+
+	return true
+}
+
+// importCycle returns the chain of packages through which the package at fromDir directly or indirectly
+// imports the package at toDir, or nil if there is no such chain
+func (p *schemaPackages) importCycle(fromDir, toDir string, visited map[string]bool) []string {
+	if fromDir == toDir {
+		return []string{fromDir}
+	}
+
+	if visited[fromDir] {
+		return nil
+	}
+
+	visited[fromDir] = true
+
+	for _, importedDir := range slices.Sorted(maps.Keys(p.imports[fromDir])) {
+		if chain := p.importCycle(importedDir, toDir, visited); chain != nil {
+			return append([]string{fromDir}, chain...)
+		}
+	}
+
+	return nil
+}
+
+func (p *schemaPackages) formatCycle(dirs []string) string {
+	var ret []string
+
+	for _, dir := range dirs {
+		ret = append(ret, p.relDir(dir))
+	}
+
+	return strings.Join(ret, " -> ")
+}
+
+// relDir returns package dir relative to the generator destination dir
+func (p *schemaPackages) relDir(dir string) string {
+	rel, err := filepath.Rel(p.dirPath, dir)
+	if err != nil {
+		return dir
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// newAlias returns unused import alias, for instance 'salesmodel' for the model package of the 'sales' schema
+func (p *schemaPackages) newAlias(schemaName, packageName string) string {
+	alias := strings.ToLower(dbidentifier.ToGoIdentifier(schemaName) + packageName)
+
+	if alias == "" || (alias[0] >= '0' && alias[0] <= '9') {
+		alias = "_" + alias
+	}
+
+	ret := alias
+	for i := 2; p.isAliasUsed(ret); i++ {
+		ret = alias + strconv.Itoa(i)
+	}
+
+	return ret
+}
+
+func (p *schemaPackages) isAliasUsed(alias string) bool {
+	for _, usedAlias := range p.aliases {
+		if usedAlias == alias {
+			return true
+		}
+	}
+
+	return false
+}
+
+// userType returns model type of the column with the enum, enum array or composite type. Model type name is
+// the type name set by the model template. If the model type from the other schema can not be imported, column
+// is a string (or a string array).
+func (p *schemaPackages) userType(modelDirPath string, schemaMetaData metadata.Schema, column metadata.Column) (Type, bool) {
+	dataType := column.DataType
+
+	if (dataType.Kind != metadata.EnumType && dataType.Kind != metadata.CompositeType) ||
+		(dataType.IsArray() && dataType.Kind != metadata.EnumType) {
+		return Type{}, false
+	}
+
+	ret := Type{Name: "string"}
+
+	if dataType.Schema == "" || dataType.Schema == schemaMetaData.Name {
+		_, schemaTemplate, ok := p.schema(schemaMetaData.Name)
+		if !ok {
+			return Type{}, false
+		}
+
+		typeName, ok := userTypeModelName(schemaMetaData, schemaTemplate.Model, dataType)
+		if !ok {
+			return Type{}, false
+		}
+
+		ret = Type{Name: typeName}
+	} else if typeSchema, typeSchemaTemplate, ok := p.schema(dataType.Schema); ok && !typeSchemaTemplate.Model.Skip {
+		if typeName, ok := userTypeModelName(typeSchema, typeSchemaTemplate.Model, dataType); ok {
+			typeModelDir := filepath.Join(p.dirPath, typeSchemaTemplate.Path, typeSchemaTemplate.Model.Path)
+
+			qualifier, importPath, ok := p.packageQualifier(modelDirPath, typeModelDir, typeSchema.Name,
+				typeSchemaTemplate.Model.PackageName())
+			if ok {
+				ret = Type{Name: qualifier + typeName, ImportPath: importPath}
+			}
+		}
+	}
+
+	if dataType.IsArray() {
+		ret = getUserDefinedArrayType(column, ret)
+	}
+
+	if column.IsNullable {
+		ret.Name = "*" + ret.Name
+	}
+
+	return ret, true
+}
+
+// userTypeModelName returns model type name of the enum or composite type, if the model type is generated
+func userTypeModelName(schemaMetaData metadata.Schema, modelTemplate Model, dataType metadata.DataType) (string, bool) {
+	if dataType.Kind == metadata.CompositeType {
+		composite, ok := schemaMetaData.Composite(dataType.Name)
+		if !ok || modelTemplate.Composite == nil {
+			return "", false
+		}
+
+		compositeModel := modelTemplate.Composite(composite)
+
+		return compositeModel.TypeName, !compositeModel.Skip
+	}
+
+	for _, enum := range schemaMetaData.EnumsMetaData {
+		enumModel := modelTemplate.Enum(enum)
+
+		if enum.Name == dataType.Name && !enumModel.Skip {
+			return enumModel.TypeName, true
+		}
+	}
+
+	return "", false
+}
+
+// resolveUserTypes resolves enum and composite types from the other schemas before any other reference, so that
+// model packages import the user defined types whenever possible
+func (p *schemaPackages) resolveUserTypes() {
+	for i, schema := range p.schemas {
+		modelTemplate := p.templates[i].Model
+		if modelTemplate.Skip {
+			continue
+		}
+
+		modelDirPath := filepath.Join(p.dirPath, p.templates[i].Path, modelTemplate.Path)
+
+		for _, table := range schema.TablesMetaData {
+			if tableModel := modelTemplate.Table(table); !tableModel.Skip {
+				p.resolveFieldTypes(tableModel.Field, modelDirPath, schema, table.Columns)
+			}
+		}
+
+		for _, view := range schema.ViewsMetaData {
+			if viewModel := modelTemplate.View(view); !viewModel.Skip {
+				p.resolveFieldTypes(viewModel.Field, modelDirPath, schema, view.Columns)
+			}
+		}
+
+		if modelTemplate.Composite == nil {
+			continue
+		}
+
+		for _, composite := range schema.CompositesMetaData {
+			if compositeModel := modelTemplate.Composite(composite); !compositeModel.Skip {
+				p.resolveFieldTypes(compositeModel.Field, modelDirPath, schema, composite.Attributes)
+			}
+		}
+	}
+}
+
+func (p *schemaPackages) resolveFieldTypes(fieldFunc func(column metadata.Column) TableModelField, modelDirPath string,
+	schema metadata.Schema, columns []metadata.Column) {
+
+	for _, column := range columns {
+		p.resolveFieldType(fieldFunc(column), modelDirPath, schema, column)
+	}
+}
+
+// resolveFieldType replaces default type of the model field, for the enum or composite column, with the model type
+// set by the model template, or with the type from the other schema package
+func (p *schemaPackages) resolveFieldType(field TableModelField, modelDirPath string, schemaMetaData metadata.Schema,
+	column metadata.Column) TableModelField {
+
+	defaultType := getType(column)
+
+	if field.Skip || field.Type.Name != defaultType.Name || field.Type.ImportPath != defaultType.ImportPath {
+		return field
+	}
+
+	if userType, ok := p.userType(modelDirPath, schemaMetaData, column); ok {
+		field.Type = userType
+	}
+
+	return field
+}
@@ -0,0 +1,192 @@
+package template
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessSchemasReferences(t *testing.T) {
+	moduleDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com/app\n"), 0o600))
+	destDir := filepath.Join(moduleDir, "gen")
+
+	intType := metadata.DataType{Name: "int4", Kind: metadata.BaseType}
+
+	schemas := []metadata.Schema{
+		{
+			Name: "public",
+			TablesMetaData: []metadata.Table{
+				{
+					Name: "customer",
+					Columns: []metadata.Column{
+						{Name: "id", IsPrimaryKey: true, DataType: intType},
+						{Name: "last_order_id", IsNullable: true, DataType: intType},
+					},
+					ForeignKeys: []metadata.ForeignKey{
+						{Name: "customer_last_order_fkey", Columns: []string{"last_order_id"}, ReferencedSchema: "sales",
+							ReferencedTable: "orders", ReferencedColumns: []string{"id"}},
+					},
+				},
+			},
+			EnumsMetaData: []metadata.Enum{
+				{Name: "mood", Values: []string{"sad", "happy"}},
+			},
+		},
+		{
+			Name: "sales",
+			TablesMetaData: []metadata.Table{
+				{
+					Name: "orders",
+					Columns: []metadata.Column{
+						{Name: "id", IsPrimaryKey: true, DataType: intType},
+						{Name: "customer_id", DataType: intType},
+						{Name: "mood", IsNullable: true, DataType: metadata.DataType{Name: "mood", Kind: metadata.EnumType, Schema: "public"}},
+					},
+					ForeignKeys: []metadata.ForeignKey{
+						{Name: "orders_customer_id_fkey", Columns: []string{"customer_id"}, ReferencedSchema: "public",
+							ReferencedTable: "customer", ReferencedColumns: []string{"id"}},
+					},
+				},
+			},
+		},
+	}
+
+	generatorTemplate := Default(postgres.Dialect).UseSchema(func(schema metadata.Schema) Schema {
+		return DefaultSchema(schema).
+			UseModel(DefaultModel().UseTable(func(table metadata.Table) TableModel {
+				return DefaultTableModel(table).UseRelation(DefaultTableModelRelation)
+			})).
+			UseSQLBuilder(DefaultSQLBuilder().UseTable(func(table metadata.Table) TableSQLBuilder {
+				return DefaultTableSQLBuilder(table).UseForeignKey(func(foreignKey metadata.ForeignKey) TableSQLBuilderForeignKey {
+					return DefaultTableSQLBuilderForeignKey(table, foreignKey)
+				})
+			}))
+	})
+
+	output := NewMemoryOutput()
+	err := ProcessSchemas(destDir, schemas, generatorTemplate.UseOutput(output))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{filepath.Join(destDir, "public"), filepath.Join(destDir, "sales")}, output.Dirs)
+
+	orders := string(output.Files[filepath.Join(destDir, "sales", "model", "orders.go")])
+	require.Contains(t, orders, `publicmodel "example.com/app/gen/public/model"`)
+	require.Contains(t, orders, "\tMood       *publicmodel.Mood\n")
+	require.Contains(t, orders, "\tCustomer   *publicmodel.Customer\n")
+
+	// sales model package imports public model package, so the relation field would create an import cycle
+	customer := string(output.Files[filepath.Join(destDir, "public", "model", "customer.go")])
+	require.NotContains(t, customer, "import")
+	require.NotContains(t, customer, "salesmodel")
+
+	customerTable := string(output.Files[filepath.Join(destDir, "public", "table", "customer.go")])
+	require.Contains(t, customerTable, `salestable "example.com/app/gen/sales/table"`)
+	require.Contains(t, customerTable, "func (a CustomerTable) JoinOrders(table ...*salestable.OrdersTable) postgres.BoolExpression {")
+
+	// public table package imports sales table package, so the join helper would create an import cycle
+	ordersTable := string(output.Files[filepath.Join(destDir, "sales", "table", "orders.go")])
+	require.NotContains(t, ordersTable, "publictable")
+
+	// without go.mod, import paths can not be resolved and enum columns from the other schemas are strings
+	destDir = t.TempDir()
+	output = NewMemoryOutput()
+	err = ProcessSchemas(destDir, schemas, generatorTemplate.UseOutput(output))
+	require.NoError(t, err)
+
+	orders = string(output.Files[filepath.Join(destDir, "sales", "model", "orders.go")])
+	require.NotContains(t, orders, "import")
+	require.Contains(t, orders, "\tMood       *string\n")
+}
+
+func TestProcessSchemaEnumTypeName(t *testing.T) {
+	moduleDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com/app\n"), 0o600))
+	destDir := filepath.Join(moduleDir, "gen")
+
+	ratingType := metadata.DataType{Name: "mpaa_rating", Kind: metadata.EnumType, Schema: "public", SourceDialect: "PostgreSQL"}
+	ratingArrayType := ratingType
+	ratingArrayType.Dimensions = 1
+
+	schemas := []metadata.Schema{
+		{
+			Name: "public",
+			TablesMetaData: []metadata.Table{
+				{
+					Name: "film",
+					Columns: []metadata.Column{
+						{Name: "rating", DataType: ratingType},
+						{Name: "old_rating", IsNullable: true, DataType: ratingType},
+						{Name: "ratings", DataType: ratingArrayType},
+					},
+				},
+			},
+			EnumsMetaData: []metadata.Enum{
+				{Name: "mpaa_rating", Values: []string{"G", "PG"}},
+			},
+		},
+		{
+			Name: "sales",
+			TablesMetaData: []metadata.Table{
+				{
+					Name: "orders",
+					Columns: []metadata.Column{
+						{Name: "rating", IsNullable: true, DataType: ratingType},
+						{Name: "ratings", DataType: ratingArrayType},
+					},
+				},
+			},
+		},
+	}
+
+	generatorTemplate := Default(postgres.Dialect).UseSchema(func(schema metadata.Schema) Schema {
+		return DefaultSchema(schema).UseModel(DefaultModel().UseEnum(func(enum metadata.Enum) EnumModel {
+			return DefaultEnumModel(enum).UseTypeName("Rating")
+		}))
+	})
+
+	output := NewMemoryOutput()
+	err := ProcessSchemas(destDir, schemas, generatorTemplate.UseOutput(output))
+	require.NoError(t, err)
+
+	require.Contains(t, string(output.Files[filepath.Join(destDir, "public", "model", "mpaa_rating.go")]), "type Rating string")
+
+	film := string(output.Files[filepath.Join(destDir, "public", "model", "film.go")])
+	require.NotContains(t, film, "MpaaRating")
+	require.Contains(t, film, "\tRating    Rating\n")
+	require.Contains(t, film, "\tOldRating *Rating\n")
+	require.Contains(t, film, "\tRatings   pgtype.ArrayValue[Rating]\n")
+
+	orders := string(output.Files[filepath.Join(destDir, "sales", "model", "orders.go")])
+	require.NotContains(t, orders, "MpaaRating")
+	require.Contains(t, orders, "\tRating  *publicmodel.Rating\n")
+	require.Contains(t, orders, "\tRatings pgtype.ArrayValue[publicmodel.Rating]\n")
+}
+
+func TestSchemaPackagesImportCycle(t *testing.T) {
+	destDir := "gen"
+	packages := newSchemaPackages(destDir, nil, Default(postgres.Dialect))
+
+	publicModel := filepath.Join(destDir, "public", "model")
+	salesModel := filepath.Join(destDir, "sales", "model")
+	hrModel := filepath.Join(destDir, "hr", "model")
+
+	require.True(t, packages.addImport(salesModel, publicModel))
+	require.True(t, packages.addImport(publicModel, hrModel))
+	require.True(t, packages.addImport(salesModel, publicModel))
+
+	require.Equal(t, []string{salesModel, publicModel, hrModel}, packages.importCycle(salesModel, hrModel, map[string]bool{}))
+	require.Nil(t, packages.importCycle(hrModel, salesModel, map[string]bool{}))
+
+	require.False(t, packages.addImport(hrModel, salesModel))
+	require.False(t, packages.addImport(hrModel, salesModel))
+	require.True(t, packages.skippedImports[hrModel][salesModel])
+	require.False(t, packages.imports[hrModel][salesModel])
+
+	require.Equal(t, "hr/model -> sales/model -> public/model -> hr/model",
+		packages.formatCycle(append([]string{hrModel}, packages.importCycle(salesModel, hrModel, map[string]bool{})...)))
+}
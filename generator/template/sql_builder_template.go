@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -18,6 +19,8 @@ type SQLBuilder struct {
 	Table func(table metadata.Table) TableSQLBuilder
 	View  func(view metadata.Table) TableSQLBuilder
 	Enum  func(enum metadata.Enum) EnumSQLBuilder
+	// Composite is template for composite type SQL builder files generation (PostgreSQL only)
+	Composite func(composite metadata.Composite) CompositeSQLBuilder
 }
 
 // DefaultSQLBuilder returns default SQLBuilder implementation
@@ -27,6 +30,8 @@ func DefaultSQLBuilder() SQLBuilder {
 		Table: DefaultTableSQLBuilder,
 		View:  DefaultViewSQLBuilder,
 		Enum:  DefaultEnumSQLBuilder,
+
+		Composite: DefaultCompositeSQLBuilder,
 	}
 }
 
@@ -54,6 +59,12 @@ func (sb SQLBuilder) UseEnum(enumFunc func(enum metadata.Enum) EnumSQLBuilder) S
 	return sb
 }
 
+// UseComposite returns new SQLBuilder with new CompositeSQLBuilder template function set
+func (sb SQLBuilder) UseComposite(compositeFunc func(composite metadata.Composite) CompositeSQLBuilder) SQLBuilder {
+	sb.Composite = compositeFunc
+	return sb
+}
+
 // ShouldSkip returns new SQLBuilder with new skip flag set
 func (sb SQLBuilder) ShouldSkip(skip bool) SQLBuilder {
 	sb.Skip = skip
@@ -62,13 +73,15 @@ func (sb SQLBuilder) ShouldSkip(skip bool) SQLBuilder {
 
 // TableSQLBuilder is template for generating table SQLBuilder files
 type TableSQLBuilder struct {
-	Skip         bool
-	Path         string
-	FileName     string
-	InstanceName string
-	TypeName     string
-	DefaultAlias string
-	Column       func(columnMetaData metadata.Column) TableSQLBuilderColumn
+	Skip           bool
+	Path           string
+	FileName       string
+	InstanceName   string
+	TypeName       string
+	DefaultAlias   string
+	Column         func(columnMetaData metadata.Column) TableSQLBuilderColumn
+	ForeignKey     func(foreignKey metadata.ForeignKey) TableSQLBuilderForeignKey
+	ConflictTarget func(index metadata.Index) TableSQLBuilderConflictTarget
 }
 
 // ViewSQLBuilder is template for generating view SQLBuilder files
@@ -136,6 +149,85 @@ func (tb TableSQLBuilder) UseColumn(columnsFunc func(column metadata.Column) Tab
 	return tb
 }
 
+// UseForeignKey returns new TableSQLBuilder with new foreign key template function set. Join helper methods are
+// not generated by default, DefaultTableSQLBuilderForeignKey can be used to enable them:
+//
+//	DefaultTableSQLBuilder(table).UseForeignKey(func(foreignKey metadata.ForeignKey) TableSQLBuilderForeignKey {
+//		return DefaultTableSQLBuilderForeignKey(table, foreignKey)
+//	})
+func (tb TableSQLBuilder) UseForeignKey(foreignKeyFunc func(foreignKey metadata.ForeignKey) TableSQLBuilderForeignKey) TableSQLBuilder {
+	tb.ForeignKey = foreignKeyFunc
+	return tb
+}
+
+// TableSQLBuilderForeignKey is template for table sql builder join helper method, generated for each foreign key.
+// Join helper method returns join condition between the table and referenced table.
+type TableSQLBuilderForeignKey struct {
+	Skip bool
+	Name string
+}
+
+// DefaultTableSQLBuilderForeignKey returns default implementation of TableSQLBuilderForeignKey. Join helper name is
+// 'Join' followed by referenced table name, or if there are more foreign keys referencing the same table,
+// by referenced table name and foreign key column names (for instance JoinAddressByBillingAddressID).
+func DefaultTableSQLBuilderForeignKey(tableMetaData metadata.Table, foreignKey metadata.ForeignKey) TableSQLBuilderForeignKey {
+	name := "Join" + dbidentifier.ToGoIdentifier(foreignKey.ReferencedTable)
+
+	sameTableReferences := 0
+	for _, fk := range tableMetaData.ForeignKeys {
+		if fk.ReferencedSchema == foreignKey.ReferencedSchema && fk.ReferencedTable == foreignKey.ReferencedTable {
+			sameTableReferences++
+		}
+	}
+
+	if sameTableReferences > 1 {
+		name += "By"
+		for _, column := range foreignKey.Columns {
+			name += dbidentifier.ToGoIdentifier(column)
+		}
+	}
+
+	return TableSQLBuilderForeignKey{
+		Name: name,
+	}
+}
+
+// UseConflictTarget returns new TableSQLBuilder with new conflict target template function set. Conflict target
+// fields are not generated by default, DefaultTableSQLBuilderConflictTarget can be used to enable them:
+//
+//	DefaultTableSQLBuilder(table).UseConflictTarget(DefaultTableSQLBuilderConflictTarget)
+func (tb TableSQLBuilder) UseConflictTarget(conflictTargetFunc func(index metadata.Index) TableSQLBuilderConflictTarget) TableSQLBuilder {
+	tb.ConflictTarget = conflictTargetFunc
+	return tb
+}
+
+// TableSQLBuilderConflictTarget is template for table sql builder conflict target field, generated for each
+// unique index or unique constraint. Conflict targets are not generated for MySQL, because MySQL does not
+// support ON CONFLICT clause.
+type TableSQLBuilderConflictTarget struct {
+	Skip bool
+	Name string
+}
+
+// DefaultTableSQLBuilderConflictTarget returns default implementation of TableSQLBuilderConflictTarget. Conflict
+// target name is 'Unique' followed by index column names (for instance UniqueTitle), or index name for partial indexes.
+func DefaultTableSQLBuilderConflictTarget(index metadata.Index) TableSQLBuilderConflictTarget {
+	if index.Predicate != "" {
+		return TableSQLBuilderConflictTarget{
+			Name: dbidentifier.ToGoIdentifier(index.Name),
+		}
+	}
+
+	name := "Unique"
+	for _, column := range index.Columns {
+		name += dbidentifier.ToGoIdentifier(column)
+	}
+
+	return TableSQLBuilderConflictTarget{
+		Name: name,
+	}
+}
+
 // TableSQLBuilderColumn is template for table sql builder column
 type TableSQLBuilderColumn struct {
 	Skip bool
@@ -163,7 +255,17 @@ func DefaultTableSQLBuilderColumn(columnMetaData metadata.Column) TableSQLBuilde
 // getSqlBuilderColumnType returns type of jet sql builder column
 func getSqlBuilderColumnType(columnMetaData metadata.Column) string {
 	switch columnMetaData.DataType.Kind {
-	case metadata.EnumType, metadata.UserDefinedType:
+	case metadata.CompositeType:
+		if columnMetaData.DataType.IsArray() {
+			return "StringArray"
+		}
+		return "Row"
+	case metadata.EnumType:
+		if columnMetaData.DataType.IsArray() {
+			return "EnumArray"
+		}
+		return "String"
+	case metadata.UserDefinedType:
 		if columnMetaData.DataType.IsArray() {
 			return "StringArray"
 		}
@@ -172,13 +274,7 @@ func getSqlBuilderColumnType(columnMetaData metadata.Column) string {
 
 	columnType := sqlToColumnType(columnMetaData)
 
-	if columnMetaData.DataType.IsArray() {
-		if columnMetaData.DataType.Dimensions > 1 {
-			fmt.Println("- [SQL Builder] Unsupported sql array with multiple dimensions column '" +
-				columnMetaData.Name + " " + columnMetaData.DataType.Name + "', using StringColumn instead.")
-			return "String"
-		}
-
+	if columnMetaData.DataType.IsArray() { // multidimensional arrays are of the same type, elements are accessed with AT(i, j)
 		columnType = columnType + "Array"
 	}
 
@@ -188,6 +284,27 @@ func getSqlBuilderColumnType(columnMetaData metadata.Column) string {
 // sqlToColumnType maps the type of a SQL column type to a go jet sql builder column. The second return value returns
 // whether the given type is supported.
 func sqlToColumnType(columnMetaData metadata.Column) string {
+	switch columnMetaData.DataType.SourceDialect {
+	case "PostgreSQL":
+		switch strings.ToLower(columnMetaData.DataType.Name) {
+		case "json":
+			return "Json"
+		case "jsonb":
+			return "Jsonb"
+		case "tsvector":
+			return "TsVector"
+		case "tsquery":
+			return "TsQuery"
+		}
+	case "MySQL", "SQLite":
+		switch strings.ToLower(columnMetaData.DataType.Name) {
+		case "json":
+			return "Json"
+		case "fts5":
+			return "Fts5"
+		}
+	}
+
 	switch strings.ToLower(columnMetaData.DataType.Name) {
 	case "boolean", "bool":
 		return "Bool"
@@ -290,3 +407,203 @@ func defaultEnumValueName(enumName, enumValue string) string {
 
 	return enumValueName
 }
+
+// enumArrayType returns PostgreSQL array type of the enum, for instance 'mood[]'. Enum name is quoted unless it is
+// a lowercase identifier.
+func enumArrayType(enumName string) string {
+	isLowercaseIdentifier := enumName != "" && !unicode.IsDigit(rune(enumName[0]))
+
+	for _, c := range enumName {
+		if !(c >= 'a' && c <= 'z') && !unicode.IsDigit(c) && c != '_' {
+			isLowercaseIdentifier = false
+		}
+	}
+
+	if !isLowercaseIdentifier {
+		return `"` + strings.ReplaceAll(enumName, `"`, `""`) + `"[]`
+	}
+
+	return enumName + "[]"
+}
+
+// CompositeSQLBuilder is template for generating composite type SQLBuilder files. Composite type SQL builder type
+// wraps a row expression of the composite type (for instance composite type column), and has a typed accessor
+// method for each composite type attribute.
+type CompositeSQLBuilder struct {
+	Skip         bool
+	Path         string
+	FileName     string
+	InstanceName string // name of the function wrapping row expression into TypeName
+	TypeName     string
+	Field        func(attributeMetaData metadata.Column) TableSQLBuilderColumn
+}
+
+// DefaultCompositeSQLBuilder returns default implementation of CompositeSQLBuilder
+func DefaultCompositeSQLBuilder(compositeMetaData metadata.Composite) CompositeSQLBuilder {
+	compositeNameGoIdentifier := dbidentifier.ToGoIdentifier(compositeMetaData.Name)
+
+	return CompositeSQLBuilder{
+		Path:         "/composite",
+		FileName:     dbidentifier.ToGoFileName(compositeMetaData.Name),
+		InstanceName: compositeNameGoIdentifier,
+		TypeName:     compositeNameGoIdentifier + "Row",
+		Field:        DefaultCompositeSQLBuilderField,
+	}
+}
+
+// DefaultCompositeSQLBuilderField returns default implementation of composite type attribute accessor
+func DefaultCompositeSQLBuilderField(attributeMetaData metadata.Column) TableSQLBuilderColumn {
+	return TableSQLBuilderColumn{
+		Name: dbidentifier.ToGoIdentifier(attributeMetaData.Name),
+		Type: getSqlBuilderColumnType(attributeMetaData),
+	}
+}
+
+// PackageName returns composite type sql builder package name
+func (c CompositeSQLBuilder) PackageName() string {
+	return filepath.Base(c.Path)
+}
+
+// UsePath returns new CompositeSQLBuilder with new path set
+func (c CompositeSQLBuilder) UsePath(path string) CompositeSQLBuilder {
+	c.Path = path
+	return c
+}
+
+// UseFileName returns new CompositeSQLBuilder with new file name set
+func (c CompositeSQLBuilder) UseFileName(name string) CompositeSQLBuilder {
+	c.FileName = name
+	return c
+}
+
+// UseInstanceName returns new CompositeSQLBuilder with new instance name set
+func (c CompositeSQLBuilder) UseInstanceName(name string) CompositeSQLBuilder {
+	c.InstanceName = name
+	return c
+}
+
+// UseTypeName returns new CompositeSQLBuilder with new type name set
+func (c CompositeSQLBuilder) UseTypeName(name string) CompositeSQLBuilder {
+	c.TypeName = name
+	return c
+}
+
+// UseField returns new CompositeSQLBuilder with new attribute accessor template function set
+func (c CompositeSQLBuilder) UseField(fieldFunc func(attributeMetaData metadata.Column) TableSQLBuilderColumn) CompositeSQLBuilder {
+	c.Field = fieldFunc
+	return c
+}
+
+// compositeFieldAccessor is typed accessor method of the composite type SQL builder type
+type compositeFieldAccessor struct {
+	Name       string
+	FieldName  string
+	Type       string
+	Expression string
+}
+
+// expression type and type wrapper names by sql builder column type
+var sqlBuilderExpressionTypes = map[string][2]string{
+	"Bool":       {"BoolExpression", "BoolExp"},
+	"Integer":    {"IntegerExpression", "IntExp"},
+	"Float":      {"FloatExpression", "FloatExp"},
+	"String":     {"StringExpression", "StringExp"},
+	"Bytea":      {"ByteaExpression", "ByteaExp"},
+	"Date":       {"DateExpression", "DateExp"},
+	"Time":       {"TimeExpression", "TimeExp"},
+	"Timez":      {"TimezExpression", "TimezExp"},
+	"Timestamp":  {"TimestampExpression", "TimestampExp"},
+	"Timestampz": {"TimestampzExpression", "TimestampzExp"},
+	"Interval":   {"IntervalExpression", "IntervalExp"},
+	"Json":       {"JsonExpression", "JsonExp"},
+	"Jsonb":      {"JsonbExpression", "JsonbExp"},
+	"TsVector":   {"TsVectorExpression", "TsVectorExp"},
+	"TsQuery":    {"TsQueryExpression", "TsQueryExp"},
+}
+
+// range expression type and type wrapper names by sql builder column type. Integer ranges are not included,
+// because their type wrappers return range types that can not be named outside of jet.
+var sqlBuilderRangeTypes = map[string][2]string{
+	"DateRange":       {"DateRange", "DateRangeExp"},
+	"TimestampRange":  {"TimestampRange", "TsRangeExp"},
+	"TimestampzRange": {"TimestampzRange", "TstzRangeExp"},
+	"NumericRange":    {"NumericRange", "NumRangeExp"},
+}
+
+// getCompositeFieldAccessors returns accessor methods of the composite type SQL builder type. Accessor of the
+// attribute with the composite type, generated in the same package, returns that composite SQL builder type.
+// Attributes of types without expression type wrapper are returned as plain expressions.
+func getCompositeFieldAccessors(packageName string,
+	compositeMetaData metadata.Composite,
+	compositeSQLBuilder CompositeSQLBuilder,
+	schemaMetaData metadata.Schema,
+	sqlBuilderTemplate SQLBuilder) []compositeFieldAccessor {
+
+	var ret []compositeFieldAccessor
+
+	for _, attribute := range compositeMetaData.Attributes {
+		field := compositeSQLBuilder.Field(attribute)
+		if field.Skip {
+			continue
+		}
+
+		rowField := fmt.Sprintf("%s.RowField(r.RowExpression, %s)", packageName, strconv.Quote(attribute.Name))
+		accessor := compositeFieldAccessor{
+			Name:       field.Name,
+			FieldName:  attribute.Name,
+			Type:       packageName + ".Expression",
+			Expression: rowField,
+		}
+
+		if expressionType, ok := sqlBuilderExpressionTypes[strings.TrimSuffix(field.Type, "Array")]; ok {
+			if strings.HasSuffix(field.Type, "Array") {
+				elemType := packageName + "." + expressionType[0]
+				accessor.Type = fmt.Sprintf("%s.Array[%s]", packageName, elemType)
+				accessor.Expression = fmt.Sprintf("%s.ArrayExp[%s](%s)", packageName, elemType, rowField)
+			} else {
+				accessor.Type = packageName + "." + expressionType[0]
+				accessor.Expression = fmt.Sprintf("%s.%s(%s)", packageName, expressionType[1], rowField)
+			}
+		} else if rangeType, ok := sqlBuilderRangeTypes[field.Type]; ok {
+			accessor.Type = packageName + "." + rangeType[0]
+			accessor.Expression = fmt.Sprintf("%s.%s(%s)", packageName, rangeType[1], rowField)
+		} else if field.Type == "Row" {
+			accessor.Type = packageName + ".RowExpression"
+			accessor.Expression = fmt.Sprintf("%s.RowExp(%s)", packageName, rowField)
+
+			if nested, ok := nestedCompositeSQLBuilder(attribute, compositeSQLBuilder, schemaMetaData, sqlBuilderTemplate); ok {
+				accessor.Type = nested.TypeName
+				accessor.Expression = fmt.Sprintf("%s(%s)", nested.InstanceName, accessor.Expression)
+			}
+		}
+
+		ret = append(ret, accessor)
+	}
+
+	return ret
+}
+
+// nestedCompositeSQLBuilder returns SQL builder of the attribute composite type, if it is generated in the same
+// package as the compositeSQLBuilder
+func nestedCompositeSQLBuilder(attribute metadata.Column,
+	compositeSQLBuilder CompositeSQLBuilder,
+	schemaMetaData metadata.Schema,
+	sqlBuilderTemplate SQLBuilder) (CompositeSQLBuilder, bool) {
+
+	if attribute.DataType.Schema != "" && attribute.DataType.Schema != schemaMetaData.Name {
+		return CompositeSQLBuilder{}, false
+	}
+
+	nestedComposite, ok := schemaMetaData.Composite(attribute.DataType.Name)
+	if !ok {
+		return CompositeSQLBuilder{}, false
+	}
+
+	nested := sqlBuilderTemplate.Composite(nestedComposite)
+
+	if nested.Skip || filepath.Clean(nested.Path) != filepath.Clean(compositeSQLBuilder.Path) {
+		return CompositeSQLBuilder{}, false
+	}
+
+	return nested, true
+}
@@ -2,6 +2,8 @@ package template
 
 import (
 	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -11,6 +13,24 @@ func TestToGoEnumValueIdentifier(t *testing.T) {
 	require.Equal(t, defaultEnumValueName("NumEnum", "100"), "NumEnum100")
 }
 
+func TestEnumArrayType(t *testing.T) {
+	require.Equal(t, "mood[]", enumArrayType("mood"))
+	require.Equal(t, "mood_2[]", enumArrayType("mood_2"))
+	require.Equal(t, `"Mood"[]`, enumArrayType("Mood"))
+	require.Equal(t, `"my ""mood"""[]`, enumArrayType(`my "mood"`))
+}
+
+func TestEnumArrayColumnType(t *testing.T) {
+	column := metadata.Column{Name: "moods", DataType: metadata.DataType{Name: "mood", Kind: metadata.EnumType, Dimensions: 1}}
+	require.Equal(t, "EnumArray", DefaultTableSQLBuilderColumn(column).Type)
+
+	column.DataType.Dimensions = 0
+	require.Equal(t, "String", DefaultTableSQLBuilderColumn(column).Type)
+
+	column.DataType = metadata.DataType{Name: "hstore", Kind: metadata.UserDefinedType, Dimensions: 1}
+	require.Equal(t, "StringArray", DefaultTableSQLBuilderColumn(column).Type)
+}
+
 func TestColumnRenameReserved(t *testing.T) {
 	tests := []struct {
 		col  string
@@ -35,3 +55,229 @@ func TestColumnRenameReserved(t *testing.T) {
 		})
 	}
 }
+
+func TestTableSQLBuilderColumnSourceDialect(t *testing.T) {
+	testCases := []struct {
+		name          string
+		dataTypeName  string
+		sourceDialect string
+		dimensions    int
+		expectedType  string
+	}{
+		{name: "postgres json", dataTypeName: "json", sourceDialect: "PostgreSQL", expectedType: "Json"},
+		{name: "postgres jsonb", dataTypeName: "jsonb", sourceDialect: "PostgreSQL", expectedType: "Jsonb"},
+		{name: "postgres jsonb array", dataTypeName: "jsonb", sourceDialect: "PostgreSQL", dimensions: 1, expectedType: "JsonbArray"},
+		{name: "postgres tsvector", dataTypeName: "tsvector", sourceDialect: "PostgreSQL", expectedType: "TsVector"},
+		{name: "postgres tsquery", dataTypeName: "tsquery", sourceDialect: "PostgreSQL", expectedType: "TsQuery"},
+		{name: "postgres text", dataTypeName: "text", sourceDialect: "PostgreSQL", expectedType: "String"},
+		{name: "postgres two-dimensional int array", dataTypeName: "int4", sourceDialect: "PostgreSQL", dimensions: 2, expectedType: "IntegerArray"},
+		{name: "mysql json", dataTypeName: "json", sourceDialect: "MySQL", expectedType: "Json"},
+		{name: "sqlite json", dataTypeName: "JSON", sourceDialect: "SQLite", expectedType: "Json"},
+		{name: "sqlite fts5", dataTypeName: "FTS5", sourceDialect: "SQLite", expectedType: "Fts5"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			column := DefaultTableSQLBuilderColumn(metadata.Column{
+				Name: "field",
+				DataType: metadata.DataType{
+					Name:          testCase.dataTypeName,
+					Kind:          metadata.BaseType,
+					Dimensions:    testCase.dimensions,
+					SourceDialect: testCase.sourceDialect,
+				},
+			})
+
+			require.Equal(t, testCase.expectedType, column.Type)
+		})
+	}
+}
+
+var foreignKeysSchema = metadata.Schema{
+	Name: "public",
+	TablesMetaData: []metadata.Table{
+		{
+			Name: "address",
+			Columns: []metadata.Column{
+				{Name: "address_id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+			},
+		},
+		{
+			Name: "film",
+			Columns: []metadata.Column{
+				{Name: "film_id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				{Name: "language_id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				{Name: "language_name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+				{Name: "billing_address_id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				{Name: "shipping_address_id", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			},
+			ForeignKeys: []metadata.ForeignKey{
+				{Name: "film_language_fk", Columns: []string{"language_id", "language_name"},
+					ReferencedSchema: "public", ReferencedTable: "language", ReferencedColumns: []string{"language_id", "name"}},
+				{Name: "film_billing_address_fk", Columns: []string{"billing_address_id"},
+					ReferencedSchema: "public", ReferencedTable: "address", ReferencedColumns: []string{"address_id"}},
+				{Name: "film_shipping_address_fk", Columns: []string{"shipping_address_id"},
+					ReferencedSchema: "public", ReferencedTable: "address", ReferencedColumns: []string{"address_id"}},
+				{Name: "film_store_fk", Columns: []string{"billing_address_id"},
+					ReferencedSchema: "store", ReferencedTable: "address", ReferencedColumns: []string{"address_id"}},
+			},
+		},
+		{
+			Name: "language",
+			Columns: []metadata.Column{
+				{Name: "language_id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				{Name: "name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			},
+		},
+	},
+}
+
+func TestDefaultTableSQLBuilderForeignKey(t *testing.T) {
+	film, _ := foreignKeysSchema.Table("film")
+
+	require.Nil(t, DefaultTableSQLBuilder(film).ForeignKey)
+	require.Equal(t, TableSQLBuilderForeignKey{Name: "JoinLanguage"}, DefaultTableSQLBuilderForeignKey(film, film.ForeignKeys[0]))
+	require.Equal(t, TableSQLBuilderForeignKey{Name: "JoinAddressByBillingAddressID"}, DefaultTableSQLBuilderForeignKey(film, film.ForeignKeys[1]))
+	require.Equal(t, TableSQLBuilderForeignKey{Name: "JoinAddressByShippingAddressID"}, DefaultTableSQLBuilderForeignKey(film, film.ForeignKeys[2]))
+}
+
+func joinHelpersSQLBuilder(table metadata.Table) TableSQLBuilder {
+	return DefaultTableSQLBuilder(table).UseForeignKey(func(foreignKey metadata.ForeignKey) TableSQLBuilderForeignKey {
+		return DefaultTableSQLBuilderForeignKey(table, foreignKey)
+	})
+}
+
+func TestGetTableJoinHelpers(t *testing.T) {
+	film, _ := foreignKeysSchema.Table("film")
+
+	require.Empty(t, getTableJoinHelpers(postgres.Dialect, film, DefaultTableSQLBuilder(film), foreignKeysSchema, DefaultSQLBuilder(), "", nil))
+
+	joinHelpers := getTableJoinHelpers(postgres.Dialect, film, joinHelpersSQLBuilder(film), foreignKeysSchema, DefaultSQLBuilder(), "", nil)
+
+	// shipping address foreign key columns are of different types, and store address is in the other schema
+	require.Equal(t, []tableJoinHelper{
+		{
+			Name:                   "JoinLanguage",
+			ForeignKeyName:         "film_language_fk",
+			ReferencedTypeName:     "LanguageTable",
+			ReferencedInstanceName: "Language",
+			Conditions: []tableJoinCondition{
+				{Column: "LanguageID", ReferencedColumn: "LanguageID"},
+				{Column: "LanguageName", ReferencedColumn: "Name"},
+			},
+		},
+		{
+			Name:                   "JoinAddressByBillingAddressID",
+			ForeignKeyName:         "film_billing_address_fk",
+			ReferencedTypeName:     "AddressTable",
+			ReferencedInstanceName: "Address",
+			Conditions: []tableJoinCondition{
+				{Column: "BillingAddressID", ReferencedColumn: "AddressID"},
+			},
+		},
+	}, joinHelpers)
+
+	t.Run("referenced table skipped", func(t *testing.T) {
+		sqlBuilder := DefaultSQLBuilder().UseTable(func(table metadata.Table) TableSQLBuilder {
+			return joinHelpersSQLBuilder(table).UsePath("/" + table.Name)
+		})
+
+		require.Empty(t, getTableJoinHelpers(postgres.Dialect, film, sqlBuilder.Table(film), foreignKeysSchema, sqlBuilder, "", nil))
+	})
+
+	t.Run("foreign key skipped", func(t *testing.T) {
+		tableSQLBuilder := DefaultTableSQLBuilder(film).UseForeignKey(func(foreignKey metadata.ForeignKey) TableSQLBuilderForeignKey {
+			return TableSQLBuilderForeignKey{Skip: foreignKey.ReferencedTable == "language", Name: "Join" + foreignKey.Name}
+		})
+
+		joinHelpers := getTableJoinHelpers(postgres.Dialect, film, tableSQLBuilder, foreignKeysSchema, DefaultSQLBuilder(), "", nil)
+		require.Len(t, joinHelpers, 1)
+		require.Equal(t, "Joinfilm_billing_address_fk", joinHelpers[0].Name)
+	})
+}
+
+func TestGetTableConflictTargets(t *testing.T) {
+	table := metadata.Table{
+		Name: "film",
+		Columns: []metadata.Column{
+			{Name: "film_id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+			{Name: "title", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			{Name: "store_id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+			{Name: "active", DataType: metadata.DataType{Name: "boolean", Kind: metadata.BaseType}},
+		},
+		Indexes: []metadata.Index{
+			{Name: "film_pkey", IsPrimary: true, IsUnique: true, IsConstraint: true, Columns: []string{"film_id"}},
+			{Name: "film_title_key", IsUnique: true, IsConstraint: true, Columns: []string{"title", "store_id"}},
+			{Name: "film_active_title_idx", IsUnique: true, Predicate: "active = true", Columns: []string{"title"}},
+			{Name: "film_lower_title_idx", IsUnique: true, Columns: []string{"lower(title)"}},
+			{Name: "film_store_idx", Columns: []string{"store_id"}},
+		},
+	}
+
+	require.Nil(t, DefaultTableSQLBuilder(table).ConflictTarget)
+	require.Empty(t, getTableConflictTargets(postgres.Dialect, table, DefaultTableSQLBuilder(table)))
+
+	tableSQLBuilder := DefaultTableSQLBuilder(table).UseConflictTarget(DefaultTableSQLBuilderConflictTarget)
+
+	require.Equal(t, TableSQLBuilderConflictTarget{Name: "UniqueTitleStoreID"}, tableSQLBuilder.ConflictTarget(table.Indexes[1]))
+	require.Equal(t, TableSQLBuilderConflictTarget{Name: "FilmActiveTitleIdx"}, tableSQLBuilder.ConflictTarget(table.Indexes[2]))
+
+	require.Equal(t, []tableConflictTarget{
+		{Name: "UniqueTitleStoreID", Columns: "TitleColumn, StoreIDColumn"},
+		{Name: "FilmActiveTitleIdx", Columns: "TitleColumn", Predicate: "active = true"},
+	}, getTableConflictTargets(postgres.Dialect, table, tableSQLBuilder))
+
+	require.Empty(t, getTableConflictTargets(mysql.Dialect, table, tableSQLBuilder))
+}
+
+func TestGetCompositeFieldAccessors(t *testing.T) {
+	dataType := func(name string, kind metadata.DataTypeKind) metadata.DataType {
+		return metadata.DataType{Name: name, Kind: kind, SourceDialect: "PostgreSQL"}
+	}
+
+	geo := metadata.Composite{Name: "geo"}
+	address := metadata.Composite{
+		Name: "address",
+		Attributes: []metadata.Column{
+			{Name: "city", DataType: dataType("text", metadata.BaseType)},
+			{Name: "zip", DataType: dataType("int4", metadata.BaseType)},
+			{Name: "tags", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType, Dimensions: 1, SourceDialect: "PostgreSQL"}},
+			{Name: "during", DataType: dataType("daterange", metadata.RangeType)},
+			{Name: "ids", DataType: dataType("int4range", metadata.RangeType)},
+			{Name: "location", DataType: dataType("geo", metadata.CompositeType)},
+			{Name: "other", DataType: metadata.DataType{Name: "geo", Kind: metadata.CompositeType, Schema: "other"}},
+		},
+	}
+	schema := metadata.Schema{Name: "public", CompositesMetaData: []metadata.Composite{address, geo}}
+
+	accessors := getCompositeFieldAccessors("postgres", address, DefaultCompositeSQLBuilder(address), schema,
+		DefaultSQLBuilder())
+
+	require.Equal(t, []compositeFieldAccessor{
+		{Name: "City", FieldName: "city", Type: "postgres.StringExpression",
+			Expression: `postgres.StringExp(postgres.RowField(r.RowExpression, "city"))`},
+		{Name: "Zip", FieldName: "zip", Type: "postgres.IntegerExpression",
+			Expression: `postgres.IntExp(postgres.RowField(r.RowExpression, "zip"))`},
+		{Name: "Tags", FieldName: "tags", Type: "postgres.Array[postgres.StringExpression]",
+			Expression: `postgres.ArrayExp[postgres.StringExpression](postgres.RowField(r.RowExpression, "tags"))`},
+		{Name: "During", FieldName: "during", Type: "postgres.DateRange",
+			Expression: `postgres.DateRangeExp(postgres.RowField(r.RowExpression, "during"))`},
+		{Name: "Ids", FieldName: "ids", Type: "postgres.Expression",
+			Expression: `postgres.RowField(r.RowExpression, "ids")`},
+		{Name: "Location", FieldName: "location", Type: "GeoRow",
+			Expression: `Geo(postgres.RowExp(postgres.RowField(r.RowExpression, "location")))`},
+		{Name: "Other", FieldName: "other", Type: "postgres.RowExpression",
+			Expression: `postgres.RowExp(postgres.RowField(r.RowExpression, "other"))`},
+	}, accessors)
+
+	// nested composite type generated in the other package
+	sqlBuilder := DefaultSQLBuilder().UseComposite(func(composite metadata.Composite) CompositeSQLBuilder {
+		if composite.Name == "geo" {
+			return DefaultCompositeSQLBuilder(composite).UsePath("/geo")
+		}
+		return DefaultCompositeSQLBuilder(composite)
+	})
+
+	accessors = getCompositeFieldAccessors("postgres", address, DefaultCompositeSQLBuilder(address), schema, sqlBuilder)
+	require.Equal(t, "postgres.RowExpression", accessors[5].Type)
+}
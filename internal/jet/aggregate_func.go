@@ -0,0 +1,208 @@
+package jet
+
+// aggregateFuncSerializer serializes aggregate function call with optional modifiers:
+//
+//	name([DISTINCT] parameters [ORDER BY ...]) [FILTER (WHERE condition)]
+type aggregateFuncSerializer struct {
+	name       string
+	parameters parametersSerializer
+	distinct   bool
+	orderBy    ClauseOrderBy
+	filter     BoolExpression
+}
+
+func newAggregateFuncSerializer(name string, expressions []Expression) *aggregateFuncSerializer {
+	return &aggregateFuncSerializer{
+		name:       name,
+		parameters: expressions,
+		orderBy:    ClauseOrderBy{SkipNewLine: true},
+	}
+}
+
+func (a *aggregateFuncSerializer) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteString(a.name + "(")
+
+	if a.distinct {
+		out.WriteString("DISTINCT")
+	}
+
+	a.parameters.serialize(statement, out, options...)
+	a.orderBy.Serialize(statement, out)
+
+	out.WriteString(")")
+
+	if a.filter != nil {
+		out.WriteString("FILTER (WHERE")
+		a.filter.serialize(statement, out, NoWrap)
+		out.WriteString(")")
+	}
+}
+
+// aggregateModifiersImpl implements aggregate function modifiers, and returns root aggregate expression
+type aggregateModifiersImpl[T any] struct {
+	aggregate *aggregateFuncSerializer
+	root      T
+}
+
+// DISTINCT eliminates duplicate input rows before they are passed to the aggregate function
+func (a *aggregateModifiersImpl[T]) DISTINCT() T {
+	a.aggregate.distinct = true
+	return a.root
+}
+
+// ORDER_BY specifies the order of the input rows passed to the aggregate function
+func (a *aggregateModifiersImpl[T]) ORDER_BY(orderBy ...OrderByClause) T {
+	a.aggregate.orderBy.List = orderBy
+	return a.root
+}
+
+// FILTER specifies condition input rows have to satisfy to be passed to the aggregate function
+func (a *aggregateModifiersImpl[T]) FILTER(condition BoolExpression) T {
+	a.aggregate.filter = condition
+	return a.root
+}
+
+// --------------------------------------
+
+// AggregateExpression is aggregate function expression with DISTINCT, ORDER_BY and FILTER modifiers
+type AggregateExpression interface {
+	windowExpression
+
+	DISTINCT() AggregateExpression
+	ORDER_BY(orderBy ...OrderByClause) AggregateExpression
+	FILTER(condition BoolExpression) AggregateExpression
+}
+
+type aggregateExpressionImpl struct {
+	windowExpression
+	aggregateModifiersImpl[AggregateExpression]
+}
+
+// NewAggregateFunc creates new aggregate function with name and expressions
+func NewAggregateFunc(name string, expressions ...Expression) AggregateExpression {
+	aggregate := newAggregateFuncSerializer(name, expressions)
+
+	newExp := &aggregateExpressionImpl{
+		windowExpression: newWindowExpression(newExpression(aggregate)),
+	}
+	newExp.aggregate = aggregate
+	newExp.root = newExp
+
+	return newExp
+}
+
+// --------------------------------------
+
+// FloatAggregateExpression is float aggregate function expression with DISTINCT, ORDER_BY and FILTER modifiers
+type FloatAggregateExpression interface {
+	floatWindowExpression
+
+	DISTINCT() FloatAggregateExpression
+	ORDER_BY(orderBy ...OrderByClause) FloatAggregateExpression
+	FILTER(condition BoolExpression) FloatAggregateExpression
+}
+
+type floatAggregateExpressionImpl struct {
+	floatWindowExpression
+	aggregateModifiersImpl[FloatAggregateExpression]
+}
+
+// NewFloatAggregateFunc creates new float aggregate function with name and expressions
+func NewFloatAggregateFunc(name string, expressions ...Expression) FloatAggregateExpression {
+	aggregate := newAggregateFuncSerializer(name, expressions)
+
+	newExp := &floatAggregateExpressionImpl{
+		floatWindowExpression: newFloatWindowExpression(FloatExp(newExpression(aggregate))),
+	}
+	newExp.aggregate = aggregate
+	newExp.root = newExp
+
+	return newExp
+}
+
+// --------------------------------------
+
+// IntegerAggregateExpression is integer aggregate function expression with DISTINCT, ORDER_BY and FILTER modifiers
+type IntegerAggregateExpression interface {
+	integerWindowExpression
+
+	DISTINCT() IntegerAggregateExpression
+	ORDER_BY(orderBy ...OrderByClause) IntegerAggregateExpression
+	FILTER(condition BoolExpression) IntegerAggregateExpression
+}
+
+type integerAggregateExpressionImpl struct {
+	integerWindowExpression
+	aggregateModifiersImpl[IntegerAggregateExpression]
+}
+
+// NewIntegerAggregateFunc creates new integer aggregate function with name and expressions
+func NewIntegerAggregateFunc(name string, expressions ...Expression) IntegerAggregateExpression {
+	aggregate := newAggregateFuncSerializer(name, expressions)
+
+	newExp := &integerAggregateExpressionImpl{
+		integerWindowExpression: newIntegerWindowExpression(IntExp(newExpression(aggregate))),
+	}
+	newExp.aggregate = aggregate
+	newExp.root = newExp
+
+	return newExp
+}
+
+// --------------------------------------
+
+// BoolAggregateExpression is bool aggregate function expression with DISTINCT, ORDER_BY and FILTER modifiers
+type BoolAggregateExpression interface {
+	boolWindowExpression
+
+	DISTINCT() BoolAggregateExpression
+	ORDER_BY(orderBy ...OrderByClause) BoolAggregateExpression
+	FILTER(condition BoolExpression) BoolAggregateExpression
+}
+
+type boolAggregateExpressionImpl struct {
+	boolWindowExpression
+	aggregateModifiersImpl[BoolAggregateExpression]
+}
+
+// NewBoolAggregateFunc creates new bool aggregate function with name and expressions
+func NewBoolAggregateFunc(name string, expressions ...Expression) BoolAggregateExpression {
+	aggregate := newAggregateFuncSerializer(name, expressions)
+
+	newExp := &boolAggregateExpressionImpl{
+		boolWindowExpression: newBoolWindowExpression(BoolExp(newExpression(aggregate))),
+	}
+	newExp.aggregate = aggregate
+	newExp.root = newExp
+
+	return newExp
+}
+
+// --------------------------------------
+
+// StringAggregateExpression is string aggregate function expression with DISTINCT, ORDER_BY and FILTER modifiers
+type StringAggregateExpression interface {
+	stringWindowExpression
+
+	DISTINCT() StringAggregateExpression
+	ORDER_BY(orderBy ...OrderByClause) StringAggregateExpression
+	FILTER(condition BoolExpression) StringAggregateExpression
+}
+
+type stringAggregateExpressionImpl struct {
+	stringWindowExpression
+	aggregateModifiersImpl[StringAggregateExpression]
+}
+
+// NewStringAggregateFunc creates new string aggregate function with name and expressions
+func NewStringAggregateFunc(name string, expressions ...Expression) StringAggregateExpression {
+	aggregate := newAggregateFuncSerializer(name, expressions)
+
+	newExp := &stringAggregateExpressionImpl{
+		stringWindowExpression: newStringWindowExpression(StringExp(newExpression(aggregate))),
+	}
+	newExp.aggregate = aggregate
+	newExp.root = newExp
+
+	return newExp
+}
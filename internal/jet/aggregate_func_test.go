@@ -0,0 +1,36 @@
+package jet
+
+import (
+	"testing"
+)
+
+func TestAggregateFuncFILTER(t *testing.T) {
+	assertClauseSerialize(t, NewIntegerAggregateFunc("COUNT", STAR).FILTER(table1ColInt.GT(Int(10))),
+		"COUNT(*) FILTER (WHERE table1.col_int > $1)", int64(10))
+	assertClauseSerialize(t, NewFloatAggregateFunc("SUM", table1ColFloat).FILTER(table1ColBool.IS_TRUE()),
+		"SUM(table1.col_float) FILTER (WHERE table1.col_bool IS TRUE)")
+	assertClauseSerialize(t, NewBoolAggregateFunc("BOOL_AND", table1ColBool).FILTER(table1ColInt.IS_NOT_NULL()),
+		"BOOL_AND(table1.col_bool) FILTER (WHERE table1.col_int IS NOT NULL)")
+}
+
+func TestAggregateFuncDISTINCT(t *testing.T) {
+	assertClauseSerialize(t, NewIntegerAggregateFunc("COUNT", table1ColInt).DISTINCT(), "COUNT(DISTINCT table1.col_int)")
+	assertClauseSerialize(t, NewFloatAggregateFunc("AVG", table1ColFloat).DISTINCT().FILTER(table1ColInt.EQ(Int(1))),
+		"AVG(DISTINCT table1.col_float) FILTER (WHERE table1.col_int = $1)", int64(1))
+}
+
+func TestAggregateFuncORDER_BY(t *testing.T) {
+	assertClauseSerialize(t, NewAggregateFunc("ARRAY_AGG", table1ColInt).ORDER_BY(table1ColFloat.DESC()),
+		"ARRAY_AGG(table1.col_int ORDER BY table1.col_float DESC)")
+	assertClauseSerialize(t, NewStringAggregateFunc("STRING_AGG", table2ColStr, String(",")).
+		DISTINCT().
+		ORDER_BY(table2ColStr.ASC(), table1ColInt.DESC().NULLS_LAST()),
+		"STRING_AGG(DISTINCT table2.col_str, $1 ORDER BY table2.col_str ASC, table1.col_int DESC NULLS LAST)", ",")
+}
+
+func TestAggregateFuncOVER(t *testing.T) {
+	assertClauseSerialize(t, NewIntegerAggregateFunc("SUM", table1ColInt).FILTER(table1ColBool).OVER(PARTITION_BY(table1ColFloat)),
+		"SUM(table1.col_int) FILTER (WHERE table1.col_bool) OVER (PARTITION BY table1.col_float)")
+	assertClauseSerialize(t, NewAggregateFunc("MAX", table1ColInt).FILTER(table1ColInt.LT(Int(3))).OVER(),
+		"MAX(table1.col_int) FILTER (WHERE table1.col_int < $1) OVER ()", int64(3))
+}
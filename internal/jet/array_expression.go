@@ -17,7 +17,9 @@ type Array[E Expression] interface {
 	CONCAT(rhs Array[E]) Array[E]
 	CONCAT_ELEMENT(E) Array[E]
 
-	AT(expression IntegerExpression) E
+	// AT returns array element at the index. Additional indexes are subscripts of the multidimensional array,
+	// for instance AT(Int(1), Int(2)) serializes as array[1][2].
+	AT(expression IntegerExpression, indexes ...IntegerExpression) E
 }
 
 type arrayInterfaceImpl[E Expression] struct {
@@ -70,8 +72,14 @@ func (a arrayInterfaceImpl[E]) CONCAT_ELEMENT(rhs E) Array[E] {
 	return ArrayExp[E](NewBinaryOperatorExpression(a.parent, rhs, "||"))
 }
 
-func (a arrayInterfaceImpl[E]) AT(at IntegerExpression) E {
-	return CastToArrayElemType[E](a.parent, AtomicCustomExpression(a.parent, Token("["), at, Token("]")))
+func (a arrayInterfaceImpl[E]) AT(at IntegerExpression, indexes ...IntegerExpression) E {
+	parts := []Serializer{a.parent}
+
+	for _, index := range append([]IntegerExpression{at}, indexes...) {
+		parts = append(parts, Token("["), index, Token("]"))
+	}
+
+	return CastToArrayElemType[E](a.parent, AtomicCustomExpression(parts...))
 }
 
 type arrayExpressionWrapper[E Expression] struct {
@@ -101,6 +109,8 @@ func CastToArrayElemType[E Expression](array Array[E], exp Expression) E {
 		i = BoolExp(exp)
 	case Array[StringExpression]:
 		i = StringExp(exp)
+	case Array[EnumExpression]:
+		i = EnumExp(exp)
 	case Array[IntegerExpression]:
 		i = IntExp(exp)
 	case Array[FloatExpression]:
@@ -119,6 +129,16 @@ func CastToArrayElemType[E Expression](array Array[E], exp Expression) E {
 		i = TimezExp(exp)
 	case Array[IntervalExpression]:
 		i = IntervalExp(exp)
+	case Array[JsonExpression]:
+		i = JsonExp(exp)
+	case Array[PgJsonExpression]:
+		i = PgJsonExp(exp)
+	case Array[JsonbExpression]:
+		i = JsonbExp(exp)
+	case Array[TsVectorExpression]:
+		i = TsVectorExp(exp)
+	case Array[TsQueryExpression]:
+		i = TsQueryExp(exp)
 	}
 
 	return i.(E)
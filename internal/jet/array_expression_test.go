@@ -51,6 +51,7 @@ func TestArrayExpressionCONCAT_ELEMENT(t *testing.T) {
 
 func TestArrayExpressionAT(t *testing.T) {
 	assertClauseSerialize(t, table1ColStringArray.AT(Int(1)), "table1.col_array_string[$1]", int64(1))
+	assertClauseSerialize(t, table1ColStringArray.AT(Int(1), Int(2)), "table1.col_array_string[$1][$2]", int64(1), int64(2))
 }
 
 func TestCastToArrayElemType(t *testing.T) {
@@ -58,6 +59,7 @@ func TestCastToArrayElemType(t *testing.T) {
 	var _ IntegerExpression = CastToArrayElemType[IntegerExpression](ARRAY[IntegerExpression](), table1Col1)
 	var _ FloatExpression = CastToArrayElemType[FloatExpression](ARRAY[FloatExpression](), table1Col1)
 	var _ StringExpression = CastToArrayElemType[StringExpression](ARRAY[StringExpression](), table1Col1)
+	var _ EnumExpression = CastToArrayElemType[EnumExpression](ARRAY[EnumExpression](), table1Col1)
 	var _ BlobExpression = CastToArrayElemType[BlobExpression](ARRAY[BlobExpression](), table1Col1)
 	var _ DateExpression = CastToArrayElemType[DateExpression](ARRAY[DateExpression](), table1Col1)
 	var _ TimestampExpression = CastToArrayElemType[TimestampExpression](ARRAY[TimestampExpression](), table1Col1)
@@ -12,6 +12,8 @@ type Column interface {
 	TableName() string
 
 	setTableName(table string)
+	setTable(schemaName, tableName string)
+	tableIdentifier() (schemaName, tableName string)
 	setSubQuery(subQuery SelectTable)
 	defaultAlias() string
 }
@@ -35,6 +37,10 @@ type ColumnExpressionImpl struct {
 	name      string
 	tableName string
 
+	// schema and name of the table column belongs to, regardless of table alias
+	tableSchemaName   string
+	tableOriginalName string
+
 	subQuery SelectTable
 }
 
@@ -68,6 +74,19 @@ func (c *ColumnExpressionImpl) setTableName(table string) {
 	c.tableName = table
 }
 
+func (c *ColumnExpressionImpl) setTable(schemaName, tableName string) {
+	c.tableSchemaName = schemaName
+	c.tableOriginalName = tableName
+}
+
+func (c *ColumnExpressionImpl) tableIdentifier() (schemaName, tableName string) {
+	if c.tableOriginalName == "" {
+		return "", c.tableName
+	}
+
+	return c.tableSchemaName, c.tableOriginalName
+}
+
 func (c *ColumnExpressionImpl) setSubQuery(subQuery SelectTable) {
 	c.subQuery = subQuery
 }
@@ -151,10 +151,12 @@ func (cl ColumnList) serializeForRowToJsonProjection(statement StatementType, ou
 func (cl ColumnList) Name() string { return "" }
 
 // TableName is placeholder for ColumnList to implement Column interface
-func (cl ColumnList) TableName() string                { return "" }
-func (cl ColumnList) setTableName(name string)         {}
-func (cl ColumnList) setSubQuery(subQuery SelectTable) {}
-func (cl ColumnList) defaultAlias() string             { return "" }
+func (cl ColumnList) TableName() string                     { return "" }
+func (cl ColumnList) setTableName(name string)              {}
+func (cl ColumnList) setTable(schemaName, tableName string) {}
+func (cl ColumnList) tableIdentifier() (string, string)     { return "", "" }
+func (cl ColumnList) setSubQuery(subQuery SelectTable)      {}
+func (cl ColumnList) defaultAlias() string                  { return "" }
 
 // SetTableName is utility function to set table name from outside of jet package to avoid making public setTableName
 func SetTableName(columnExpression ColumnExpression, tableName string) {
@@ -531,6 +531,228 @@ func IntervalColumn(name string) ColumnInterval {
 
 //------------------------------------------------------//
 
+// ColumnJson is interface of SQL json columns.
+type ColumnJson interface {
+	JsonExpression
+	Column
+
+	From(subQuery SelectTable) ColumnJson
+	SET(jsonExp JsonExpression) ColumnAssigment
+}
+
+type jsonColumnImpl struct {
+	jsonInterfaceImpl
+	*ColumnExpressionImpl
+}
+
+func (i *jsonColumnImpl) fromImpl(subQuery SelectTable) Projection {
+	return i.From(subQuery)
+}
+
+func (i *jsonColumnImpl) From(subQuery SelectTable) ColumnJson {
+	newJsonColumn := JsonColumn(i.name)
+	newJsonColumn.setTableName(i.tableName)
+	newJsonColumn.setSubQuery(subQuery)
+
+	return newJsonColumn
+}
+
+func (i *jsonColumnImpl) SET(jsonExp JsonExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:   i,
+		toAssign: jsonExp,
+	}
+}
+
+// JsonColumn creates named json column.
+func JsonColumn(name string) ColumnJson {
+	jsonColumn := &jsonColumnImpl{}
+	jsonColumn.jsonInterfaceImpl.root = jsonColumn
+	jsonColumn.ColumnExpressionImpl = NewColumnImpl(name, "", jsonColumn)
+
+	return jsonColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnPgJson is interface of PostgreSQL json columns.
+type ColumnPgJson interface {
+	PgJsonExpression
+	Column
+
+	From(subQuery SelectTable) ColumnPgJson
+	SET(jsonExp PgJsonExpression) ColumnAssigment
+}
+
+type pgJsonColumnImpl struct {
+	pgJsonInterfaceImpl
+	stringInterfaceImpl
+	*ColumnExpressionImpl
+}
+
+func (i *pgJsonColumnImpl) fromImpl(subQuery SelectTable) Projection {
+	return i.From(subQuery)
+}
+
+func (i *pgJsonColumnImpl) From(subQuery SelectTable) ColumnPgJson {
+	newJsonColumn := PgJsonColumn(i.name)
+	newJsonColumn.setTableName(i.tableName)
+	newJsonColumn.setSubQuery(subQuery)
+
+	return newJsonColumn
+}
+
+func (i *pgJsonColumnImpl) SET(jsonExp PgJsonExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:   i,
+		toAssign: jsonExp,
+	}
+}
+
+// PgJsonColumn creates named PostgreSQL json column.
+func PgJsonColumn(name string) ColumnPgJson {
+	jsonColumn := &pgJsonColumnImpl{}
+	jsonColumn.pgJsonInterfaceImpl.root = jsonColumn
+	jsonColumn.stringInterfaceImpl.root = jsonColumn
+	jsonColumn.ColumnExpressionImpl = NewColumnImpl(name, "", jsonColumn)
+
+	return jsonColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnJsonb is interface of SQL jsonb columns.
+type ColumnJsonb interface {
+	JsonbExpression
+	Column
+
+	From(subQuery SelectTable) ColumnJsonb
+	SET(jsonbExp JsonbExpression) ColumnAssigment
+}
+
+type jsonbColumnImpl struct {
+	jsonbInterfaceImpl
+	*ColumnExpressionImpl
+}
+
+func (i *jsonbColumnImpl) fromImpl(subQuery SelectTable) Projection {
+	return i.From(subQuery)
+}
+
+func (i *jsonbColumnImpl) From(subQuery SelectTable) ColumnJsonb {
+	newJsonbColumn := JsonbColumn(i.name)
+	newJsonbColumn.setTableName(i.tableName)
+	newJsonbColumn.setSubQuery(subQuery)
+
+	return newJsonbColumn
+}
+
+func (i *jsonbColumnImpl) SET(jsonbExp JsonbExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:   i,
+		toAssign: jsonbExp,
+	}
+}
+
+// JsonbColumn creates named jsonb column.
+func JsonbColumn(name string) ColumnJsonb {
+	jsonbColumn := &jsonbColumnImpl{}
+	jsonbColumn.jsonbInterfaceImpl.root = jsonbColumn
+	jsonbColumn.ColumnExpressionImpl = NewColumnImpl(name, "", jsonbColumn)
+
+	return jsonbColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnTsVector is interface of SQL tsvector columns.
+type ColumnTsVector interface {
+	TsVectorExpression
+	Column
+
+	From(subQuery SelectTable) ColumnTsVector
+	SET(tsVectorExp TsVectorExpression) ColumnAssigment
+}
+
+type tsVectorColumnImpl struct {
+	tsVectorInterfaceImpl
+	*ColumnExpressionImpl
+}
+
+func (i *tsVectorColumnImpl) fromImpl(subQuery SelectTable) Projection {
+	return i.From(subQuery)
+}
+
+func (i *tsVectorColumnImpl) From(subQuery SelectTable) ColumnTsVector {
+	newTsVectorColumn := TsVectorColumn(i.name)
+	newTsVectorColumn.setTableName(i.tableName)
+	newTsVectorColumn.setSubQuery(subQuery)
+
+	return newTsVectorColumn
+}
+
+func (i *tsVectorColumnImpl) SET(tsVectorExp TsVectorExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:   i,
+		toAssign: tsVectorExp,
+	}
+}
+
+// TsVectorColumn creates named tsvector column.
+func TsVectorColumn(name string) ColumnTsVector {
+	tsVectorColumn := &tsVectorColumnImpl{}
+	tsVectorColumn.tsVectorInterfaceImpl.root = tsVectorColumn
+	tsVectorColumn.ColumnExpressionImpl = NewColumnImpl(name, "", tsVectorColumn)
+
+	return tsVectorColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnTsQuery is interface of SQL tsquery columns.
+type ColumnTsQuery interface {
+	TsQueryExpression
+	Column
+
+	From(subQuery SelectTable) ColumnTsQuery
+	SET(tsQueryExp TsQueryExpression) ColumnAssigment
+}
+
+type tsQueryColumnImpl struct {
+	tsQueryInterfaceImpl
+	*ColumnExpressionImpl
+}
+
+func (i *tsQueryColumnImpl) fromImpl(subQuery SelectTable) Projection {
+	return i.From(subQuery)
+}
+
+func (i *tsQueryColumnImpl) From(subQuery SelectTable) ColumnTsQuery {
+	newTsQueryColumn := TsQueryColumn(i.name)
+	newTsQueryColumn.setTableName(i.tableName)
+	newTsQueryColumn.setSubQuery(subQuery)
+
+	return newTsQueryColumn
+}
+
+func (i *tsQueryColumnImpl) SET(tsQueryExp TsQueryExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:   i,
+		toAssign: tsQueryExp,
+	}
+}
+
+// TsQueryColumn creates named tsquery column.
+func TsQueryColumn(name string) ColumnTsQuery {
+	tsQueryColumn := &tsQueryColumnImpl{}
+	tsQueryColumn.tsQueryInterfaceImpl.root = tsQueryColumn
+	tsQueryColumn.ColumnExpressionImpl = NewColumnImpl(name, "", tsQueryColumn)
+
+	return tsQueryColumn
+}
+
+//------------------------------------------------------//
+
 // ColumnRange is interface for range columns which can be int range, string range
 // timestamp range or date range.
 type ColumnRange[T Expression] interface {
@@ -573,3 +795,44 @@ func RangeColumn[T Expression](name string) ColumnRange[T] {
 
 	return rangeColumn
 }
+
+//------------------------------------------------------//
+
+// ColumnFts5 is interface of SQLite FTS5 hidden column, the column with the same name as the FTS5 virtual table.
+type ColumnFts5 interface {
+	Column
+	Expression
+
+	// MATCH checks if the row of the FTS5 table matches full-text query (table MATCH query)
+	MATCH(query StringExpression) BoolExpression
+
+	From(subQuery SelectTable) ColumnFts5
+}
+
+type fts5ColumnImpl struct {
+	*ColumnExpressionImpl
+}
+
+func (i *fts5ColumnImpl) fromImpl(subQuery SelectTable) Projection {
+	return i.From(subQuery)
+}
+
+func (i *fts5ColumnImpl) From(subQuery SelectTable) ColumnFts5 {
+	newFts5Column := Fts5Column(i.name)
+	newFts5Column.setTableName(i.tableName)
+	newFts5Column.setSubQuery(subQuery)
+
+	return newFts5Column
+}
+
+func (i *fts5ColumnImpl) MATCH(query StringExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(i, query, "MATCH")
+}
+
+// Fts5Column creates named FTS5 hidden column.
+func Fts5Column(name string) ColumnFts5 {
+	fts5Column := &fts5ColumnImpl{}
+	fts5Column.ColumnExpressionImpl = NewColumnImpl(name, "", fts5Column)
+
+	return fts5Column
+}
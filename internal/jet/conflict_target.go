@@ -0,0 +1,16 @@
+package jet
+
+// ConflictTarget is ON CONFLICT clause target, unique index (or unique constraint) columns with an optional
+// partial index predicate. Generated table types contain conflict target for each unique index of the table.
+type ConflictTarget struct {
+	Columns   ColumnList
+	Predicate BoolExpression
+}
+
+// NewConflictTarget creates new conflict target
+func NewConflictTarget(predicate BoolExpression, columns ...ColumnExpression) ConflictTarget {
+	return ConflictTarget{
+		Columns:   columns,
+		Predicate: predicate,
+	}
+}
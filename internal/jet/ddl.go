@@ -0,0 +1,551 @@
+package jet
+
+import "fmt"
+
+// ColumnDefinition is definition of the table column used in CREATE TABLE and ALTER TABLE statements
+type ColumnDefinition interface {
+	Serializer
+
+	// TYPE sets sql data type of the column. If not set, dialect default data type for the column type is used.
+	TYPE(dataType string) ColumnDefinition
+	// NOT_NULL adds NOT NULL constraint to the column
+	NOT_NULL() ColumnDefinition
+	// PRIMARY_KEY adds PRIMARY KEY constraint to the column
+	PRIMARY_KEY() ColumnDefinition
+	// UNIQUE adds UNIQUE constraint to the column
+	UNIQUE() ColumnDefinition
+	// DEFAULT sets column default value
+	DEFAULT(value Expression) ColumnDefinition
+	// CHECK adds CHECK constraint to the column
+	CHECK(condition BoolExpression) ColumnDefinition
+	// REFERENCES adds foreign key constraint to the column. MySQL ignores column foreign keys, use FOREIGN_KEY table constraint instead.
+	REFERENCES(column Column) ColumnDefinition
+}
+
+type columnDefinitionImpl struct {
+	column     Column
+	dataType   string
+	notNull    bool
+	primaryKey bool
+	unique     bool
+	defaultVal Expression
+	check      BoolExpression
+	references Column
+}
+
+// ColumnDef creates new column definition
+func ColumnDef(column Column) ColumnDefinition {
+	return &columnDefinitionImpl{column: column}
+}
+
+func (c *columnDefinitionImpl) TYPE(dataType string) ColumnDefinition {
+	c.dataType = dataType
+	return c
+}
+
+func (c *columnDefinitionImpl) NOT_NULL() ColumnDefinition {
+	c.notNull = true
+	return c
+}
+
+func (c *columnDefinitionImpl) PRIMARY_KEY() ColumnDefinition {
+	c.primaryKey = true
+	return c
+}
+
+func (c *columnDefinitionImpl) UNIQUE() ColumnDefinition {
+	c.unique = true
+	return c
+}
+
+func (c *columnDefinitionImpl) DEFAULT(value Expression) ColumnDefinition {
+	c.defaultVal = value
+	return c
+}
+
+func (c *columnDefinitionImpl) CHECK(condition BoolExpression) ColumnDefinition {
+	c.check = condition
+	return c
+}
+
+func (c *columnDefinitionImpl) REFERENCES(column Column) ColumnDefinition {
+	c.references = column
+	return c
+}
+
+func (c *columnDefinitionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if c.column == nil {
+		panic("jet: column is nil for column definition")
+	}
+
+	dataType := c.dataType
+
+	if dataType == "" {
+		dataType = out.Dialect.DataType(c.column)
+	}
+
+	if dataType == "" {
+		panic(fmt.Sprintf("jet: unknown data type for column '%s', use TYPE to set it", c.column.Name()))
+	}
+
+	out.WriteIdentifier(c.column.Name())
+	out.WriteString(dataType)
+
+	if c.notNull {
+		out.WriteString("NOT NULL")
+	}
+
+	if c.primaryKey {
+		out.WriteString("PRIMARY KEY")
+	}
+
+	if c.unique {
+		out.WriteString("UNIQUE")
+	}
+
+	if c.defaultVal != nil {
+		out.WriteString("DEFAULT")
+		serializeDDLExpression(c.defaultVal, statement, out)
+	}
+
+	if c.check != nil {
+		out.WriteString("CHECK (")
+		serializeDDLExpression(c.check, statement, out, NoWrap)
+		out.WriteString(")")
+	}
+
+	if c.references != nil {
+		serializeReferences(out, []Column{c.references})
+	}
+}
+
+// ColumnDefList converts list of columns to list of column definitions with dialect default data types
+func ColumnDefList(columns []Column) []ColumnDefinition {
+	var ret []ColumnDefinition
+
+	for _, column := range columns {
+		ret = append(ret, ColumnDef(column))
+	}
+
+	return ret
+}
+
+// ---------------------------------------------------//
+
+// TableConstraint is table constraint used in CREATE TABLE and ALTER TABLE statements
+type TableConstraint interface {
+	Serializer
+	isTableConstraint()
+}
+
+type tableConstraintImpl struct {
+	name       string
+	kind       string
+	columns    []Column
+	references []Column
+	condition  BoolExpression
+}
+
+func (t *tableConstraintImpl) isTableConstraint() {}
+
+func (t *tableConstraintImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if t.name != "" {
+		out.WriteString("CONSTRAINT")
+		out.WriteIdentifier(t.name)
+	}
+
+	out.WriteString(t.kind)
+
+	if t.condition != nil {
+		out.WriteString("(")
+		serializeDDLExpression(t.condition, statement, out, NoWrap)
+		out.WriteString(")")
+		return
+	}
+
+	serializeColumnNames(out, t.columns)
+
+	if len(t.references) > 0 {
+		serializeReferences(out, t.references)
+	}
+}
+
+// PrimaryKey creates PRIMARY KEY table constraint
+func PrimaryKey(columns ...Column) TableConstraint {
+	return &tableConstraintImpl{kind: "PRIMARY KEY", columns: columns}
+}
+
+// Unique creates UNIQUE table constraint
+func Unique(columns ...Column) TableConstraint {
+	return &tableConstraintImpl{kind: "UNIQUE", columns: columns}
+}
+
+// Check creates CHECK table constraint
+func Check(condition BoolExpression) TableConstraint {
+	return &tableConstraintImpl{kind: "CHECK", condition: condition}
+}
+
+// ForeignKey starts FOREIGN KEY table constraint
+func ForeignKey(columns ...Column) ForeignKeyConstraint {
+	return ForeignKeyConstraint{columns: columns}
+}
+
+// ForeignKeyConstraint is FOREIGN KEY table constraint without referenced columns
+type ForeignKeyConstraint struct {
+	name    string
+	columns []Column
+}
+
+// REFERENCES sets columns referenced by the foreign key constraint
+func (f ForeignKeyConstraint) REFERENCES(columns ...Column) TableConstraint {
+	return &tableConstraintImpl{name: f.name, kind: "FOREIGN KEY", columns: f.columns, references: columns}
+}
+
+// NamedConstraint is used to create table constraint with a name
+type NamedConstraint struct {
+	name string
+}
+
+// Constraint creates new NamedConstraint
+func Constraint(name string) NamedConstraint {
+	return NamedConstraint{name: name}
+}
+
+// PRIMARY_KEY creates named PRIMARY KEY table constraint
+func (n NamedConstraint) PRIMARY_KEY(columns ...Column) TableConstraint {
+	return &tableConstraintImpl{name: n.name, kind: "PRIMARY KEY", columns: columns}
+}
+
+// UNIQUE creates named UNIQUE table constraint
+func (n NamedConstraint) UNIQUE(columns ...Column) TableConstraint {
+	return &tableConstraintImpl{name: n.name, kind: "UNIQUE", columns: columns}
+}
+
+// CHECK creates named CHECK table constraint
+func (n NamedConstraint) CHECK(condition BoolExpression) TableConstraint {
+	return &tableConstraintImpl{name: n.name, kind: "CHECK", condition: condition}
+}
+
+// FOREIGN_KEY starts named FOREIGN KEY table constraint
+func (n NamedConstraint) FOREIGN_KEY(columns ...Column) ForeignKeyConstraint {
+	return ForeignKeyConstraint{name: n.name, columns: columns}
+}
+
+// ---------------------------------------------------//
+
+// ClauseCreateTable struct
+type ClauseCreateTable struct {
+	Table       SerializerTable
+	Temporary   bool
+	IfNotExists bool
+	Columns     []ColumnDefinition
+	Constraints []TableConstraint
+}
+
+// Serialize serializes clause into SQLBuilder
+func (c *ClauseCreateTable) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if c.Table == nil {
+		panic("jet: table is nil for CREATE TABLE statement")
+	}
+
+	columns := c.Columns
+
+	if len(columns) == 0 {
+		columns = ColumnDefList(c.Table.columns())
+	}
+
+	if len(columns) == 0 {
+		panic("jet: no columns for CREATE TABLE statement")
+	}
+
+	out.NewLine()
+	out.WriteString("CREATE")
+
+	if c.Temporary {
+		out.WriteString("TEMPORARY")
+	}
+
+	out.WriteString("TABLE")
+
+	if c.IfNotExists {
+		out.WriteString("IF NOT EXISTS")
+	}
+
+	serializeTableName(out, c.Table)
+	out.WriteString("(")
+	out.IncreaseIdent(tabSize)
+
+	for i, column := range columns {
+		if i > 0 {
+			out.WriteString(",")
+		}
+		out.NewLine()
+		column.serialize(statementType, out, FallTrough(options)...)
+	}
+
+	for _, constraint := range c.Constraints {
+		out.WriteString(",")
+		out.NewLine()
+		constraint.serialize(statementType, out, FallTrough(options)...)
+	}
+
+	out.DecreaseIdent(tabSize)
+	out.NewLine()
+	out.WriteString(")")
+}
+
+// ClauseAlterTable struct
+type ClauseAlterTable struct {
+	Table   SerializerTable
+	Actions []Serializer
+}
+
+// Serialize serializes clause into SQLBuilder
+func (c *ClauseAlterTable) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if c.Table == nil {
+		panic("jet: table is nil for ALTER TABLE statement")
+	}
+
+	if len(c.Actions) == 0 {
+		panic("jet: no actions for ALTER TABLE statement")
+	}
+
+	out.NewLine()
+	out.WriteString("ALTER TABLE")
+	serializeTableName(out, c.Table)
+	out.IncreaseIdent(tabSize)
+
+	for i, action := range c.Actions {
+		if i > 0 {
+			out.WriteString(",")
+		}
+		out.NewLine()
+		action.serialize(statementType, out, FallTrough(options)...)
+	}
+
+	out.DecreaseIdent(tabSize)
+}
+
+// AddColumn creates ALTER TABLE action adding new column
+func AddColumn(column ColumnDefinition) Serializer {
+	return &ddlActionImpl{parts: []Serializer{Token("ADD COLUMN"), column}}
+}
+
+// DropColumn creates ALTER TABLE action removing column
+func DropColumn(column Column) Serializer {
+	return &ddlActionImpl{parts: []Serializer{Token("DROP COLUMN"), identifier(column.Name())}}
+}
+
+// RenameColumn creates ALTER TABLE action renaming column
+func RenameColumn(column Column, newName string) Serializer {
+	return &ddlActionImpl{parts: []Serializer{Token("RENAME COLUMN"), identifier(column.Name()), Token("TO"), identifier(newName)}}
+}
+
+// RenameTo creates ALTER TABLE action renaming table
+func RenameTo(newTableName string) Serializer {
+	return &ddlActionImpl{parts: []Serializer{Token("RENAME TO"), identifier(newTableName)}}
+}
+
+// AddConstraint creates ALTER TABLE action adding new table constraint
+func AddConstraint(constraint TableConstraint) Serializer {
+	return &ddlActionImpl{parts: []Serializer{Token("ADD"), constraint}}
+}
+
+// DropConstraint creates ALTER TABLE action removing named table constraint
+func DropConstraint(name string) Serializer {
+	return &ddlActionImpl{parts: []Serializer{Token("DROP CONSTRAINT"), identifier(name)}}
+}
+
+// AlterColumn creates ALTER TABLE action changing the column (PostgreSQL only). Expressions in the list of
+// parts are serialized with inlined arguments and without table name.
+func AlterColumn(column Column, parts ...Serializer) Serializer {
+	return &ddlActionImpl{parts: append([]Serializer{Token("ALTER COLUMN"), identifier(column.Name())}, parts...)}
+}
+
+// ModifyColumn creates ALTER TABLE action changing the column definition (MySQL only)
+func ModifyColumn(column ColumnDefinition) Serializer {
+	return &ddlActionImpl{parts: []Serializer{Token("MODIFY COLUMN"), column}}
+}
+
+type ddlActionImpl struct {
+	parts []Serializer
+}
+
+func (d *ddlActionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	for _, part := range d.parts {
+		if expression, ok := part.(Expression); ok {
+			serializeDDLExpression(expression, statement, out)
+			continue
+		}
+
+		part.serialize(statement, out, FallTrough(options)...)
+	}
+}
+
+// ClauseDropTable struct
+type ClauseDropTable struct {
+	Tables   []SerializerTable
+	IfExists bool
+}
+
+// Serialize serializes clause into SQLBuilder
+func (c *ClauseDropTable) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if len(c.Tables) == 0 {
+		panic("jet: no tables for DROP TABLE statement")
+	}
+
+	out.NewLine()
+	out.WriteString("DROP TABLE")
+
+	if c.IfExists {
+		out.WriteString("IF EXISTS")
+	}
+
+	for i, table := range c.Tables {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		serializeTableName(out, table)
+	}
+}
+
+// ClauseCreateIndex struct
+type ClauseCreateIndex struct {
+	Name        string
+	Unique      bool
+	IfNotExists bool
+	Table       SerializerTable
+	Using       string // PostgreSQL only
+	Columns     []Column
+}
+
+// Serialize serializes clause into SQLBuilder
+func (c *ClauseCreateIndex) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if c.Table == nil {
+		panic("jet: table is nil for CREATE INDEX statement")
+	}
+
+	if len(c.Columns) == 0 {
+		panic("jet: no columns for CREATE INDEX statement")
+	}
+
+	out.NewLine()
+	out.WriteString("CREATE")
+
+	if c.Unique {
+		out.WriteString("UNIQUE")
+	}
+
+	out.WriteString("INDEX")
+
+	if c.IfNotExists {
+		out.WriteString("IF NOT EXISTS")
+	}
+
+	out.WriteIdentifier(c.Name)
+	out.WriteString("ON")
+	serializeTableName(out, c.Table)
+
+	if c.Using != "" {
+		out.WriteString("USING " + c.Using)
+	}
+
+	serializeColumnNames(out, c.Columns)
+}
+
+// ClauseDDLWhere is WHERE clause of the DDL statements. Condition is serialized with inlined arguments
+// and without table name.
+type ClauseDDLWhere struct {
+	Condition BoolExpression
+}
+
+// Serialize serializes clause into SQLBuilder
+func (c *ClauseDDLWhere) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if c.Condition == nil {
+		return
+	}
+
+	out.NewLine()
+	out.WriteString("WHERE")
+	serializeDDLExpression(c.Condition, statementType, out, NoWrap)
+}
+
+// ClauseDropIndex struct
+type ClauseDropIndex struct {
+	Name     string
+	IfExists bool
+	Table    SerializerTable // MySQL only
+}
+
+// Serialize serializes clause into SQLBuilder
+func (c *ClauseDropIndex) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.NewLine()
+	out.WriteString("DROP INDEX")
+
+	if c.IfExists {
+		out.WriteString("IF EXISTS")
+	}
+
+	out.WriteIdentifier(c.Name)
+
+	if c.Table != nil {
+		out.WriteString("ON")
+		serializeTableName(out, c.Table)
+	}
+}
+
+// ---------------------------------------------------//
+
+type identifier string
+
+func (i identifier) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteIdentifier(string(i))
+}
+
+// serializeDDLExpression serializes expression without table names and with arguments inlined,
+// because DDL statements do not accept query parameters.
+func serializeDDLExpression(expression Expression, statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	debug := out.Debug
+	out.Debug = true
+
+	expression.serialize(statement, out, append(options, ShortName)...)
+
+	out.Debug = debug
+}
+
+func serializeTableName(out *SQLBuilder, table Table) {
+	if table.SchemaName() != "" {
+		out.WriteIdentifier(table.SchemaName())
+		out.WriteString(".")
+	}
+
+	out.WriteIdentifier(table.TableName())
+}
+
+func serializeColumnNames(out *SQLBuilder, columns []Column) {
+	out.WriteString("(")
+
+	for i, column := range columns {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		out.WriteIdentifier(column.Name())
+	}
+
+	out.WriteString(")")
+}
+
+func serializeReferences(out *SQLBuilder, columns []Column) {
+	out.WriteString("REFERENCES")
+
+	schemaName, tableName := columns[0].tableIdentifier()
+
+	// SQLite foreign keys can only reference tables from the same schema, and do not accept schema qualified names
+	if schemaName != "" && out.Dialect.Name() != "SQLite" {
+		out.WriteIdentifier(schemaName)
+		out.WriteString(".")
+	}
+
+	out.WriteIdentifier(tableName)
+	serializeColumnNames(out, columns)
+}
@@ -0,0 +1,22 @@
+package jet
+
+import "testing"
+
+func TestColumnDefinition(t *testing.T) {
+	assertClauseSerialize(t, ColumnDef(table3ColInt).TYPE("integer"), `col_int integer`)
+	assertClauseSerialize(t, ColumnDef(table3ColInt).TYPE("integer").NOT_NULL().PRIMARY_KEY(), `col_int integer NOT NULL PRIMARY KEY`)
+	assertClauseSerialize(t, ColumnDef(table3StrCol).TYPE("text").UNIQUE().DEFAULT(String("none")), `col2 text UNIQUE DEFAULT 'none'`)
+	assertClauseSerialize(t, ColumnDef(table3ColInt).TYPE("integer").CHECK(table3ColInt.GT(Int(10))), `col_int integer CHECK (col_int > 10)`)
+	assertClauseSerialize(t, ColumnDef(table3Col1).TYPE("integer").REFERENCES(table2Col3), `col1 integer REFERENCES db.table2 (col3)`)
+	assertClauseSerializeErr(t, ColumnDef(table3Col1), "jet: unknown data type for column 'col1', use TYPE to set it")
+}
+
+func TestTableConstraint(t *testing.T) {
+	assertClauseSerialize(t, PrimaryKey(table3Col1, table3ColInt), `PRIMARY KEY (col1, col_int)`)
+	assertClauseSerialize(t, Unique(table3StrCol), `UNIQUE (col2)`)
+	assertClauseSerialize(t, Check(table3ColInt.BETWEEN(Int(1), Int(5))), `CHECK (col_int BETWEEN 1 AND 5)`)
+	assertClauseSerialize(t, ForeignKey(table3Col1).REFERENCES(table2Col3), `FOREIGN KEY (col1) REFERENCES db.table2 (col3)`)
+	assertClauseSerialize(t, Constraint("table3_pk").PRIMARY_KEY(table3Col1), `CONSTRAINT table3_pk PRIMARY KEY (col1)`)
+	assertClauseSerialize(t, Constraint("table3_fk").FOREIGN_KEY(table3Col1, table3ColInt).REFERENCES(table2Col3, table2Col4),
+		`CONSTRAINT table3_fk FOREIGN KEY (col1, col_int) REFERENCES db.table2 (col3, col4)`)
+}
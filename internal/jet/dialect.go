@@ -18,6 +18,7 @@ type Dialect interface {
 	ValuesDefaultColumnName(index int) string
 	JsonValueEncode(expr Expression) Expression
 	RegexpLike(str StringExpression, not bool, pattern StringExpression, caseSensitive bool) SerializerFunc
+	DataType(column Column) string
 }
 
 // SerializerFunc func
@@ -43,6 +44,7 @@ type DialectParams struct {
 	ValuesDefaultColumnName    func(index int) string
 	JsonValueEncode            func(expr Expression) Expression
 	RegexpLike                 func(str StringExpression, not bool, pattern StringExpression, caseSensitive bool) SerializerFunc
+	DataType                   func(column Column) string
 }
 
 // NewDialect creates new dialect with params
@@ -60,6 +62,7 @@ func NewDialect(params DialectParams) Dialect {
 		valuesDefaultColumnName:    params.ValuesDefaultColumnName,
 		jsonValueEncode:            params.JsonValueEncode,
 		regexpLike:                 params.RegexpLike,
+		dataType:                   params.DataType,
 	}
 }
 
@@ -76,6 +79,7 @@ type dialectImpl struct {
 	valuesDefaultColumnName    func(index int) string
 	jsonValueEncode            func(expr Expression) Expression
 	regexpLike                 func(str StringExpression, not bool, pattern StringExpression, caseSensitive bool) SerializerFunc
+	dataType                   func(column Column) string
 }
 
 func (d *dialectImpl) Name() string {
@@ -141,6 +145,14 @@ func (d *dialectImpl) RegexpLike(str StringExpression, not bool, pattern StringE
 	}
 }
 
+func (d *dialectImpl) DataType(column Column) string {
+	if d.dataType == nil {
+		return ""
+	}
+
+	return d.dataType(column)
+}
+
 func arrayOfStringsToMapOfStrings(arr []string) map[string]bool {
 	ret := map[string]bool{}
 	for _, elem := range arr {
@@ -0,0 +1,263 @@
+package jet
+
+// JSON operators
+const (
+	JsonGetOperator         = "->"
+	JsonGetTextOperator     = "->>"
+	JsonGetPathOperator     = "#>"
+	JsonGetPathTextOperator = "#>>"
+)
+
+// JsonExpression interface
+type JsonExpression interface {
+	Expression
+	isJson()
+
+	// GET extracts JSON object field or JSON array element with the given key (json -> key)
+	GET(key Expression) JsonExpression
+	// GET_TEXT extracts JSON object field or JSON array element with the given key, as text (json ->> key)
+	GET_TEXT(key Expression) StringExpression
+}
+
+type jsonInterfaceImpl struct {
+	root JsonExpression
+}
+
+func (j *jsonInterfaceImpl) isJson() {}
+
+func (j *jsonInterfaceImpl) GET(key Expression) JsonExpression {
+	return JsonExp(NewBinaryOperatorExpression(j.root, key, JsonGetOperator))
+}
+
+func (j *jsonInterfaceImpl) GET_TEXT(key Expression) StringExpression {
+	return StringExp(NewBinaryOperatorExpression(j.root, key, JsonGetTextOperator))
+}
+
+//---------------------------------------------------//
+
+type jsonExpressionWrapper struct {
+	jsonInterfaceImpl
+	Expression
+}
+
+func newJsonExpressionWrap(expression Expression) JsonExpression {
+	jsonExpressionWrap := &jsonExpressionWrapper{Expression: expression}
+	jsonExpressionWrap.jsonInterfaceImpl.root = jsonExpressionWrap
+	expression.setRoot(jsonExpressionWrap)
+	return jsonExpressionWrap
+}
+
+// JsonExp is json expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as json expression.
+// Does not add sql cast to generated sql builder output.
+func JsonExp(expression Expression) JsonExpression {
+	return newJsonExpressionWrap(expression)
+}
+
+//---------------------------------------------------//
+
+// PgJsonExpression is interface of PostgreSQL json expressions. Unlike JsonExpression, shared by all
+// the dialects, it also supports PostgreSQL path operators. PostgreSQL json expressions are also string
+// expressions, so they can still be used where json values were used as string expressions
+// (for instance, in assignments to json columns generated as string columns).
+type PgJsonExpression interface {
+	StringExpression
+	isJson()
+
+	// GET extracts JSON object field or JSON array element with the given key (json -> key)
+	GET(key Expression) PgJsonExpression
+	// GET_TEXT extracts JSON object field or JSON array element with the given key, as text (json ->> key)
+	GET_TEXT(key Expression) StringExpression
+	// GET_PATH extracts JSON sub-object at the specified path (json #> path)
+	GET_PATH(path Array[StringExpression]) PgJsonExpression
+	// GET_PATH_TEXT extracts JSON sub-object at the specified path as text (json #>> path)
+	GET_PATH_TEXT(path Array[StringExpression]) StringExpression
+}
+
+type pgJsonInterfaceImpl struct {
+	root PgJsonExpression
+}
+
+func (j *pgJsonInterfaceImpl) isJson() {}
+
+func (j *pgJsonInterfaceImpl) GET(key Expression) PgJsonExpression {
+	return PgJsonExp(NewBinaryOperatorExpression(j.root, key, JsonGetOperator))
+}
+
+func (j *pgJsonInterfaceImpl) GET_TEXT(key Expression) StringExpression {
+	return StringExp(NewBinaryOperatorExpression(j.root, key, JsonGetTextOperator))
+}
+
+func (j *pgJsonInterfaceImpl) GET_PATH(path Array[StringExpression]) PgJsonExpression {
+	return PgJsonExp(NewBinaryOperatorExpression(j.root, path, JsonGetPathOperator))
+}
+
+func (j *pgJsonInterfaceImpl) GET_PATH_TEXT(path Array[StringExpression]) StringExpression {
+	return StringExp(NewBinaryOperatorExpression(j.root, path, JsonGetPathTextOperator))
+}
+
+//---------------------------------------------------//
+
+type pgJsonExpressionWrapper struct {
+	pgJsonInterfaceImpl
+	stringInterfaceImpl
+	Expression
+}
+
+func newPgJsonExpressionWrap(expression Expression) PgJsonExpression {
+	pgJsonExpressionWrap := &pgJsonExpressionWrapper{Expression: expression}
+	pgJsonExpressionWrap.pgJsonInterfaceImpl.root = pgJsonExpressionWrap
+	pgJsonExpressionWrap.stringInterfaceImpl.root = pgJsonExpressionWrap
+	expression.setRoot(pgJsonExpressionWrap)
+	return pgJsonExpressionWrap
+}
+
+// PgJsonExp is PostgreSQL json expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as PostgreSQL json expression.
+// Does not add sql cast to generated sql builder output.
+func PgJsonExp(expression Expression) PgJsonExpression {
+	return newPgJsonExpressionWrap(expression)
+}
+
+//---------------------------------------------------//
+
+// JsonbExpression interface
+type JsonbExpression interface {
+	Expression
+	isJsonb()
+
+	EQ(rhs JsonbExpression) BoolExpression
+	NOT_EQ(rhs JsonbExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs JsonbExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs JsonbExpression) BoolExpression
+
+	// GET extracts JSON object field or JSON array element with the given key (jsonb -> key)
+	GET(key Expression) JsonbExpression
+	// GET_TEXT extracts JSON object field or JSON array element with the given key, as text (jsonb ->> key)
+	GET_TEXT(key Expression) StringExpression
+	// GET_PATH extracts JSON sub-object at the specified path (jsonb #> path)
+	GET_PATH(path Array[StringExpression]) JsonbExpression
+	// GET_PATH_TEXT extracts JSON sub-object at the specified path as text (jsonb #>> path)
+	GET_PATH_TEXT(path Array[StringExpression]) StringExpression
+
+	// CONTAINS checks if this expression contains rhs JSON path/value entries at the top level (jsonb @> jsonb)
+	CONTAINS(rhs JsonbExpression) BoolExpression
+	// IS_CONTAINED_BY checks if this expression is contained by rhs at the top level (jsonb <@ jsonb)
+	IS_CONTAINED_BY(rhs JsonbExpression) BoolExpression
+	// HAS_KEY checks if the key exists as a top-level key or array element (jsonb ? text)
+	HAS_KEY(key StringExpression) BoolExpression
+	// HAS_ANY_KEY checks if any of the keys exist as top-level keys or array elements (jsonb ?| text[])
+	HAS_ANY_KEY(keys Array[StringExpression]) BoolExpression
+	// HAS_ALL_KEYS checks if all the keys exist as top-level keys or array elements (jsonb ?& text[])
+	HAS_ALL_KEYS(keys Array[StringExpression]) BoolExpression
+	// CONCAT concatenates two jsonb values (jsonb || jsonb)
+	CONCAT(rhs JsonbExpression) JsonbExpression
+	// DELETE deletes a key (and its value) from a JSON object, or matching string value(s) or
+	// array element from a JSON array (jsonb - key)
+	DELETE(key Expression) JsonbExpression
+	// DELETE_PATH deletes the field or array element at the specified path (jsonb #- text[])
+	DELETE_PATH(path Array[StringExpression]) JsonbExpression
+	// PATH_EXISTS checks if JSON path returns any item for the specified JSON value (jsonb @? jsonpath)
+	PATH_EXISTS(path StringExpression) BoolExpression
+	// PATH_MATCH returns the result of a JSON path predicate check for the specified JSON value (jsonb @@ jsonpath)
+	PATH_MATCH(path StringExpression) BoolExpression
+}
+
+type jsonbInterfaceImpl struct {
+	root JsonbExpression
+}
+
+func (j *jsonbInterfaceImpl) isJsonb() {}
+
+func (j *jsonbInterfaceImpl) EQ(rhs JsonbExpression) BoolExpression {
+	return Eq(j.root, rhs)
+}
+
+func (j *jsonbInterfaceImpl) NOT_EQ(rhs JsonbExpression) BoolExpression {
+	return NotEq(j.root, rhs)
+}
+
+func (j *jsonbInterfaceImpl) IS_DISTINCT_FROM(rhs JsonbExpression) BoolExpression {
+	return IsDistinctFrom(j.root, rhs)
+}
+
+func (j *jsonbInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs JsonbExpression) BoolExpression {
+	return IsNotDistinctFrom(j.root, rhs)
+}
+
+func (j *jsonbInterfaceImpl) GET(key Expression) JsonbExpression {
+	return JsonbExp(NewBinaryOperatorExpression(j.root, key, JsonGetOperator))
+}
+
+func (j *jsonbInterfaceImpl) GET_TEXT(key Expression) StringExpression {
+	return StringExp(NewBinaryOperatorExpression(j.root, key, JsonGetTextOperator))
+}
+
+func (j *jsonbInterfaceImpl) GET_PATH(path Array[StringExpression]) JsonbExpression {
+	return JsonbExp(NewBinaryOperatorExpression(j.root, path, JsonGetPathOperator))
+}
+
+func (j *jsonbInterfaceImpl) GET_PATH_TEXT(path Array[StringExpression]) StringExpression {
+	return StringExp(NewBinaryOperatorExpression(j.root, path, JsonGetPathTextOperator))
+}
+
+func (j *jsonbInterfaceImpl) CONTAINS(rhs JsonbExpression) BoolExpression {
+	return Contains(j.root, rhs)
+}
+
+func (j *jsonbInterfaceImpl) IS_CONTAINED_BY(rhs JsonbExpression) BoolExpression {
+	return IsContainedBy(j.root, rhs)
+}
+
+func (j *jsonbInterfaceImpl) HAS_KEY(key StringExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(j.root, key, "?")
+}
+
+func (j *jsonbInterfaceImpl) HAS_ANY_KEY(keys Array[StringExpression]) BoolExpression {
+	return newBinaryBoolOperatorExpression(j.root, keys, "?|")
+}
+
+func (j *jsonbInterfaceImpl) HAS_ALL_KEYS(keys Array[StringExpression]) BoolExpression {
+	return newBinaryBoolOperatorExpression(j.root, keys, "?&")
+}
+
+func (j *jsonbInterfaceImpl) CONCAT(rhs JsonbExpression) JsonbExpression {
+	return JsonbExp(NewBinaryOperatorExpression(j.root, rhs, StringConcatOperator))
+}
+
+func (j *jsonbInterfaceImpl) DELETE(key Expression) JsonbExpression {
+	return JsonbExp(Sub(j.root, key))
+}
+
+func (j *jsonbInterfaceImpl) DELETE_PATH(path Array[StringExpression]) JsonbExpression {
+	return JsonbExp(NewBinaryOperatorExpression(j.root, path, "#-"))
+}
+
+func (j *jsonbInterfaceImpl) PATH_EXISTS(path StringExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(j.root, path, "@?")
+}
+
+func (j *jsonbInterfaceImpl) PATH_MATCH(path StringExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(j.root, path, "@@")
+}
+
+//---------------------------------------------------//
+
+type jsonbExpressionWrapper struct {
+	jsonbInterfaceImpl
+	Expression
+}
+
+func newJsonbExpressionWrap(expression Expression) JsonbExpression {
+	jsonbExpressionWrap := &jsonbExpressionWrapper{Expression: expression}
+	jsonbExpressionWrap.jsonbInterfaceImpl.root = jsonbExpressionWrap
+	expression.setRoot(jsonbExpressionWrap)
+	return jsonbExpressionWrap
+}
+
+// JsonbExp is jsonb expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as jsonb expression.
+// Does not add sql cast to generated sql builder output.
+func JsonbExp(expression Expression) JsonbExpression {
+	return newJsonbExpressionWrap(expression)
+}
@@ -0,0 +1,76 @@
+package jet
+
+import "testing"
+
+var (
+	table1ColJson   = JsonColumn("col_json")
+	table1ColPgJson = PgJsonColumn("col_json")
+	table1ColJsonb  = JsonbColumn("col_jsonb")
+	table2ColJsonb  = JsonbColumn("col_jsonb")
+)
+
+func init() {
+	table1ColJson.setTableName("table1")
+	table1ColPgJson.setTableName("table1")
+	table1ColJsonb.setTableName("table1")
+	table2ColJsonb.setTableName("table2")
+}
+
+func TestJsonExpressionGET(t *testing.T) {
+	assertClauseSerialize(t, table1ColJson.GET(String("name")), "(table1.col_json -> $1)", "name")
+	assertClauseSerialize(t, table1ColJson.GET(Int(2)).GET(String("name")), "((table1.col_json -> $1) -> $2)", int64(2), "name")
+	assertClauseSerialize(t, table1ColJson.GET_TEXT(String("name")).EQ(String("John")),
+		"((table1.col_json ->> $1) = $2)", "name", "John")
+}
+
+func TestPgJsonExpressionGET_PATH(t *testing.T) {
+	assertClauseSerialize(t, table1ColPgJson.GET(String("a")).GET_PATH(table1ColStringArray),
+		"((table1.col_json -> $1) #> table1.col_array_string)", "a")
+	assertClauseSerialize(t, table1ColPgJson.GET_PATH_TEXT(table1ColStringArray).EQ(String("John")),
+		"((table1.col_json #>> table1.col_array_string) = $1)", "John")
+}
+
+func TestJsonbExpressionComparison(t *testing.T) {
+	assertClauseSerialize(t, table1ColJsonb.EQ(table2ColJsonb), "(table1.col_jsonb = table2.col_jsonb)")
+	assertClauseSerialize(t, table1ColJsonb.NOT_EQ(table2ColJsonb), "(table1.col_jsonb != table2.col_jsonb)")
+	assertClauseSerialize(t, table1ColJsonb.IS_DISTINCT_FROM(table2ColJsonb), "(table1.col_jsonb IS DISTINCT FROM table2.col_jsonb)")
+	assertClauseSerialize(t, table1ColJsonb.IS_NOT_DISTINCT_FROM(table2ColJsonb), "(table1.col_jsonb IS NOT DISTINCT FROM table2.col_jsonb)")
+}
+
+func TestJsonbExpressionGET(t *testing.T) {
+	assertClauseSerialize(t, table1ColJsonb.GET(String("name")).EQ(table2ColJsonb), "((table1.col_jsonb -> $1) = table2.col_jsonb)", "name")
+	assertClauseSerialize(t, table1ColJsonb.GET_TEXT(Int(1)), "(table1.col_jsonb ->> $1)", int64(1))
+	assertClauseSerialize(t, table1ColJsonb.GET_PATH(table1ColStringArray), "(table1.col_jsonb #> table1.col_array_string)")
+	assertClauseSerialize(t, table1ColJsonb.GET_PATH_TEXT(table1ColStringArray), "(table1.col_jsonb #>> table1.col_array_string)")
+}
+
+func TestJsonbExpressionContainment(t *testing.T) {
+	assertClauseSerialize(t, table1ColJsonb.CONTAINS(table2ColJsonb), "(table1.col_jsonb @> table2.col_jsonb)")
+	assertClauseSerialize(t, table1ColJsonb.IS_CONTAINED_BY(table2ColJsonb), "(table1.col_jsonb <@ table2.col_jsonb)")
+}
+
+func TestJsonbExpressionKeys(t *testing.T) {
+	assertClauseSerialize(t, table1ColJsonb.HAS_KEY(String("name")), "(table1.col_jsonb ? $1)", "name")
+	assertClauseSerialize(t, table1ColJsonb.HAS_ANY_KEY(table1ColStringArray), "(table1.col_jsonb ?| table1.col_array_string)")
+	assertClauseSerialize(t, table1ColJsonb.HAS_ALL_KEYS(table1ColStringArray), "(table1.col_jsonb ?& table1.col_array_string)")
+}
+
+func TestJsonbExpressionModification(t *testing.T) {
+	assertClauseSerialize(t, table1ColJsonb.CONCAT(table2ColJsonb), "(table1.col_jsonb || table2.col_jsonb)")
+	assertClauseSerialize(t, table1ColJsonb.DELETE(String("name")), "(table1.col_jsonb - $1)", "name")
+	assertClauseSerialize(t, table1ColJsonb.DELETE_PATH(table1ColStringArray), "(table1.col_jsonb #- table1.col_array_string)")
+}
+
+func TestJsonbExpressionPath(t *testing.T) {
+	assertClauseSerialize(t, table1ColJsonb.PATH_EXISTS(String("$.a[*] ? (@ > 2)")), "(table1.col_jsonb @? $1)", "$.a[*] ? (@ > 2)")
+	assertClauseSerialize(t, table1ColJsonb.PATH_MATCH(String("$.a[*] > 2")), "(table1.col_jsonb @@ $1)", "$.a[*] > 2")
+}
+
+func TestJsonColumnFrom(t *testing.T) {
+	jsonColumn := table1ColJson.From(subQuery)
+	assertClauseSerialize(t, jsonColumn, `sub_query."table1.col_json"`)
+	assertProjectionSerialize(t, jsonColumn, `sub_query."table1.col_json" AS "table1.col_json"`)
+
+	jsonbColumn := table1ColJsonb.From(subQuery)
+	assertClauseSerialize(t, jsonbColumn.GET(String("a")), `(sub_query."table1.col_jsonb" -> $1)`, "a")
+}
@@ -0,0 +1,139 @@
+package jet
+
+// JsonTableColumn is a column definition of the JSON_TABLE function
+type JsonTableColumn interface {
+	Serializer
+	tableColumns() []ColumnExpression
+}
+
+type jsonTableColumnImpl struct {
+	column  ColumnExpression
+	sqlType string
+	path    string
+	exists  bool
+}
+
+// NewJsonTableColumn creates JSON_TABLE column of sqlType, which value is extracted from the JSON
+// document using path. If exists is true, column will contain 1 if any data is present at the path location.
+func NewJsonTableColumn(column ColumnExpression, sqlType string, path string, exists bool) JsonTableColumn {
+	return jsonTableColumnImpl{
+		column:  column,
+		sqlType: sqlType,
+		path:    path,
+		exists:  exists,
+	}
+}
+
+func (j jsonTableColumnImpl) tableColumns() []ColumnExpression {
+	return []ColumnExpression{j.column}
+}
+
+func (j jsonTableColumnImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteIdentifier(j.column.Name())
+	out.WriteString(j.sqlType)
+
+	if j.exists {
+		out.WriteString("EXISTS")
+	}
+
+	out.WriteString("PATH")
+	out.insertConstantArgument(j.path)
+}
+
+type jsonTableOrdinalityColumn struct {
+	column ColumnExpression
+}
+
+// NewJsonTableOrdinalityColumn creates JSON_TABLE column that enumerates rows, starting from 1
+func NewJsonTableOrdinalityColumn(column ColumnExpression) JsonTableColumn {
+	return jsonTableOrdinalityColumn{column: column}
+}
+
+func (j jsonTableOrdinalityColumn) tableColumns() []ColumnExpression {
+	return []ColumnExpression{j.column}
+}
+
+func (j jsonTableOrdinalityColumn) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteIdentifier(j.column.Name())
+	out.WriteString("FOR ORDINALITY")
+}
+
+type jsonTableNestedPath struct {
+	path    string
+	columns []JsonTableColumn
+}
+
+// NewJsonTableNestedPath flattens nested objects or arrays in the JSON data into a single row along with
+// the JSON values from the parent object or array.
+func NewJsonTableNestedPath(path string, columns []JsonTableColumn) JsonTableColumn {
+	return jsonTableNestedPath{
+		path:    path,
+		columns: columns,
+	}
+}
+
+func (j jsonTableNestedPath) tableColumns() []ColumnExpression {
+	return jsonTableColumns(j.columns)
+}
+
+func (j jsonTableNestedPath) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteString("NESTED PATH")
+	out.insertConstantArgument(j.path)
+	serializeJsonTableColumns(statement, out, j.columns)
+}
+
+type jsonTableSerializer struct {
+	expression Expression
+	path       string
+	columns    []JsonTableColumn
+}
+
+func (j jsonTableSerializer) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteString("JSON_TABLE(")
+	j.expression.serialize(statement, out, NoWrap)
+	out.WriteString(", ")
+	out.insertConstantArgument(j.path)
+	serializeJsonTableColumns(statement, out, j.columns)
+	out.WriteString(")")
+}
+
+// NewJsonTable creates new JSON_TABLE table function with the alias
+func NewJsonTable(expression Expression, path string, columns []JsonTableColumn, alias string) SelectTable {
+	if len(columns) == 0 {
+		panic("jet: JSON_TABLE requires at least one column")
+	}
+
+	return NewTableFunction(
+		jsonTableSerializer{
+			expression: expression,
+			path:       path,
+			columns:    columns,
+		},
+		alias,
+		jsonTableColumns(columns),
+	)
+}
+
+func serializeJsonTableColumns(statement StatementType, out *SQLBuilder, columns []JsonTableColumn) {
+	out.WriteString("COLUMNS(")
+
+	for i, column := range columns {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+
+		column.serialize(statement, out)
+	}
+
+	out.WriteString(")")
+}
+
+func jsonTableColumns(columns []JsonTableColumn) []ColumnExpression {
+	var ret []ColumnExpression
+
+	for _, column := range columns {
+		ret = append(ret, column.tableColumns()...)
+	}
+
+	return ret
+}
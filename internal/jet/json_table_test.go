@@ -0,0 +1,29 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonTable(t *testing.T) {
+	id := IntegerColumn("id")
+	tag := StringColumn("tag")
+
+	jsonTable := NewJsonTable(table1ColJson, "$[*]", []JsonTableColumn{
+		NewJsonTableColumn(id, "INT", "$.id", false),
+		NewJsonTableNestedPath("$.tags[*]", []JsonTableColumn{
+			NewJsonTableColumn(tag, "TEXT", "$", false),
+		}),
+	}, "jt")
+
+	assertClauseSerialize(t, jsonTable,
+		`JSON_TABLE(table1.col_json, '$[*]' COLUMNS(id INT PATH '$.id', NESTED PATH '$.tags[*]' COLUMNS(tag TEXT PATH '$'))) AS jt`)
+	assertClauseSerialize(t, id, `jt.id`)
+	assertProjectionSerialize(t, tag, `jt.tag AS "tag"`)
+	require.Len(t, jsonTable.AllColumns(), 2)
+
+	require.PanicsWithValue(t, "jet: JSON_TABLE requires at least one column", func() {
+		NewJsonTable(table1ColJson, "$[*]", nil, "jt")
+	})
+}
@@ -260,6 +260,31 @@ func RawBlob(raw string, namedArgs ...map[string]interface{}) BlobExpression {
 	return BlobExp(Raw(raw, namedArgs...))
 }
 
+// RawJson helper that for json expressions
+func RawJson(raw string, namedArgs ...map[string]interface{}) JsonExpression {
+	return JsonExp(Raw(raw, namedArgs...))
+}
+
+// RawPgJson helper that for PostgreSQL json expressions
+func RawPgJson(raw string, namedArgs ...map[string]interface{}) PgJsonExpression {
+	return PgJsonExp(Raw(raw, namedArgs...))
+}
+
+// RawJsonb helper that for jsonb expressions
+func RawJsonb(raw string, namedArgs ...map[string]interface{}) JsonbExpression {
+	return JsonbExp(Raw(raw, namedArgs...))
+}
+
+// RawTsVector helper that for tsvector expressions
+func RawTsVector(raw string, namedArgs ...map[string]interface{}) TsVectorExpression {
+	return TsVectorExp(Raw(raw, namedArgs...))
+}
+
+// RawTsQuery helper that for tsquery expressions
+func RawTsQuery(raw string, namedArgs ...map[string]interface{}) TsQueryExpression {
+	return TsQueryExp(Raw(raw, namedArgs...))
+}
+
 // RawRange helper that for range expressions
 func RawRange[T Expression](raw string, namedArgs ...map[string]interface{}) Range[T] {
 	return RangeExp[T](Raw(raw, namedArgs...))
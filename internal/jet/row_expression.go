@@ -99,3 +99,69 @@ func RowExp(expression Expression) RowExpression {
 	rowExpressionWrap.rowInterfaceImpl.root = &rowExpressionWrap
 	return &rowExpressionWrap
 }
+
+//------------------------------------------------------//
+
+// ColumnRow is interface of SQL composite (row) type columns.
+type ColumnRow interface {
+	RowExpression
+	Column
+
+	From(subQuery SelectTable) ColumnRow
+	SET(rowExp RowExpression) ColumnAssigment
+}
+
+type rowColumnImpl struct {
+	rowInterfaceImpl
+	*ColumnExpressionImpl
+}
+
+func (r *rowColumnImpl) fromImpl(subQuery SelectTable) Projection {
+	return r.From(subQuery)
+}
+
+func (r *rowColumnImpl) From(subQuery SelectTable) ColumnRow {
+	newRowColumn := RowColumn(r.name)
+	newRowColumn.setTableName(r.tableName)
+	newRowColumn.setSubQuery(subQuery)
+
+	return newRowColumn
+}
+
+func (r *rowColumnImpl) SET(rowExp RowExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:   r,
+		toAssign: rowExp,
+	}
+}
+
+// RowColumn creates named composite (row) type column.
+func RowColumn(name string) ColumnRow {
+	rowColumn := &rowColumnImpl{}
+	rowColumn.rowInterfaceImpl.root = rowColumn
+	rowColumn.ColumnExpressionImpl = NewColumnImpl(name, "", rowColumn)
+
+	return rowColumn
+}
+
+//------------------------------------------------------//
+
+type rowFieldSerializer struct {
+	row       Expression
+	fieldName string
+}
+
+func (r *rowFieldSerializer) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteString("(")
+	r.row.serialize(statement, out, NoWrap)
+	out.WriteString(").")
+	out.WriteIdentifier(r.fieldName)
+}
+
+// RowField returns the field of the composite (row) value, serialized as '(row).field'.
+func RowField(row RowExpression, fieldName string) Expression {
+	return newExpression(&rowFieldSerializer{
+		row:       row,
+		fieldName: fieldName,
+	})
+}
@@ -32,10 +32,16 @@ const (
 	InsertStatementType        StatementType = "INSERT"
 	UpdateStatementType        StatementType = "UPDATE"
 	DeleteStatementType        StatementType = "DELETE"
+	MergeStatementType         StatementType = "MERGE"
 	SetStatementType           StatementType = "SET"
 	LockStatementType          StatementType = "LOCK"
 	UnLockStatementType        StatementType = "UNLOCK"
 	WithStatementType          StatementType = "WITH"
+	CreateTableStatementType   StatementType = "CREATE_TABLE"
+	AlterTableStatementType    StatementType = "ALTER_TABLE"
+	DropTableStatementType     StatementType = "DROP_TABLE"
+	CreateIndexStatementType   StatementType = "CREATE_INDEX"
+	DropIndexStatementType     StatementType = "DROP_INDEX"
 )
 
 // Serializer interface
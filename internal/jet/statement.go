@@ -192,7 +192,7 @@ func (s *statementInterfaceImpl) Rows(ctx context.Context, db qrm.Queryable) (*R
 		return nil, err
 	}
 
-	scanContext, err := qrm.NewScanContext(rows)
+	scanContext, err := qrm.NewScanContextWithConfig(rows, qrm.ContextConfig(ctx))
 
 	if err != nil {
 		return nil, err
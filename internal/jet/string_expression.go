@@ -133,6 +133,20 @@ func newStringExpressionWrap(expression Expression) StringExpression {
 	return stringExpressionWrap
 }
 
+// EnumExpression is interface for SQL enum type expressions. Enum expression is a string expression, so any
+// string expression, for instance generated enum value, can be used as enum expression. Distinct interface
+// allows typed arrays of enum values.
+type EnumExpression interface {
+	StringExpression
+}
+
+// EnumExp is enum expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as enum expression.
+// Does not add sql cast to generated sql builder output.
+func EnumExp(expression Expression) EnumExpression {
+	return newStringExpressionWrap(expression)
+}
+
 // StringExp is string expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as string expression.
 // Does not add sql cast to generated sql builder output.
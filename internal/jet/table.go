@@ -34,11 +34,31 @@ func NewTable(schemaName, name, alias string, columns ...ColumnExpression) Seria
 
 	for _, c := range columns {
 		c.setTableName(columnTableName)
+		c.setTable(schemaName, name)
 	}
 
 	return &t
 }
 
+// NewVirtualTable creates new virtual table with schema Name, table Name, list of columns and list of hidden columns.
+// Hidden columns can be used in expressions, but are not part of the table column list.
+func NewVirtualTable(schemaName, name, alias string, columns []ColumnExpression, hiddenColumns ...ColumnExpression) SerializerTable {
+	t := NewTable(schemaName, name, alias, columns...)
+
+	columnTableName := name
+
+	if alias != "" {
+		columnTableName = alias
+	}
+
+	for _, c := range hiddenColumns {
+		c.setTableName(columnTableName)
+		c.setTable(schemaName, name)
+	}
+
+	return t
+}
+
 type tableImpl struct {
 	schemaName string
 	name       string
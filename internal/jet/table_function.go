@@ -0,0 +1,43 @@
+package jet
+
+// tableFunctionImpl is a table-valued function (JSON_TABLE, json_each, etc...) that can be used
+// in the FROM clause, just like a regular table.
+type tableFunctionImpl struct {
+	function Serializer
+	alias    string
+	columns  []ColumnExpression
+}
+
+// NewTableFunction creates new table-valued function with alias and the list of columns function returns.
+func NewTableFunction(function Serializer, alias string, columns []ColumnExpression) SelectTable {
+	tableFunction := tableFunctionImpl{
+		function: function,
+		alias:    alias,
+		columns:  columns,
+	}
+
+	for _, column := range columns {
+		column.setSubQuery(tableFunction)
+	}
+
+	return tableFunction
+}
+
+func (t tableFunctionImpl) projections() ProjectionList {
+	return ColumnListToProjectionList(t.columns)
+}
+
+func (t tableFunctionImpl) Alias() string {
+	return t.alias
+}
+
+func (t tableFunctionImpl) AllColumns() ProjectionList {
+	return ColumnListToProjectionList(t.columns)
+}
+
+func (t tableFunctionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	t.function.serialize(statement, out, NoWrap)
+
+	out.WriteString("AS")
+	out.WriteIdentifier(t.alias)
+}
@@ -0,0 +1,150 @@
+package jet
+
+// TextSearchMatchOperator is full text search match operator
+const TextSearchMatchOperator = "@@"
+
+// TsVectorExpression interface
+type TsVectorExpression interface {
+	Expression
+	isTsVector()
+
+	EQ(rhs TsVectorExpression) BoolExpression
+	NOT_EQ(rhs TsVectorExpression) BoolExpression
+
+	// MATCH checks if this document matches the text search query (tsvector @@ tsquery)
+	MATCH(query TsQueryExpression) BoolExpression
+	// CONCAT concatenates two text search documents (tsvector || tsvector)
+	CONCAT(rhs TsVectorExpression) TsVectorExpression
+}
+
+type tsVectorInterfaceImpl struct {
+	root TsVectorExpression
+}
+
+func (t *tsVectorInterfaceImpl) isTsVector() {}
+
+func (t *tsVectorInterfaceImpl) EQ(rhs TsVectorExpression) BoolExpression {
+	return Eq(t.root, rhs)
+}
+
+func (t *tsVectorInterfaceImpl) NOT_EQ(rhs TsVectorExpression) BoolExpression {
+	return NotEq(t.root, rhs)
+}
+
+func (t *tsVectorInterfaceImpl) MATCH(query TsQueryExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(t.root, query, TextSearchMatchOperator)
+}
+
+func (t *tsVectorInterfaceImpl) CONCAT(rhs TsVectorExpression) TsVectorExpression {
+	return TsVectorExp(NewBinaryOperatorExpression(t.root, rhs, StringConcatOperator))
+}
+
+//---------------------------------------------------//
+
+type tsVectorExpressionWrapper struct {
+	tsVectorInterfaceImpl
+	Expression
+}
+
+func newTsVectorExpressionWrap(expression Expression) TsVectorExpression {
+	tsVectorExpressionWrap := &tsVectorExpressionWrapper{Expression: expression}
+	tsVectorExpressionWrap.tsVectorInterfaceImpl.root = tsVectorExpressionWrap
+	expression.setRoot(tsVectorExpressionWrap)
+	return tsVectorExpressionWrap
+}
+
+// TsVectorExp is tsvector expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as tsvector expression.
+// Does not add sql cast to generated sql builder output.
+func TsVectorExp(expression Expression) TsVectorExpression {
+	return newTsVectorExpressionWrap(expression)
+}
+
+//---------------------------------------------------//
+
+// TsQueryExpression interface
+type TsQueryExpression interface {
+	Expression
+	isTsQuery()
+
+	EQ(rhs TsQueryExpression) BoolExpression
+	NOT_EQ(rhs TsQueryExpression) BoolExpression
+
+	// MATCH checks if the text search document matches this query (tsquery @@ tsvector)
+	MATCH(document TsVectorExpression) BoolExpression
+	// AND combines two queries, so that both have to match (tsquery && tsquery)
+	AND(rhs TsQueryExpression) TsQueryExpression
+	// OR combines two queries, so that either has to match (tsquery || tsquery)
+	OR(rhs TsQueryExpression) TsQueryExpression
+	// NOT negates this query (!! tsquery)
+	NOT() TsQueryExpression
+	// FOLLOWED_BY creates query that matches this query followed by rhs query (tsquery <-> tsquery)
+	FOLLOWED_BY(rhs TsQueryExpression) TsQueryExpression
+	// CONTAINS checks if this query contains rhs query (tsquery @> tsquery)
+	CONTAINS(rhs TsQueryExpression) BoolExpression
+	// IS_CONTAINED_BY checks if this query is contained by rhs query (tsquery <@ tsquery)
+	IS_CONTAINED_BY(rhs TsQueryExpression) BoolExpression
+}
+
+type tsQueryInterfaceImpl struct {
+	root TsQueryExpression
+}
+
+func (t *tsQueryInterfaceImpl) isTsQuery() {}
+
+func (t *tsQueryInterfaceImpl) EQ(rhs TsQueryExpression) BoolExpression {
+	return Eq(t.root, rhs)
+}
+
+func (t *tsQueryInterfaceImpl) NOT_EQ(rhs TsQueryExpression) BoolExpression {
+	return NotEq(t.root, rhs)
+}
+
+func (t *tsQueryInterfaceImpl) MATCH(document TsVectorExpression) BoolExpression {
+	return newBinaryBoolOperatorExpression(t.root, document, TextSearchMatchOperator)
+}
+
+func (t *tsQueryInterfaceImpl) AND(rhs TsQueryExpression) TsQueryExpression {
+	return TsQueryExp(NewBinaryOperatorExpression(t.root, rhs, "&&"))
+}
+
+func (t *tsQueryInterfaceImpl) OR(rhs TsQueryExpression) TsQueryExpression {
+	return TsQueryExp(NewBinaryOperatorExpression(t.root, rhs, StringConcatOperator))
+}
+
+func (t *tsQueryInterfaceImpl) NOT() TsQueryExpression {
+	return TsQueryExp(newPrefixOperatorExpression(t.root, "!!"))
+}
+
+func (t *tsQueryInterfaceImpl) FOLLOWED_BY(rhs TsQueryExpression) TsQueryExpression {
+	return TsQueryExp(NewBinaryOperatorExpression(t.root, rhs, "<->"))
+}
+
+func (t *tsQueryInterfaceImpl) CONTAINS(rhs TsQueryExpression) BoolExpression {
+	return Contains(t.root, rhs)
+}
+
+func (t *tsQueryInterfaceImpl) IS_CONTAINED_BY(rhs TsQueryExpression) BoolExpression {
+	return IsContainedBy(t.root, rhs)
+}
+
+//---------------------------------------------------//
+
+type tsQueryExpressionWrapper struct {
+	tsQueryInterfaceImpl
+	Expression
+}
+
+func newTsQueryExpressionWrap(expression Expression) TsQueryExpression {
+	tsQueryExpressionWrap := &tsQueryExpressionWrapper{Expression: expression}
+	tsQueryExpressionWrap.tsQueryInterfaceImpl.root = tsQueryExpressionWrap
+	expression.setRoot(tsQueryExpressionWrap)
+	return tsQueryExpressionWrap
+}
+
+// TsQueryExp is tsquery expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as tsquery expression.
+// Does not add sql cast to generated sql builder output.
+func TsQueryExp(expression Expression) TsQueryExpression {
+	return newTsQueryExpressionWrap(expression)
+}
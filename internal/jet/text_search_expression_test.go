@@ -0,0 +1,36 @@
+package jet
+
+import "testing"
+
+var (
+	table1ColTsVector = TsVectorColumn("col_tsvector")
+	table2ColTsVector = TsVectorColumn("col_tsvector")
+	table2ColTsQuery  = TsQueryColumn("col_tsquery")
+)
+
+func init() {
+	table1ColTsVector.setTableName("table1")
+	table2ColTsVector.setTableName("table2")
+	table2ColTsQuery.setTableName("table2")
+}
+
+func TestTsVectorExpression(t *testing.T) {
+	assertClauseSerialize(t, table1ColTsVector.MATCH(table2ColTsQuery), "(table1.col_tsvector @@ table2.col_tsquery)")
+	assertClauseSerialize(t, table1ColTsVector.CONCAT(table2ColTsVector), "(table1.col_tsvector || table2.col_tsvector)")
+	assertClauseSerialize(t, table1ColTsVector.EQ(table2ColTsVector), "(table1.col_tsvector = table2.col_tsvector)")
+	assertClauseSerialize(t, table1ColTsVector.NOT_EQ(table2ColTsVector), "(table1.col_tsvector != table2.col_tsvector)")
+}
+
+func TestTsQueryExpression(t *testing.T) {
+	query := TsQueryExp(Func("to_tsquery", String("cat")))
+
+	assertClauseSerialize(t, table2ColTsQuery.MATCH(table1ColTsVector), "(table2.col_tsquery @@ table1.col_tsvector)")
+	assertClauseSerialize(t, table2ColTsQuery.AND(query), "(table2.col_tsquery && to_tsquery($1))", "cat")
+	assertClauseSerialize(t, table2ColTsQuery.OR(query), "(table2.col_tsquery || to_tsquery($1))", "cat")
+	assertClauseSerialize(t, table2ColTsQuery.FOLLOWED_BY(query), "(table2.col_tsquery <-> to_tsquery($1))", "cat")
+	assertClauseSerialize(t, table2ColTsQuery.NOT(), "(!! table2.col_tsquery)")
+	assertClauseSerialize(t, table2ColTsQuery.NOT().AND(query).MATCH(table1ColTsVector),
+		"(((!! table2.col_tsquery) && to_tsquery($1)) @@ table1.col_tsvector)", "cat")
+	assertClauseSerialize(t, table2ColTsQuery.CONTAINS(query), "(table2.col_tsquery @> to_tsquery($1))", "cat")
+	assertClauseSerialize(t, table2ColTsQuery.IS_CONTAINED_BY(query), "(table2.col_tsquery <@ to_tsquery($1))", "cat")
+}
@@ -148,3 +148,35 @@ func (f *boolWindowExpressionImpl) OVER(window ...Window) BoolExpression {
 func (f *boolWindowExpressionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 	f.commonWindowImpl.serialize(statement, out, FallTrough(options)...)
 }
+
+// ------------------------------------------------
+
+type stringWindowExpression interface {
+	StringExpression
+	OVER(window ...Window) StringExpression
+}
+
+func newStringWindowExpression(stringExp StringExpression) stringWindowExpression {
+	newExp := &stringWindowExpressionImpl{
+		StringExpression: stringExp,
+	}
+
+	newExp.commonWindowImpl.expression = stringExp
+	stringExp.setRoot(newExp)
+
+	return newExp
+}
+
+type stringWindowExpressionImpl struct {
+	StringExpression
+	commonWindowImpl
+}
+
+func (f *stringWindowExpressionImpl) OVER(window ...Window) StringExpression {
+	f.commonWindowImpl.over(window...)
+	return f
+}
+
+func (f *stringWindowExpressionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	f.commonWindowImpl.serialize(statement, out, FallTrough(options)...)
+}
@@ -0,0 +1,218 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines shown around each change
+const contextLines = 3
+
+type opKind byte
+
+const (
+	equalOp  opKind = ' '
+	deleteOp opKind = '-'
+	insertOp opKind = '+'
+)
+
+type op struct {
+	kind opKind
+	line string
+}
+
+// Unified returns unified diff of oldText and newText, with oldName and newName used as file names in the diff
+// header. If texts are equal, empty string is returned.
+func Unified(oldName, newName string, oldText, newText []byte) string {
+	if string(oldText) == string(newText) {
+		return ""
+	}
+
+	ops := lineOps(splitLines(string(oldText)), splitLines(string(newText)))
+
+	var ret strings.Builder
+
+	fmt.Fprintf(&ret, "--- %s\n+++ %s\n", oldName, newName)
+
+	for _, h := range hunks(ops) {
+		ret.WriteString(h)
+	}
+
+	return ret.String()
+}
+
+// splitLines splits text into lines, keeping the new line characters
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(text, "\n")
+
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// lineOps returns the shortest edit script transforming a into b, using Myers diff algorithm
+func lineOps(a, b []string) []op {
+	n, m := len(a), len(b)
+	maxD := n + m
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+
+	// trace[d] holds v, for diagonals -d..d, before the d-th step
+	var trace [][]int
+
+search:
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	var ops []op
+
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		prev := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && prev[k-1+d] < prev[k+1+d]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		prevX := 0
+		if d > 0 {
+			prevX = prev[prevK+d]
+		}
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, op{equalOp, a[x]})
+		}
+
+		if d == 0 {
+			break
+		}
+
+		if x == prevX {
+			ops = append(ops, op{insertOp, b[prevY]})
+		} else {
+			ops = append(ops, op{deleteOp, a[prevX]})
+		}
+
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	return ops
+}
+
+// hunks groups edit script changes, together with the surrounding context lines, into the unified diff hunks
+func hunks(ops []op) []string {
+	var ret []string
+
+	for start := 0; start < len(ops); {
+		firstChange := start
+		for firstChange < len(ops) && ops[firstChange].kind == equalOp {
+			firstChange++
+		}
+
+		if firstChange == len(ops) {
+			break
+		}
+
+		// extend the hunk while the next change is close enough to share the context lines
+		end := firstChange
+		for i := firstChange; i < len(ops) && i-end <= 2*contextLines; i++ {
+			if ops[i].kind != equalOp {
+				end = i + 1
+			}
+		}
+
+		hunkStart := max(start, firstChange-contextLines)
+		hunkEnd := min(len(ops), end+contextLines)
+
+		ret = append(ret, formatHunk(ops, hunkStart, hunkEnd))
+
+		start = hunkEnd
+	}
+
+	return ret
+}
+
+func formatHunk(ops []op, from, to int) string {
+	oldStart, newStart := 0, 0
+	for _, o := range ops[:from] {
+		if o.kind != insertOp {
+			oldStart++
+		}
+		if o.kind != deleteOp {
+			newStart++
+		}
+	}
+
+	var body strings.Builder
+	oldCount, newCount := 0, 0
+
+	for _, o := range ops[from:to] {
+		if o.kind != insertOp {
+			oldCount++
+		}
+		if o.kind != deleteOp {
+			newCount++
+		}
+
+		body.WriteByte(byte(o.kind))
+		body.WriteString(o.line)
+
+		if !strings.HasSuffix(o.line, "\n") {
+			body.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+
+	return fmt.Sprintf("@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount)) + body.String()
+}
+
+// hunkRange formats hunk line range. Empty ranges start at the line before the change.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
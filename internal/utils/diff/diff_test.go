@@ -0,0 +1,62 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnified(t *testing.T) {
+	require.Equal(t, Unified("a", "b", []byte("x\ny\n"), []byte("x\ny\n")), "")
+
+	require.Equal(t, Unified("a", "b", []byte("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n"),
+		[]byte("1\n2\ntwo\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n15\n16\n")), `--- a
++++ b
+@@ -1,6 +1,6 @@
+ 1
+ 2
+-3
++two
+ 4
+ 5
+ 6
+@@ -11,5 +11,5 @@
+ 11
+ 12
+ 13
+-14
+ 15
++16
+`)
+
+	require.Equal(t, Unified("a", "b", []byte("1\n2\n3\n4\n5\n6\n7\n8\n"), []byte("1\none\n3\n4\n5\n6\n7\neight\n")), `--- a
++++ b
+@@ -1,8 +1,8 @@
+ 1
+-2
++one
+ 3
+ 4
+ 5
+ 6
+ 7
+-8
++eight
+`)
+}
+
+func TestUnifiedAddedAndRemovedFile(t *testing.T) {
+	require.Equal(t, Unified("/dev/null", "b", nil, []byte("x\ny")), `--- /dev/null
++++ b
+@@ -0,0 +1,2 @@
++x
++y
+\ No newline at end of file
+`)
+
+	require.Equal(t, Unified("a", "/dev/null", []byte("x\n"), nil), `--- a
++++ /dev/null
+@@ -1 +0,0 @@
+-x
+`)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"go/format"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 )
@@ -56,3 +57,45 @@ func EnsureDirPathExist(dirPath string) error {
 
 	return nil
 }
+
+// GoImportPath returns import path of the go package at dirPath, using the module path from the go.mod file found
+// in the dirPath or in one of its parent directories. Package directory does not have to exist.
+func GoImportPath(dirPath string) (string, error) {
+	packageDir, err := filepath.Abs(dirPath)
+	if err != nil {
+		return "", err
+	}
+
+	for moduleDir := packageDir; ; moduleDir = filepath.Dir(moduleDir) {
+		goMod, err := os.ReadFile(filepath.Join(moduleDir, "go.mod")) // #nosec G304
+		if err == nil {
+			modulePath := goModulePath(goMod)
+			if modulePath == "" {
+				return "", fmt.Errorf("module path not found in %s", filepath.Join(moduleDir, "go.mod"))
+			}
+
+			relPath, err := filepath.Rel(moduleDir, packageDir)
+			if err != nil {
+				return "", err
+			}
+
+			return path.Join(modulePath, filepath.ToSlash(relPath)), nil
+		}
+
+		if filepath.Dir(moduleDir) == moduleDir {
+			return "", fmt.Errorf("go.mod file not found for directory %s", dirPath)
+		}
+	}
+}
+
+func goModulePath(goMod []byte) string {
+	for _, line := range strings.Split(string(goMod), "\n") {
+		fields := strings.Fields(line)
+
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], "\"`")
+		}
+	}
+
+	return ""
+}
@@ -73,3 +73,8 @@ func (c *cast) AS_TIME() TimeExpression {
 func (c *cast) AS_BINARY() BlobExpression {
 	return BlobExp(c.AS("BINARY"))
 }
+
+// AS_JSON casts expression as JSON type
+func (c *cast) AS_JSON() JsonExpression {
+	return JsonExp(c.AS("JSON"))
+}
@@ -15,4 +15,5 @@ func TestCAST(t *testing.T) {
 	assertSerialize(t, CAST(Int(22)).AS_SIGNED(), `CAST(? AS SIGNED)`)
 	assertSerialize(t, CAST(Int(22)).AS_UNSIGNED(), `CAST(? AS UNSIGNED)`)
 	assertSerialize(t, CAST(Int(22)).AS_BINARY(), `CAST(? AS BINARY)`)
+	assertSerialize(t, CAST(String(`{"a": 1}`)).AS_JSON(), `CAST(? AS JSON)`)
 }
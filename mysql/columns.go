@@ -62,3 +62,9 @@ type ColumnTimestamp = jet.ColumnTimestamp
 
 // TimestampColumn creates named timestamp column
 var TimestampColumn = jet.TimestampColumn
+
+// ColumnJson is interface of SQL json columns.
+type ColumnJson = jet.ColumnJson
+
+// JsonColumn creates named json column
+var JsonColumn = jet.JsonColumn
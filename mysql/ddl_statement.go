@@ -0,0 +1,250 @@
+package mysql
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// ColumnDefinition is definition of the table column used in CREATE TABLE and ALTER TABLE statements
+type ColumnDefinition = jet.ColumnDefinition
+
+// TableConstraint is table constraint used in CREATE TABLE and ALTER TABLE statements
+type TableConstraint = jet.TableConstraint
+
+// COLUMN creates definition of the column. If data type is not set with TYPE, default data type for the column type is used.
+//
+//	COLUMN(Film.FilmID).TYPE("INT AUTO_INCREMENT").PRIMARY_KEY()
+var COLUMN = jet.ColumnDef
+
+// PRIMARY_KEY creates PRIMARY KEY table constraint
+var PRIMARY_KEY = jet.PrimaryKey
+
+// UNIQUE creates UNIQUE table constraint
+var UNIQUE = jet.Unique
+
+// CHECK creates CHECK table constraint
+var CHECK = jet.Check
+
+// FOREIGN_KEY creates FOREIGN KEY table constraint
+//
+//	FOREIGN_KEY(Film.LanguageID).REFERENCES(Language.LanguageID)
+var FOREIGN_KEY = jet.ForeignKey
+
+// CONSTRAINT creates named table constraint
+//
+//	CONSTRAINT("film_language_fk").FOREIGN_KEY(Film.LanguageID).REFERENCES(Language.LanguageID)
+var CONSTRAINT = jet.Constraint
+
+// ---------------------------------------------------//
+
+// CreateTableStatement is interface for MySQL CREATE TABLE statement
+type CreateTableStatement interface {
+	Statement
+
+	TEMPORARY() CreateTableStatement
+	IF_NOT_EXISTS() CreateTableStatement
+	COLUMNS(columns ...ColumnDefinition) CreateTableStatement
+	CONSTRAINTS(constraints ...TableConstraint) CreateTableStatement
+}
+
+// CREATE_TABLE creates new CREATE TABLE statement. If column definitions are not set with COLUMNS,
+// all the table columns are created with default data types.
+func CREATE_TABLE(table Table) CreateTableStatement {
+	newCreateTable := &createTableStatementImpl{}
+	newCreateTable.SerializerStatement = jet.NewStatementImpl(Dialect, jet.CreateTableStatementType, newCreateTable,
+		&newCreateTable.CreateTable)
+
+	newCreateTable.CreateTable.Table = table
+
+	return newCreateTable
+}
+
+type createTableStatementImpl struct {
+	jet.SerializerStatement
+
+	CreateTable jet.ClauseCreateTable
+}
+
+func (c *createTableStatementImpl) TEMPORARY() CreateTableStatement {
+	c.CreateTable.Temporary = true
+	return c
+}
+
+func (c *createTableStatementImpl) IF_NOT_EXISTS() CreateTableStatement {
+	c.CreateTable.IfNotExists = true
+	return c
+}
+
+func (c *createTableStatementImpl) COLUMNS(columns ...ColumnDefinition) CreateTableStatement {
+	c.CreateTable.Columns = columns
+	return c
+}
+
+func (c *createTableStatementImpl) CONSTRAINTS(constraints ...TableConstraint) CreateTableStatement {
+	c.CreateTable.Constraints = constraints
+	return c
+}
+
+// ---------------------------------------------------//
+
+// AlterTableStatement is interface for MySQL ALTER TABLE statement
+type AlterTableStatement interface {
+	Statement
+
+	ADD_COLUMN(column ColumnDefinition) AlterTableStatement
+	DROP_COLUMN(column Column) AlterTableStatement
+	MODIFY_COLUMN(column ColumnDefinition) AlterTableStatement
+	RENAME_COLUMN(column Column, newName string) AlterTableStatement
+	RENAME_TO(newTableName string) AlterTableStatement
+	ADD_CONSTRAINT(constraint TableConstraint) AlterTableStatement
+	DROP_CONSTRAINT(name string) AlterTableStatement
+}
+
+// ALTER_TABLE creates new ALTER TABLE statement
+func ALTER_TABLE(table Table) AlterTableStatement {
+	newAlterTable := &alterTableStatementImpl{}
+	newAlterTable.SerializerStatement = jet.NewStatementImpl(Dialect, jet.AlterTableStatementType, newAlterTable,
+		&newAlterTable.AlterTable)
+
+	newAlterTable.AlterTable.Table = table
+
+	return newAlterTable
+}
+
+type alterTableStatementImpl struct {
+	jet.SerializerStatement
+
+	AlterTable jet.ClauseAlterTable
+}
+
+func (a *alterTableStatementImpl) addAction(action jet.Serializer) AlterTableStatement {
+	a.AlterTable.Actions = append(a.AlterTable.Actions, action)
+	return a
+}
+
+func (a *alterTableStatementImpl) ADD_COLUMN(column ColumnDefinition) AlterTableStatement {
+	return a.addAction(jet.AddColumn(column))
+}
+
+func (a *alterTableStatementImpl) DROP_COLUMN(column Column) AlterTableStatement {
+	return a.addAction(jet.DropColumn(column))
+}
+
+func (a *alterTableStatementImpl) MODIFY_COLUMN(column ColumnDefinition) AlterTableStatement {
+	return a.addAction(jet.ModifyColumn(column))
+}
+
+func (a *alterTableStatementImpl) RENAME_COLUMN(column Column, newName string) AlterTableStatement {
+	return a.addAction(jet.RenameColumn(column, newName))
+}
+
+func (a *alterTableStatementImpl) RENAME_TO(newTableName string) AlterTableStatement {
+	return a.addAction(jet.RenameTo(newTableName))
+}
+
+func (a *alterTableStatementImpl) ADD_CONSTRAINT(constraint TableConstraint) AlterTableStatement {
+	return a.addAction(jet.AddConstraint(constraint))
+}
+
+func (a *alterTableStatementImpl) DROP_CONSTRAINT(name string) AlterTableStatement {
+	return a.addAction(jet.DropConstraint(name))
+}
+
+// ---------------------------------------------------//
+
+// DropTableStatement is interface for MySQL DROP TABLE statement
+type DropTableStatement interface {
+	Statement
+
+	IF_EXISTS() DropTableStatement
+}
+
+// DROP_TABLE creates new DROP TABLE statement
+func DROP_TABLE(table Table, tables ...Table) DropTableStatement {
+	newDropTable := &dropTableStatementImpl{}
+	newDropTable.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DropTableStatementType, newDropTable,
+		&newDropTable.DropTable)
+
+	for _, t := range append([]Table{table}, tables...) {
+		newDropTable.DropTable.Tables = append(newDropTable.DropTable.Tables, t)
+	}
+
+	return newDropTable
+}
+
+type dropTableStatementImpl struct {
+	jet.SerializerStatement
+
+	DropTable jet.ClauseDropTable
+}
+
+func (d *dropTableStatementImpl) IF_EXISTS() DropTableStatement {
+	d.DropTable.IfExists = true
+	return d
+}
+
+// ---------------------------------------------------//
+
+// CreateIndexStatement is interface for MySQL CREATE INDEX statement
+type CreateIndexStatement interface {
+	Statement
+
+	ON(table Table, columns ...Column) CreateIndexStatement
+}
+
+// CREATE_INDEX creates new CREATE INDEX statement
+//
+//	CREATE_INDEX("film_title_idx").ON(Film, Film.Title)
+func CREATE_INDEX(name string) CreateIndexStatement {
+	return newCreateIndexStatement(name, false)
+}
+
+// CREATE_UNIQUE_INDEX creates new CREATE UNIQUE INDEX statement
+func CREATE_UNIQUE_INDEX(name string) CreateIndexStatement {
+	return newCreateIndexStatement(name, true)
+}
+
+func newCreateIndexStatement(name string, unique bool) CreateIndexStatement {
+	newCreateIndex := &createIndexStatementImpl{}
+	newCreateIndex.SerializerStatement = jet.NewStatementImpl(Dialect, jet.CreateIndexStatementType, newCreateIndex,
+		&newCreateIndex.CreateIndex)
+
+	newCreateIndex.CreateIndex.Name = name
+	newCreateIndex.CreateIndex.Unique = unique
+
+	return newCreateIndex
+}
+
+type createIndexStatementImpl struct {
+	jet.SerializerStatement
+
+	CreateIndex jet.ClauseCreateIndex
+}
+
+func (c *createIndexStatementImpl) ON(table Table, columns ...Column) CreateIndexStatement {
+	c.CreateIndex.Table = table
+	c.CreateIndex.Columns = nil
+
+	for _, column := range columns {
+		c.CreateIndex.Columns = append(c.CreateIndex.Columns, column)
+	}
+
+	return c
+}
+
+// ---------------------------------------------------//
+
+// DROP_INDEX creates new DROP INDEX statement
+func DROP_INDEX(name string, table Table) Statement {
+	newDropIndex := &dropIndexStatementImpl{}
+	newDropIndex.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DropIndexStatementType, newDropIndex,
+		&newDropIndex.DropIndex)
+
+	newDropIndex.DropIndex.Name = name
+	newDropIndex.DropIndex.Table = table
+
+	return newDropIndex
+}
+
+type dropIndexStatementImpl struct {
+	jet.SerializerStatement
+
+	DropIndex jet.ClauseDropIndex
+}
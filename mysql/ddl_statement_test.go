@@ -0,0 +1,74 @@
+package mysql
+
+import "testing"
+
+func TestCreateTable(t *testing.T) {
+	assertStatementSql(t, CREATE_TABLE(table1), `
+CREATE TABLE db.table1 (
+    col1 BIGINT,
+    col_int BIGINT,
+    col_float DOUBLE,
+    col_string TEXT,
+    col3 BIGINT,
+    col_bool BOOLEAN,
+    col_date DATE,
+    col_timestamp DATETIME,
+    col_time TIME,
+    col_json JSON
+);
+`)
+	assertStatementSql(t, CREATE_TABLE(table3).IF_NOT_EXISTS().
+		COLUMNS(
+			COLUMN(table3Col1).TYPE("INT AUTO_INCREMENT").PRIMARY_KEY(),
+			COLUMN(table3ColInt).NOT_NULL().DEFAULT(Int(0)).CHECK(table3ColInt.GT_EQ(Int(0))),
+			COLUMN(table3StrCol).TYPE("VARCHAR(100)").UNIQUE(),
+		).
+		CONSTRAINTS(
+			CONSTRAINT("table3_fk").FOREIGN_KEY(table3ColInt).REFERENCES(table2ColInt),
+		), `
+CREATE TABLE IF NOT EXISTS db.table3 (
+    col1 INT AUTO_INCREMENT PRIMARY KEY,
+    col_int BIGINT NOT NULL DEFAULT 0 CHECK (col_int >= 0),
+    col2 VARCHAR(100) UNIQUE,
+    CONSTRAINT table3_fk FOREIGN KEY (col_int) REFERENCES db.table2 (col_int)
+);
+`)
+}
+
+func TestAlterTable(t *testing.T) {
+	assertStatementSql(t, ALTER_TABLE(table3).
+		ADD_COLUMN(COLUMN(StringColumn("title")).TYPE("VARCHAR(20)").NOT_NULL().DEFAULT(String(""))).
+		MODIFY_COLUMN(COLUMN(table3ColInt).TYPE("INT").NOT_NULL()).
+		DROP_COLUMN(table3StrCol).
+		RENAME_COLUMN(table3Col1, "id").
+		ADD_CONSTRAINT(UNIQUE(table3ColInt)).
+		DROP_CONSTRAINT("table3_fk").
+		RENAME_TO("table4"), `
+ALTER TABLE db.table3
+    ADD COLUMN title VARCHAR(20) NOT NULL DEFAULT '',
+    MODIFY COLUMN col_int INT NOT NULL,
+    DROP COLUMN col2,
+    RENAME COLUMN col1 TO id,
+    ADD UNIQUE (col_int),
+    DROP CONSTRAINT table3_fk,
+    RENAME TO table4;
+`)
+}
+
+func TestDropTable(t *testing.T) {
+	assertStatementSql(t, DROP_TABLE(table1, table2).IF_EXISTS(), `
+DROP TABLE IF EXISTS db.table1, db.table2;
+`)
+}
+
+func TestCreateAndDropIndex(t *testing.T) {
+	assertStatementSql(t, CREATE_INDEX("table3_idx").ON(table3, table3ColInt, table3StrCol), `
+CREATE INDEX table3_idx ON db.table3 (col_int, col2);
+`)
+	assertStatementSql(t, CREATE_UNIQUE_INDEX("table3_idx").ON(table3, table3StrCol), `
+CREATE UNIQUE INDEX table3_idx ON db.table3 (col2);
+`)
+	assertStatementSql(t, DROP_INDEX("table3_idx", table3), `
+DROP INDEX table3_idx ON db.table3;
+`)
+}
@@ -17,6 +17,8 @@ func newDialect() jet.Dialect {
 	operatorSerializeOverrides["/"] = mysqlDivision
 	operatorSerializeOverrides["#"] = mysqlBitXor
 	operatorSerializeOverrides[jet.StringConcatOperator] = mysqlCONCAToperator
+	operatorSerializeOverrides[jet.JsonGetOperator] = mysqlJsonGetOperator
+	operatorSerializeOverrides[jet.JsonGetTextOperator] = mysqlJsonGetTextOperator
 
 	mySQLDialectParams := jet.DialectParams{
 		Name:                       "MySQL",
@@ -51,11 +53,38 @@ func newDialect() jet.Dialect {
 			return expr
 		},
 		RegexpLike: regexpLikeOperator,
+		DataType:   dataType,
 	}
 
 	return jet.NewDialect(mySQLDialectParams)
 }
 
+// dataType returns default MySQL data type for the column type, used by DDL statements
+func dataType(column jet.Column) string {
+	switch column.(type) {
+	case ColumnBool:
+		return "BOOLEAN"
+	case ColumnInteger:
+		return "BIGINT"
+	case ColumnFloat:
+		return "DOUBLE"
+	case ColumnString:
+		return "TEXT"
+	case ColumnBlob:
+		return "BLOB"
+	case ColumnDate:
+		return "DATE"
+	case ColumnTime:
+		return "TIME"
+	case ColumnTimestamp:
+		return "DATETIME"
+	case ColumnJson:
+		return "JSON"
+	}
+
+	return ""
+}
+
 func argumentToString(value any) (string, bool) {
 	switch bindVal := value.(type) {
 	case []byte:
@@ -99,6 +128,34 @@ func mysqlCONCAToperator(expressions ...jet.Serializer) jet.SerializerFunc {
 	}
 }
 
+// mysqlJsonGetOperator serializes json -> path as JSON_EXTRACT(json, path), because MySQL '->' operator
+// accepts only string literal as a path, and jet would serialize path as a query parameter.
+func mysqlJsonGetOperator(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator ->")
+		}
+		out.WriteString("JSON_EXTRACT(")
+
+		jet.Serialize(expressions[0], statement, out, options...)
+
+		out.WriteString(", ")
+
+		jet.Serialize(expressions[1], statement, out, options...)
+
+		out.WriteString(")")
+	}
+}
+
+// mysqlJsonGetTextOperator serializes json ->> path as JSON_UNQUOTE(JSON_EXTRACT(json, path))
+func mysqlJsonGetTextOperator(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		out.WriteString("JSON_UNQUOTE(")
+		mysqlJsonGetOperator(expressions...)(statement, out, options...)
+		out.WriteString(")")
+	}
+}
+
 func mysqlDivision(expressions ...jet.Serializer) jet.SerializerFunc {
 	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 		if len(expressions) < 2 {
@@ -39,6 +39,9 @@ type TimestampExpression = jet.TimestampExpression
 // RowExpression interface
 type RowExpression = jet.RowExpression
 
+// JsonExpression interface
+type JsonExpression = jet.JsonExpression
+
 // BoolExp is bool expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as bool expression.
 // Does not add sql cast to generated sql builder output.
@@ -89,6 +92,11 @@ var TimestampExp = jet.TimestampExp
 // Note: This does not modify the generated SQL builder output by adding a SQL CAST operation.
 var RowExp = jet.RowExp
 
+// JsonExp is json expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as json expression.
+// Does not add sql cast to generated sql builder output.
+var JsonExp = jet.JsonExp
+
 // CustomExpression is used to define custom expressions.
 var CustomExpression = jet.CustomExpression
 
@@ -112,6 +120,7 @@ var (
 	RawTimestamp = jet.RawTimestamp
 	RawDate      = jet.RawDate
 	RawBlob      = jet.RawBlob
+	RawJson      = jet.RawJson
 )
 
 // Func can be used to call custom or unsupported database functions.
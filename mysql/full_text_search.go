@@ -0,0 +1,82 @@
+package mysql
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+type searchModifier string
+
+// List of MySQL full-text search modifiers
+const (
+	IN_NATURAL_LANGUAGE_MODE                      searchModifier = "IN NATURAL LANGUAGE MODE"
+	IN_NATURAL_LANGUAGE_MODE_WITH_QUERY_EXPANSION searchModifier = "IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION"
+	IN_BOOLEAN_MODE                               searchModifier = "IN BOOLEAN MODE"
+	WITH_QUERY_EXPANSION                          searchModifier = "WITH QUERY EXPANSION"
+)
+
+// MATCH starts full-text search over the list of columns. Columns have to be covered by a FULLTEXT index.
+//
+//	MATCH(Film.Title, Film.Description).AGAINST(String("+drama -comedy"), IN_BOOLEAN_MODE)
+func MATCH(column Column, columns ...Column) matchColumns {
+	return matchColumns{
+		columns: append([]Column{column}, columns...),
+	}
+}
+
+type matchColumns struct {
+	columns []Column
+}
+
+// AGAINST sets the search string and optional search modifier of the full-text search.
+func (m matchColumns) AGAINST(search StringExpression, modifier ...searchModifier) matchAgainstExpression {
+	newMatchAgainst := &matchAgainstExpressionImpl{
+		columns: m.columns,
+		search:  search,
+	}
+
+	if len(modifier) > 0 {
+		newMatchAgainst.modifier = modifier[0]
+	}
+
+	newMatchAgainst.BoolExpression = BoolExp(newMatchAgainst.expression())
+
+	return newMatchAgainst
+}
+
+// matchAgainstExpression is MATCH ... AGAINST full-text search expression. It is a predicate that can be used
+// in the WHERE clause, while in the projection list and ORDER BY clause it evaluates to the relevance value.
+type matchAgainstExpression interface {
+	BoolExpression
+
+	// SCORE returns relevance value of the full-text search as float expression
+	SCORE() FloatExpression
+}
+
+type matchAgainstExpressionImpl struct {
+	BoolExpression
+
+	columns  []Column
+	search   StringExpression
+	modifier searchModifier
+}
+
+func (m *matchAgainstExpressionImpl) SCORE() FloatExpression {
+	return FloatExp(m.expression())
+}
+
+func (m *matchAgainstExpressionImpl) expression() Expression {
+	parts := []jet.Serializer{Token("MATCH(")}
+
+	for i, column := range m.columns {
+		if i > 0 {
+			parts = append(parts, Token(", "))
+		}
+		parts = append(parts, column)
+	}
+
+	parts = append(parts, Token(")"), Token("AGAINST("), m.search)
+
+	if m.modifier != "" {
+		parts = append(parts, Token(string(m.modifier)))
+	}
+
+	return jet.AtomicCustomExpression(append(parts, Token(")"))...)
+}
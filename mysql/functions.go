@@ -1,6 +1,10 @@
 package mysql
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"fmt"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
 
 // This functions can be used, instead of its method counterparts, to have a better indentation of a complex condition
 // in the Go code and in the generated SQL.
@@ -323,6 +327,159 @@ func UNIX_TIMESTAMP(str StringExpression) TimestampExpression {
 	return jet.NewTimestampFunc("UNIX_TIMESTAMP", str)
 }
 
+// ----------------------- JSON Functions ----------------------------//
+
+// JSON_EXTRACT returns data from a JSON document, selected from the parts of the document matched by the path arguments
+func JSON_EXTRACT(json JsonExpression, paths ...StringExpression) JsonExpression {
+	return JsonExp(Func("JSON_EXTRACT", append([]Expression{json}, jet.ToExpressionList(paths)...)...))
+}
+
+// JSON_UNQUOTE unquotes JSON value and returns the result as a string
+func JSON_UNQUOTE(json Expression) StringExpression {
+	return StringExp(Func("JSON_UNQUOTE", json))
+}
+
+// JSON_SET inserts or updates data in a JSON document. Additional path-value pairs can be
+// passed using pathValues parameter.
+func JSON_SET(json JsonExpression, path StringExpression, value Expression, pathValues ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_SET", append([]Expression{json, path, value}, pathValues...)...))
+}
+
+// JSON_INSERT inserts data into a JSON document, without replacing existing values. Additional path-value
+// pairs can be passed using pathValues parameter.
+func JSON_INSERT(json JsonExpression, path StringExpression, value Expression, pathValues ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_INSERT", append([]Expression{json, path, value}, pathValues...)...))
+}
+
+// JSON_REPLACE replaces existing values in a JSON document. Additional path-value pairs can be
+// passed using pathValues parameter.
+func JSON_REPLACE(json JsonExpression, path StringExpression, value Expression, pathValues ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_REPLACE", append([]Expression{json, path, value}, pathValues...)...))
+}
+
+// JSON_REMOVE removes data from a JSON document at the specified paths
+func JSON_REMOVE(json JsonExpression, path StringExpression, paths ...StringExpression) JsonExpression {
+	return JsonExp(Func("JSON_REMOVE", append([]Expression{json, path}, jet.ToExpressionList(paths)...)...))
+}
+
+// JSON_ARRAY_APPEND appends value to the end of the array at the path in the JSON document. Additional
+// path-value pairs can be passed using pathValues parameter.
+func JSON_ARRAY_APPEND(json JsonExpression, path StringExpression, value Expression, pathValues ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_ARRAY_APPEND", append([]Expression{json, path, value}, pathValues...)...))
+}
+
+// JSON_CONTAINS indicates whether candidate JSON document is contained within a target JSON document,
+// or, if optional path argument is given, whether the candidate is found at a specific path within the target.
+// At most one path can be passed.
+func JSON_CONTAINS(target, candidate JsonExpression, path ...StringExpression) BoolExpression {
+	return BoolExp(Func("JSON_CONTAINS", optionalPath("JSON_CONTAINS", []Expression{target, candidate}, path)...))
+}
+
+// JSON_CONTAINS_PATH indicates whether a JSON document contains data at a given path or paths. oneOrAll
+// has to be 'one' or 'all'.
+func JSON_CONTAINS_PATH(json JsonExpression, oneOrAll StringExpression, path StringExpression, paths ...StringExpression) BoolExpression {
+	return BoolExp(Func("JSON_CONTAINS_PATH", append([]Expression{json, oneOrAll, path}, jet.ToExpressionList(paths)...)...))
+}
+
+// JSON_OVERLAPS compares two JSON documents. Returns true if the two document have any key-value pairs
+// or array elements in common.
+func JSON_OVERLAPS(json1, json2 JsonExpression) BoolExpression {
+	return BoolExp(Func("JSON_OVERLAPS", json1, json2))
+}
+
+// JSON_SEARCH returns the path to the given string within a JSON document. oneOrAll has to be 'one' or 'all'.
+func JSON_SEARCH(json JsonExpression, oneOrAll StringExpression, searchStr StringExpression) JsonExpression {
+	return JsonExp(Func("JSON_SEARCH", json, oneOrAll, searchStr))
+}
+
+// MEMBER_OF returns true if value is an element of jsonArray (value MEMBER OF(json_array))
+func MEMBER_OF(value Expression, jsonArray JsonExpression) BoolExpression {
+	return BoolExp(CustomExpression(value, Token("MEMBER OF("), jsonArray, Token(")")))
+}
+
+// JSON_OBJECT evaluates a list of key-value pairs and returns a JSON object containing those pairs
+//
+//	JSON_OBJECT(String("id"), Film.FilmID, String("title"), Film.Title)
+func JSON_OBJECT(keyValues ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_OBJECT", keyValues...))
+}
+
+// JSON_ARRAY evaluates a list of values and returns a JSON array containing those values
+func JSON_ARRAY(values ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_ARRAY", values...))
+}
+
+// JSON_ARRAYAGG is aggregate function. Aggregates a result set as a single JSON array whose elements consist of the rows.
+func JSON_ARRAYAGG(value Expression) JsonExpression {
+	return JsonExp(Func("JSON_ARRAYAGG", value))
+}
+
+// JSON_OBJECTAGG is aggregate function. Takes two column names or expressions as arguments, the first of these
+// being used as a key and the second as a value, and returns a JSON object containing key-value pairs.
+func JSON_OBJECTAGG(key, value Expression) JsonExpression {
+	return JsonExp(Func("JSON_OBJECTAGG", key, value))
+}
+
+// JSON_MERGE_PATCH performs an RFC 7396 compliant merge of two or more JSON documents
+func JSON_MERGE_PATCH(json1, json2 JsonExpression, jsons ...JsonExpression) JsonExpression {
+	return JsonExp(Func("JSON_MERGE_PATCH", append([]Expression{json1, json2}, jet.ToExpressionList(jsons)...)...))
+}
+
+// JSON_MERGE_PRESERVE merges two or more JSON documents, preserving duplicate keys
+func JSON_MERGE_PRESERVE(json1, json2 JsonExpression, jsons ...JsonExpression) JsonExpression {
+	return JsonExp(Func("JSON_MERGE_PRESERVE", append([]Expression{json1, json2}, jet.ToExpressionList(jsons)...)...))
+}
+
+// JSON_KEYS returns the keys from the top-level value of a JSON object as a JSON array, or, if optional path
+// argument is given, the top-level keys from the selected path. At most one path can be passed.
+func JSON_KEYS(json JsonExpression, path ...StringExpression) JsonExpression {
+	return JsonExp(Func("JSON_KEYS", optionalPath("JSON_KEYS", []Expression{json}, path)...))
+}
+
+// JSON_LENGTH returns the length of a JSON document, or, if optional path argument is given, the length of
+// the value within the document identified by the path. At most one path can be passed.
+func JSON_LENGTH(json JsonExpression, path ...StringExpression) IntegerExpression {
+	return IntExp(Func("JSON_LENGTH", optionalPath("JSON_LENGTH", []Expression{json}, path)...))
+}
+
+// JSON_DEPTH returns the maximum depth of a JSON document
+func JSON_DEPTH(json JsonExpression) IntegerExpression {
+	return IntExp(Func("JSON_DEPTH", json))
+}
+
+// JSON_TYPE returns a string indicating the type of JSON value (OBJECT, ARRAY, INTEGER, etc...)
+func JSON_TYPE(json JsonExpression) StringExpression {
+	return StringExp(Func("JSON_TYPE", json))
+}
+
+// JSON_VALID returns true if value is a valid JSON document
+func JSON_VALID(value Expression) BoolExpression {
+	return BoolExp(Func("JSON_VALID", value))
+}
+
+// JSON_QUOTE quotes a string as a JSON value
+func JSON_QUOTE(str StringExpression) JsonExpression {
+	return JsonExp(Func("JSON_QUOTE", str))
+}
+
+// JSON_PRETTY returns JSON document in a pretty-printed format
+func JSON_PRETTY(json JsonExpression) StringExpression {
+	return StringExp(Func("JSON_PRETTY", json))
+}
+
+// optionalPath appends optional json path to the function arguments. MySQL json functions accept only
+// one optional path, so extra paths are rejected instead of being silently dropped.
+func optionalPath(funcName string, args []Expression, path []StringExpression) []Expression {
+	switch len(path) {
+	case 0:
+		return args
+	case 1:
+		return append(args, path[0])
+	default:
+		panic(fmt.Sprintf("jet: %s accepts at most one path argument, got %d", funcName, len(path)))
+	}
+}
+
 // --------------- Conditional Expressions Functions -------------//
 
 // EXISTS checks for existence of the rows in subQuery
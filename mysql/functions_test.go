@@ -4,8 +4,77 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUUIDToBin(t *testing.T) {
 	assertSerialize(t, UUID_TO_BIN(String(uuid.Nil.String())), `uuid_to_bin(?)`, uuid.Nil.String())
 }
+
+func TestJsonOperators(t *testing.T) {
+	assertSerialize(t, table1ColJson.GET(String("$.name")), `(JSON_EXTRACT(table1.col_json, ?))`, "$.name")
+	assertSerialize(t, table1ColJson.GET_TEXT(String("$.name")).EQ(String("John")),
+		`((JSON_UNQUOTE(JSON_EXTRACT(table1.col_json, ?))) = ?)`, "$.name", "John")
+	assertDebugSerialize(t, table1ColJson.GET(String("$.a")).GET(String("$.b")),
+		`(JSON_EXTRACT((JSON_EXTRACT(table1.col_json, '$.a')), '$.b'))`)
+}
+
+func TestJsonFunctions(t *testing.T) {
+	assertSerialize(t, JSON_EXTRACT(table1ColJson, String("$.a"), String("$.b")), `JSON_EXTRACT(table1.col_json, ?, ?)`, "$.a", "$.b")
+	assertSerialize(t, JSON_UNQUOTE(table1ColJson), `JSON_UNQUOTE(table1.col_json)`)
+	assertSerialize(t, JSON_SET(table1ColJson, String("$.a"), Int(1), String("$.b"), Int(2)),
+		`JSON_SET(table1.col_json, ?, ?, ?, ?)`, "$.a", int64(1), "$.b", int64(2))
+	assertSerialize(t, JSON_INSERT(table1ColJson, String("$.a"), Int(1)), `JSON_INSERT(table1.col_json, ?, ?)`, "$.a", int64(1))
+	assertSerialize(t, JSON_REPLACE(table1ColJson, String("$.a"), Int(1)), `JSON_REPLACE(table1.col_json, ?, ?)`, "$.a", int64(1))
+	assertSerialize(t, JSON_REMOVE(table1ColJson, String("$.a"), String("$.b")), `JSON_REMOVE(table1.col_json, ?, ?)`, "$.a", "$.b")
+	assertSerialize(t, JSON_ARRAY_APPEND(table1ColJson, String("$"), Int(1)), `JSON_ARRAY_APPEND(table1.col_json, ?, ?)`, "$", int64(1))
+	assertSerialize(t, JSON_CONTAINS(table1ColJson, Json(`1`)), `JSON_CONTAINS(table1.col_json, CAST(? AS JSON))`, "1")
+	assertSerialize(t, JSON_CONTAINS(table1ColJson, Json(`1`), String("$.a")),
+		`JSON_CONTAINS(table1.col_json, CAST(? AS JSON), ?)`, "1", "$.a")
+	assertSerialize(t, JSON_CONTAINS_PATH(table1ColJson, String("all"), String("$.a")),
+		`JSON_CONTAINS_PATH(table1.col_json, ?, ?)`, "all", "$.a")
+	assertSerialize(t, JSON_CONTAINS_PATH(table1ColJson, String("one"), String("$.a"), String("$.b")),
+		`JSON_CONTAINS_PATH(table1.col_json, ?, ?, ?)`, "one", "$.a", "$.b")
+	assertSerialize(t, JSON_OVERLAPS(table1ColJson, Json(`[1]`)), `JSON_OVERLAPS(table1.col_json, CAST(? AS JSON))`, "[1]")
+	assertSerialize(t, JSON_SEARCH(table1ColJson, String("all"), String("abc")), `JSON_SEARCH(table1.col_json, ?, ?)`, "all", "abc")
+	assertSerialize(t, MEMBER_OF(Int(17), table1ColJson), `(? MEMBER OF(table1.col_json))`, int64(17))
+	assertSerialize(t, JSON_OBJECT(String("id"), table1ColInt), `JSON_OBJECT(?, table1.col_int)`, "id")
+	assertSerialize(t, JSON_ARRAY(table1ColInt, table1ColString), `JSON_ARRAY(table1.col_int, table1.col_string)`)
+	assertSerialize(t, JSON_ARRAYAGG(table1ColInt), `JSON_ARRAYAGG(table1.col_int)`)
+	assertSerialize(t, JSON_OBJECTAGG(table1ColString, table1ColInt), `JSON_OBJECTAGG(table1.col_string, table1.col_int)`)
+	assertSerialize(t, JSON_MERGE_PATCH(table1ColJson, Json(`{}`)), `JSON_MERGE_PATCH(table1.col_json, CAST(? AS JSON))`, "{}")
+	assertSerialize(t, JSON_MERGE_PRESERVE(table1ColJson, Json(`{}`), Json(`[]`)),
+		`JSON_MERGE_PRESERVE(table1.col_json, CAST(? AS JSON), CAST(? AS JSON))`, "{}", "[]")
+	assertSerialize(t, JSON_KEYS(table1ColJson), `JSON_KEYS(table1.col_json)`)
+	assertSerialize(t, JSON_LENGTH(table1ColJson, String("$.a")), `JSON_LENGTH(table1.col_json, ?)`, "$.a")
+	assertSerialize(t, JSON_DEPTH(table1ColJson), `JSON_DEPTH(table1.col_json)`)
+	assertSerialize(t, JSON_TYPE(table1ColJson), `JSON_TYPE(table1.col_json)`)
+	assertSerialize(t, JSON_VALID(table1ColString), `JSON_VALID(table1.col_string)`)
+	assertSerialize(t, JSON_QUOTE(table1ColString), `JSON_QUOTE(table1.col_string)`)
+	assertSerialize(t, JSON_PRETTY(table1ColJson), `JSON_PRETTY(table1.col_json)`)
+}
+
+func TestJsonFunctionsPathCount(t *testing.T) {
+	require.PanicsWithValue(t, "jet: JSON_CONTAINS accepts at most one path argument, got 2", func() {
+		JSON_CONTAINS(table1ColJson, Json(`1`), String("$.a"), String("$.b"))
+	})
+	require.PanicsWithValue(t, "jet: JSON_KEYS accepts at most one path argument, got 2", func() {
+		JSON_KEYS(table1ColJson, String("$.a"), String("$.b"))
+	})
+	require.PanicsWithValue(t, "jet: JSON_LENGTH accepts at most one path argument, got 3", func() {
+		JSON_LENGTH(table1ColJson, String("$.a"), String("$.b"), String("$.c"))
+	})
+}
+
+func TestMatchAgainst(t *testing.T) {
+	assertSerialize(t, MATCH(table2ColStr).AGAINST(String("drama")),
+		"MATCH(table2.col_str) AGAINST(?)", "drama")
+	assertSerialize(t, MATCH(table1ColString, table2ColStr).AGAINST(String("drama"), IN_NATURAL_LANGUAGE_MODE),
+		"MATCH(table1.col_string, table2.col_str) AGAINST(? IN NATURAL LANGUAGE MODE)", "drama")
+	assertSerialize(t, MATCH(table2ColStr).AGAINST(String("+drama -comedy"), IN_BOOLEAN_MODE).AND(table2ColInt.GT(Int(2))),
+		"(MATCH(table2.col_str) AGAINST(? IN BOOLEAN MODE) AND (table2.col_int > ?))", "+drama -comedy", int64(2))
+	assertSerialize(t, MATCH(table2ColStr).AGAINST(String("drama"), WITH_QUERY_EXPANSION).SCORE().GT(Float(0.5)),
+		"(MATCH(table2.col_str) AGAINST(? WITH QUERY EXPANSION) > ?)", "drama", 0.5)
+	assertSerialize(t, MATCH(table2ColStr).AGAINST(String("drama"), IN_NATURAL_LANGUAGE_MODE_WITH_QUERY_EXPANSION).SCORE(),
+		"MATCH(table2.col_str) AGAINST(? IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION)", "drama")
+}
@@ -0,0 +1,61 @@
+package mysql
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// JsonTableColumn is a column definition of the JSON_TABLE function
+type JsonTableColumn = jet.JsonTableColumn
+
+// JSON_TABLE extracts data from a JSON document and returns it as a relational table having the specified columns.
+// path is a JSON path expression applied to json, and each matched item produces one row.
+//
+//	JSON_TABLE(
+//		Customer.Data, "$.orders[*]",
+//		PATH(IntegerColumn("id"), "INT", "$.id"),
+//		PATH(StringColumn("name"), "VARCHAR(100)", "$.name"),
+//	).AS("orders")
+func JSON_TABLE(json Expression, path string, columns ...JsonTableColumn) jsonTable {
+	return jsonTable{
+		json:    json,
+		path:    path,
+		columns: columns,
+	}
+}
+
+type jsonTable struct {
+	json    Expression
+	path    string
+	columns []JsonTableColumn
+}
+
+// AS sets the alias of the JSON_TABLE, and creates new table that can be used in the FROM clause
+func (j jsonTable) AS(alias string) SelectTable {
+	subQuery := &selectTableImpl{
+		SelectTable: jet.NewJsonTable(j.json, j.path, j.columns, alias),
+	}
+
+	subQuery.readableTableInterfaceImpl.root = subQuery
+
+	return subQuery
+}
+
+// PATH creates JSON_TABLE column of sqlType, which value is extracted from the JSON document using path.
+func PATH(column Column, sqlType string, path string) JsonTableColumn {
+	return jet.NewJsonTableColumn(column, sqlType, path, false)
+}
+
+// EXISTS_PATH creates JSON_TABLE column of sqlType, which value is 1 if any data is present at the path location,
+// and 0 otherwise.
+func EXISTS_PATH(column Column, sqlType string, path string) JsonTableColumn {
+	return jet.NewJsonTableColumn(column, sqlType, path, true)
+}
+
+// FOR_ORDINALITY creates JSON_TABLE column that enumerates rows, starting from 1
+func FOR_ORDINALITY(column Column) JsonTableColumn {
+	return jet.NewJsonTableOrdinalityColumn(column)
+}
+
+// NESTED_PATH flattens nested objects or arrays at path into a single row along with the JSON values from
+// the parent object or array.
+func NESTED_PATH(path string, columns ...JsonTableColumn) JsonTableColumn {
+	return jet.NewJsonTableNestedPath(path, columns)
+}
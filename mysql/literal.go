@@ -100,3 +100,15 @@ func Timestamp(year int, month time.Month, day, hour, minute, second int, nanose
 func TimestampT(t time.Time) TimestampExpression {
 	return TIMESTAMP(StringExp(jet.TimestampT(t)))
 }
+
+// Json creates new json literal expression
+func Json(value interface{}) JsonExpression {
+	switch v := value.(type) {
+	case string:
+	case []byte:
+		value = string(v) // binary strings can not be cast to JSON
+	default:
+		panic("Json parameter value has to be of the type string or []byte")
+	}
+	return CAST(jet.Literal(value)).AS_JSON()
+}
@@ -81,3 +81,9 @@ func TestTimestamp(t *testing.T) {
 	assertSerialize(t, Timestamp(2010, time.March, 30, 10, 15, 30), `TIMESTAMP(?)`, "2010-03-30 10:15:30")
 	assertSerialize(t, TimestampT(time.Now()), `TIMESTAMP(?)`)
 }
+
+func TestJson(t *testing.T) {
+	assertSerialize(t, Json(`{"a": 1}`), `CAST(? AS JSON)`, `{"a": 1}`)
+	assertSerialize(t, Json([]byte(`[1, 2]`)), `CAST(? AS JSON)`, `[1, 2]`)
+	assertPanicErr(t, func() { Json(11) }, "Json parameter value has to be of the type string or []byte")
+}
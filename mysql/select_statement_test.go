@@ -159,3 +159,54 @@ WHERE NOT (EXISTS (
       ));
 `)
 }
+
+func TestSelectFromJsonTable(t *testing.T) {
+	id := IntegerColumn("id")
+	name := StringColumn("name")
+	hasTags := BoolColumn("has_tags")
+	rowNum := IntegerColumn("row_num")
+	tag := StringColumn("tag")
+
+	orders := JSON_TABLE(table1ColJson, "$.orders[*]",
+		FOR_ORDINALITY(rowNum),
+		PATH(id, "INT", "$.id"),
+		PATH(name, "VARCHAR(100)", "$.name"),
+		EXISTS_PATH(hasTags, "INT", "$.tags"),
+		NESTED_PATH("$.tags[*]",
+			PATH(tag, "VARCHAR(20)", "$"),
+		),
+	).AS("orders")
+
+	assertStatementSql(t, SELECT(table1ColInt, orders.AllColumns()).
+		FROM(table1.CROSS_JOIN(orders)).
+		WHERE(id.GT(Int(10))), `
+SELECT table1.col_int AS "table1.col_int",
+     orders.row_num AS "row_num",
+     orders.id AS "id",
+     orders.name AS "name",
+     orders.has_tags AS "has_tags",
+     orders.tag AS "tag"
+FROM db.table1
+     CROSS JOIN JSON_TABLE(table1.col_json, '$.orders[*]' COLUMNS(row_num FOR ORDINALITY, id INT PATH '$.id', name VARCHAR(100) PATH '$.name', has_tags INT EXISTS PATH '$.tags', NESTED PATH '$.tags[*]' COLUMNS(tag VARCHAR(20) PATH '$'))) AS orders
+WHERE orders.id > ?;
+`, int64(10))
+
+	assertPanicErr(t, func() { JSON_TABLE(table1ColJson, "$[*]").AS("empty") }, "jet: JSON_TABLE requires at least one column")
+}
+
+func TestSelectMatchAgainst(t *testing.T) {
+	match := MATCH(table1ColString).AGAINST(String("+drama -comedy"), IN_BOOLEAN_MODE)
+
+	testutils.AssertDebugStatementSql(t,
+		SELECT(table1ColInt, match.SCORE().AS("score")).
+			FROM(table1).
+			WHERE(match).
+			ORDER_BY(match.SCORE().DESC()),
+		`
+SELECT table1.col_int AS "table1.col_int",
+     MATCH(table1.col_string) AGAINST('+drama -comedy' IN BOOLEAN MODE) AS "score"
+FROM db.table1
+WHERE MATCH(table1.col_string) AGAINST('+drama -comedy' IN BOOLEAN MODE)
+ORDER BY MATCH(table1.col_string) AGAINST('+drama -comedy' IN BOOLEAN MODE) DESC;
+`)
+}
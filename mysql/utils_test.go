@@ -15,8 +15,9 @@ var table1Col3 = IntegerColumn("col3")
 var table1ColTimestamp = TimestampColumn("col_timestamp")
 var table1ColDate = DateColumn("col_date")
 var table1ColTime = TimeColumn("col_time")
+var table1ColJson = JsonColumn("col_json")
 
-var table1 = NewTable("db", "table1", "", table1Col1, table1ColInt, table1ColFloat, table1ColString, table1Col3, table1ColBool, table1ColDate, table1ColTimestamp, table1ColTime)
+var table1 = NewTable("db", "table1", "", table1Col1, table1ColInt, table1ColFloat, table1ColString, table1Col3, table1ColBool, table1ColDate, table1ColTimestamp, table1ColTime, table1ColJson)
 
 var table2Col3 = IntegerColumn("col3")
 var table2Col4 = IntegerColumn("col4")
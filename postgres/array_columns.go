@@ -6,6 +6,7 @@ import "github.com/go-jet/jet/v2/internal/jet"
 type (
 	ColumnBoolArray       jet.ColumnArray[BoolExpression]
 	ColumnStringArray     jet.ColumnArray[StringExpression]
+	ColumnEnumArray       jet.ColumnArray[EnumExpression]
 	ColumnIntegerArray    jet.ColumnArray[IntegerExpression]
 	ColumnFloatArray      jet.ColumnArray[FloatExpression]
 	ColumnByteaArray      jet.ColumnArray[ByteaExpression]
@@ -15,12 +16,17 @@ type (
 	ColumnTimeArray       jet.ColumnArray[TimeExpression]
 	ColumnTimezArray      jet.ColumnArray[TimezExpression]
 	ColumnIntervalArray   jet.ColumnArray[IntervalExpression]
+	ColumnJsonArray       jet.ColumnArray[JsonExpression]
+	ColumnJsonbArray      jet.ColumnArray[JsonbExpression]
+	ColumnTsVectorArray   jet.ColumnArray[TsVectorExpression]
+	ColumnTsQueryArray    jet.ColumnArray[TsQueryExpression]
 )
 
 // Column constructors for different postgres array column types
 var (
 	BoolArrayColumn       = jet.ArrayColumn[BoolExpression]
 	StringArrayColumn     = jet.ArrayColumn[StringExpression]
+	EnumArrayColumn       = jet.ArrayColumn[EnumExpression]
 	IntegerArrayColumn    = jet.ArrayColumn[IntegerExpression]
 	FloatArrayColumn      = jet.ArrayColumn[FloatExpression]
 	ByteaArrayColumn      = jet.ArrayColumn[ByteaExpression]
@@ -30,4 +36,8 @@ var (
 	TimeArrayColumn       = jet.ArrayColumn[TimeExpression]
 	TimezArrayColumn      = jet.ArrayColumn[TimezExpression]
 	IntervalArrayColumn   = jet.ArrayColumn[IntervalExpression]
+	JsonArrayColumn       = jet.ArrayColumn[JsonExpression]
+	JsonbArrayColumn      = jet.ArrayColumn[JsonbExpression]
+	TsVectorArrayColumn   = jet.ArrayColumn[TsVectorExpression]
+	TsQueryArrayColumn    = jet.ArrayColumn[TsQueryExpression]
 )
@@ -129,6 +129,26 @@ func (b *cast) AS_INTERVAL() IntervalExpression {
 	return IntervalExp(b.AS("interval"))
 }
 
+// AS_JSON casts expression AS json type
+func (b *cast) AS_JSON() JsonExpression {
+	return JsonExp(b.AS("json"))
+}
+
+// AS_JSONB casts expression AS jsonb type
+func (b *cast) AS_JSONB() JsonbExpression {
+	return JsonbExp(b.AS("jsonb"))
+}
+
+// AS_TSVECTOR casts expression AS tsvector type
+func (b *cast) AS_TSVECTOR() TsVectorExpression {
+	return TsVectorExp(b.AS("tsvector"))
+}
+
+// AS_TSQUERY casts expression AS tsquery type
+func (b *cast) AS_TSQUERY() TsQueryExpression {
+	return TsQueryExp(b.AS("tsquery"))
+}
+
 // AS_UUID casts expression AS uuid type
 func (b *cast) AS_UUID() StringExpression {
 	return StringExp(b.AS("uuid"))
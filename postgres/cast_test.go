@@ -69,3 +69,8 @@ func TestExpressionCAST_AS_INTERVAL(t *testing.T) {
 	assertSerialize(t, table2ColDate.SUB(CAST(Time(20, 11, 10)).AS_INTERVAL()),
 		"(table2.col_date - $1::time without time zone::interval)", "20:11:10")
 }
+
+func TestExpressionCAST_AS_TEXT_SEARCH(t *testing.T) {
+	assertSerialize(t, CAST(table2ColStr).AS_TSVECTOR(), "table2.col_str::tsvector")
+	assertSerialize(t, CAST(String("cat & rat")).AS_TSQUERY(), "$1::text::tsquery", "cat & rat")
+}
@@ -5,6 +5,17 @@ import (
 	"github.com/go-jet/jet/v2/internal/utils/is"
 )
 
+// ConflictTarget is ON CONFLICT clause target, unique index columns with an optional partial index predicate.
+// Generated table types contain conflict target for each unique index of the table.
+//
+//	Film.INSERT(Film.AllColumns).
+//		MODEL(film).
+//		ON_CONFLICT_TARGET(Film.UniqueTitle).DO_NOTHING()
+type ConflictTarget = jet.ConflictTarget
+
+// NewConflictTarget creates new conflict target from unique index columns and optional partial index predicate
+var NewConflictTarget = jet.NewConflictTarget
+
 type onConflict interface {
 	ON_CONSTRAINT(name string) conflictTarget
 	WHERE(indexPredicate BoolExpression) conflictTarget
@@ -77,6 +77,30 @@ type ColumnInterval = jet.ColumnInterval
 // IntervalColumn creates named interval column
 var IntervalColumn = jet.IntervalColumn
 
+// ColumnJson is interface of PostgreSQL json columns.
+type ColumnJson = jet.ColumnPgJson
+
+// JsonColumn creates named json column.
+var JsonColumn = jet.PgJsonColumn
+
+// ColumnJsonb is interface of PostgreSQL jsonb columns.
+type ColumnJsonb = jet.ColumnJsonb
+
+// JsonbColumn creates named jsonb column.
+var JsonbColumn = jet.JsonbColumn
+
+// ColumnTsVector is interface of PostgreSQL tsvector columns.
+type ColumnTsVector = jet.ColumnTsVector
+
+// TsVectorColumn creates named tsvector column.
+var TsVectorColumn = jet.TsVectorColumn
+
+// ColumnTsQuery is interface of PostgreSQL tsquery columns.
+type ColumnTsQuery = jet.ColumnTsQuery
+
+// TsQueryColumn creates named tsquery column.
+var TsQueryColumn = jet.TsQueryColumn
+
 // ColumnDateRange is interface of SQL date range column
 type ColumnDateRange = jet.ColumnRange[DateExpression]
 
@@ -112,3 +136,9 @@ type ColumnInt8Range jet.ColumnRange[jet.Int8Expression]
 
 // Int8RangeColumn creates named range with range column
 var Int8RangeColumn = jet.RangeColumn[jet.Int8Expression]
+
+// ColumnRow is interface of SQL composite type column
+type ColumnRow = jet.ColumnRow
+
+// RowColumn creates named composite type column
+var RowColumn = jet.RowColumn
@@ -18,3 +18,18 @@ func TestNewIntervalColumn(t *testing.T) {
 	assertSerialize(t, subQueryIntervalColumn2.EQ(INTERVAL(1, DAY)), `(sub_query."table1.col_interval" = INTERVAL '1 DAY')`)
 	assertProjectionSerialize(t, subQueryIntervalColumn2, `sub_query."table1.col_interval" AS "table1.col_interval"`)
 }
+
+func TestRowColumn(t *testing.T) {
+	addressColumn := RowColumn("address")
+	_ = NewTable("db", "customer", "", addressColumn)
+
+	assertSerialize(t, addressColumn, `customer.address`)
+	assertSerialize(t, addressColumn.EQ(ROW(String("Main St"), Int(1))), `(customer.address = ROW($1::text, $2))`, "Main St", int64(1))
+	assertSerialize(t, addressColumn.SET(ROW(String("Main St"), NULL)), `address = ROW($1::text, NULL)`, "Main St")
+	assertSerialize(t, StringExp(RowField(addressColumn, "city")).EQ(String("Paris")), `((customer.address).city = $1::text)`, "Paris")
+	assertSerialize(t, RowField(ROW(Int(1), Int(2)), "Order"), `(ROW($1, $2))."Order"`, int64(1), int64(2))
+
+	subQuery := SELECT(addressColumn).FROM(NewTable("db", "customer", "", addressColumn)).AsTable("sub_query")
+	assertSerialize(t, addressColumn.From(subQuery), `sub_query."customer.address"`)
+	assertProjectionSerialize(t, RowField(addressColumn.From(subQuery), "city").AS("city"), `(sub_query."customer.address").city AS "city"`)
+}
@@ -0,0 +1,15 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/postgres/pgtype"
+
+// ArrayValue is a model type for PostgreSQL array values of any element type and any number of dimensions.
+// Generated models use pgtype.ArrayValue, this alias is kept for compatibility.
+type ArrayValue[T any] = pgtype.ArrayValue[T]
+
+// ScanComposite parses PostgreSQL composite type value and assigns the composite fields to the destinations.
+// Generated models use pgtype.ScanComposite, this alias is kept for compatibility.
+var ScanComposite = pgtype.ScanComposite
+
+// CompositeValue returns PostgreSQL composite type value of the field values.
+// Generated models use pgtype.CompositeValue, this alias is kept for compatibility.
+var CompositeValue = pgtype.CompositeValue
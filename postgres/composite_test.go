@@ -0,0 +1,24 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/postgres/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgtypeAliases(t *testing.T) {
+	var moods ArrayValue[string] = pgtype.ArrayValue[string]{"sad"}
+	require.NoError(t, moods.Scan("{sad,happy}"))
+	require.Equal(t, pgtype.ArrayValue[string]{"sad", "happy"}, moods)
+
+	value, err := CompositeValue("Main St", 12)
+	require.NoError(t, err)
+	require.Equal(t, `("Main St","12")`, value)
+
+	var city string
+	var number int32
+	require.NoError(t, ScanComposite(value, &city, &number))
+	require.Equal(t, "Main St", city)
+	require.Equal(t, int32(12), number)
+}
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+func TestCreateTable(t *testing.T) {
+	assertStatementSql(t, CREATE_TABLE(table2), `
+CREATE TABLE db.table2 (
+    col3 bigint,
+    col4 bigint,
+    col_int bigint,
+    col_float double precision,
+    col_str text,
+    col_bool boolean,
+    col_time time without time zone,
+    col_timez time with time zone,
+    col_date date,
+    col_timestamp timestamp without time zone,
+    col_timestampz timestamp with time zone,
+    col_interval interval,
+    col_range int8range,
+    col_string_array text[],
+    col_int_array bigint[]
+);
+`)
+	assertStatementSql(t, CREATE_TABLE(table3).IF_NOT_EXISTS().
+		COLUMNS(
+			COLUMN(table3Col1).TYPE("serial").PRIMARY_KEY(),
+			COLUMN(table3ColInt).NOT_NULL().DEFAULT(Int(0)).CHECK(table3ColInt.GT_EQ(Int(0))).REFERENCES(table1ColInt),
+			COLUMN(table3StrCol).TYPE("varchar(100)").UNIQUE().DEFAULT(String("none")),
+		).
+		CONSTRAINTS(
+			UNIQUE(table3ColInt, table3StrCol),
+			CHECK(table3StrCol.NOT_EQ(String(""))),
+			CONSTRAINT("table3_fk").FOREIGN_KEY(table3Col1, table3ColInt).REFERENCES(table2Col3, table2Col4),
+		), `
+CREATE TABLE IF NOT EXISTS db.table3 (
+    col1 serial PRIMARY KEY,
+    col_int bigint NOT NULL DEFAULT 0 CHECK (col_int >= 0) REFERENCES db.table1 (col_int),
+    col2 varchar(100) UNIQUE DEFAULT 'none'::text,
+    UNIQUE (col_int, col2),
+    CHECK (col2 != ''::text),
+    CONSTRAINT table3_fk FOREIGN KEY (col1, col_int) REFERENCES db.table2 (col3, col4)
+);
+`)
+	assertStatementSql(t, CREATE_TABLE(table3).TEMPORARY().
+		COLUMNS(COLUMN(table3Col1), COLUMN(table3ColInt)).
+		CONSTRAINTS(PRIMARY_KEY(table3Col1, table3ColInt)), `
+CREATE TEMPORARY TABLE db.table3 (
+    col1 bigint,
+    col_int bigint,
+    PRIMARY KEY (col1, col_int)
+);
+`)
+	customerID := IntegerColumn("id")
+	// referenced table is aliased, but REFERENCES still has to use schema and table name
+	_ = NewTable("public", "customer", "c", customerID)
+	ordersCustomerID := IntegerColumn("customer_id")
+	orders := NewTable("sales_eu", "orders", "", ordersCustomerID)
+
+	assertStatementSql(t, CREATE_TABLE(orders).
+		COLUMNS(COLUMN(ordersCustomerID).REFERENCES(customerID)).
+		CONSTRAINTS(FOREIGN_KEY(ordersCustomerID).REFERENCES(customerID)), `
+CREATE TABLE sales_eu.orders (
+    customer_id bigint REFERENCES public.customer (id),
+    FOREIGN KEY (customer_id) REFERENCES public.customer (id)
+);
+`)
+	assertStatementSqlErr(t, CREATE_TABLE(nil), "jet: table is nil for CREATE TABLE statement")
+	assertStatementSqlErr(t, CREATE_TABLE(table3).COLUMNS(COLUMN(jet.RangeColumn[StringExpression]("col_unknown"))),
+		"jet: unknown data type for column 'col_unknown', use TYPE to set it")
+}
+
+func TestAlterTable(t *testing.T) {
+	assertStatementSql(t, ALTER_TABLE(table3).
+		ADD_COLUMN(COLUMN(StringColumn("title")).NOT_NULL().DEFAULT(String(""))).
+		DROP_COLUMN(table3StrCol).
+		ALTER_COLUMN(table3ColInt).SET_DATA_TYPE("integer").
+		ALTER_COLUMN(table3ColInt).SET_NOT_NULL().
+		ALTER_COLUMN(table3ColInt).SET_DEFAULT(Int(10)).
+		ALTER_COLUMN(table3Col1).DROP_NOT_NULL().
+		ALTER_COLUMN(table3Col1).DROP_DEFAULT().
+		ADD_CONSTRAINT(CONSTRAINT("col_int_check").CHECK(table3ColInt.GT(Int(0)))).
+		DROP_CONSTRAINT("table3_fk"), `
+ALTER TABLE db.table3
+    ADD COLUMN title text NOT NULL DEFAULT ''::text,
+    DROP COLUMN col2,
+    ALTER COLUMN col_int TYPE integer,
+    ALTER COLUMN col_int SET NOT NULL,
+    ALTER COLUMN col_int SET DEFAULT 10,
+    ALTER COLUMN col1 DROP NOT NULL,
+    ALTER COLUMN col1 DROP DEFAULT,
+    ADD CONSTRAINT col_int_check CHECK (col_int > 0),
+    DROP CONSTRAINT table3_fk;
+`)
+	assertStatementSql(t, ALTER_TABLE(table3).RENAME_COLUMN(table3StrCol, "title"), `
+ALTER TABLE db.table3
+    RENAME COLUMN col2 TO title;
+`)
+	assertStatementSql(t, ALTER_TABLE(table3).RENAME_TO("table4"), `
+ALTER TABLE db.table3
+    RENAME TO table4;
+`)
+	assertStatementSqlErr(t, ALTER_TABLE(table3), "jet: no actions for ALTER TABLE statement")
+}
+
+func TestDropTable(t *testing.T) {
+	assertStatementSql(t, DROP_TABLE(table1), `
+DROP TABLE db.table1;
+`)
+	assertStatementSql(t, DROP_TABLE(table1, table2).IF_EXISTS().CASCADE(), `
+DROP TABLE IF EXISTS db.table1, db.table2 CASCADE;
+`)
+}
+
+func TestCreateIndex(t *testing.T) {
+	assertStatementSql(t, CREATE_INDEX("table3_idx").ON(table3, table3ColInt, table3StrCol), `
+CREATE INDEX table3_idx ON db.table3 (col_int, col2);
+`)
+	assertStatementSql(t, CREATE_UNIQUE_INDEX("table3_idx").IF_NOT_EXISTS().
+		ON(table3, table3StrCol).
+		USING("btree").
+		WHERE(table3ColInt.GT(Int(10))), `
+CREATE UNIQUE INDEX IF NOT EXISTS table3_idx ON db.table3 USING btree (col2)
+WHERE col_int > 10;
+`)
+	assertStatementSqlErr(t, CREATE_INDEX("table3_idx"), "jet: table is nil for CREATE INDEX statement")
+}
+
+func TestDropIndex(t *testing.T) {
+	assertStatementSql(t, DROP_INDEX("table3_idx"), `
+DROP INDEX table3_idx;
+`)
+	assertStatementSql(t, DROP_INDEX("table3_idx").IF_EXISTS().CASCADE(), `
+DROP INDEX IF EXISTS table3_idx CASCADE;
+`)
+}
@@ -45,6 +45,7 @@ func newDialect() jet.Dialect {
 			return expr
 		},
 		RegexpLike: regexpLike,
+		DataType:   dataType,
 	}
 
 	return jet.NewDialect(dialectParams)
@@ -59,6 +60,86 @@ func argumentToString(value any) (string, bool) {
 	return "", false
 }
 
+// dataType returns default PostgreSQL data type for the column type, used by DDL statements
+func dataType(column jet.Column) string {
+	switch column.(type) {
+	case ColumnBool:
+		return "boolean"
+	case ColumnInteger:
+		return "bigint"
+	case ColumnFloat:
+		return "double precision"
+	case ColumnString:
+		return "text"
+	case ColumnBytea:
+		return "bytea"
+	case ColumnDate:
+		return "date"
+	case ColumnTime:
+		return "time without time zone"
+	case ColumnTimez:
+		return "time with time zone"
+	case ColumnTimestamp:
+		return "timestamp without time zone"
+	case ColumnTimestampz:
+		return "timestamp with time zone"
+	case ColumnInterval:
+		return "interval"
+	case ColumnJson:
+		return "json"
+	case ColumnJsonb:
+		return "jsonb"
+	case ColumnTsVector:
+		return "tsvector"
+	case ColumnTsQuery:
+		return "tsquery"
+	case ColumnInt4Range:
+		return "int4range"
+	case ColumnInt8Range:
+		return "int8range"
+	case ColumnNumericRange:
+		return "numrange"
+	case ColumnDateRange:
+		return "daterange"
+	case ColumnTimestampRange:
+		return "tsrange"
+	case ColumnTimestampzRange:
+		return "tstzrange"
+	case ColumnBoolArray:
+		return "boolean[]"
+	case ColumnIntegerArray:
+		return "bigint[]"
+	case ColumnFloatArray:
+		return "double precision[]"
+	case ColumnStringArray:
+		return "text[]"
+	case ColumnByteaArray:
+		return "bytea[]"
+	case ColumnDateArray:
+		return "date[]"
+	case ColumnTimeArray:
+		return "time without time zone[]"
+	case ColumnTimezArray:
+		return "time with time zone[]"
+	case ColumnTimestampArray:
+		return "timestamp without time zone[]"
+	case ColumnTimestampzArray:
+		return "timestamp with time zone[]"
+	case ColumnIntervalArray:
+		return "interval[]"
+	case ColumnJsonArray:
+		return "json[]"
+	case ColumnJsonbArray:
+		return "jsonb[]"
+	case ColumnTsVectorArray:
+		return "tsvector[]"
+	case ColumnTsQueryArray:
+		return "tsquery[]"
+	}
+
+	return ""
+}
+
 func regexpLike(str jet.StringExpression, not bool, pattern jet.StringExpression, caseSensitive bool) jet.SerializerFunc {
 	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 		jet.Serialize(str, statement, out, options...)
@@ -15,6 +15,9 @@ type BoolExpression = jet.BoolExpression
 // StringExpression interface
 type StringExpression = jet.StringExpression
 
+// EnumExpression interface
+type EnumExpression = jet.EnumExpression
+
 type ByteaExpression = jet.BlobExpression
 
 // NumericExpression interface
@@ -44,9 +47,36 @@ type TimestampzExpression = jet.TimestampzExpression
 // RowExpression interface
 type RowExpression = jet.RowExpression
 
+// JsonExpression interface
+type JsonExpression = jet.PgJsonExpression
+
+// JsonbExpression interface
+type JsonbExpression = jet.JsonbExpression
+
+// TsVectorExpression interface
+type TsVectorExpression = jet.TsVectorExpression
+
+// TsQueryExpression interface
+type TsQueryExpression = jet.TsQueryExpression
+
 // IntervalExpression interface
 type IntervalExpression = jet.IntervalExpression
 
+// AggregateExpression interface
+type AggregateExpression = jet.AggregateExpression
+
+// BoolAggregateExpression interface
+type BoolAggregateExpression = jet.BoolAggregateExpression
+
+// IntegerAggregateExpression interface
+type IntegerAggregateExpression = jet.IntegerAggregateExpression
+
+// FloatAggregateExpression interface
+type FloatAggregateExpression = jet.FloatAggregateExpression
+
+// StringAggregateExpression interface
+type StringAggregateExpression = jet.StringAggregateExpression
+
 // DateRange Expression interface
 type DateRange = jet.Range[DateExpression]
 
@@ -90,6 +120,11 @@ var TimeExp = jet.TimeExp
 // Does not add sql cast to generated sql builder output.
 var StringExp = jet.StringExp
 
+// EnumExp is enum expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as enum expression.
+// Does not add sql cast to generated sql builder output.
+var EnumExp = jet.EnumExp
+
 // ByteaExp is blob expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as string expression.
 // Does not add sql cast to generated sql builder output.
@@ -120,11 +155,35 @@ var TimestampzExp = jet.TimestampzExp
 // Does not add sql cast to generated sql builder output.
 var IntervalExp = jet.IntervalExp
 
+// JsonExp is json expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as json expression.
+// Does not add sql cast to generated sql builder output.
+var JsonExp = jet.PgJsonExp
+
+// JsonbExp is jsonb expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as jsonb expression.
+// Does not add sql cast to generated sql builder output.
+var JsonbExp = jet.JsonbExp
+
+// TsVectorExp is tsvector expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as tsvector expression.
+// Does not add sql cast to generated sql builder output.
+var TsVectorExp = jet.TsVectorExp
+
+// TsQueryExp is tsquery expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as tsquery expression.
+// Does not add sql cast to generated sql builder output.
+var TsQueryExp = jet.TsQueryExp
+
 // RowExp serves as a wrapper for an arbitrary expression, treating it as a row expression.
 // This enables the Go compiler to interpret any expression as a row expression
 // Note: This does not modify the generated SQL builder output by adding a SQL CAST operation.
 var RowExp = jet.RowExp
 
+// RowField returns the field of the composite type value, for instance RowField(Customer.Address, "city")
+// serializes as (customer.address).city. Field expression type can be set with type wrappers, like StringExp.
+var RowField = jet.RowField
+
 // RangeExp is range expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as range expression.
 // Does not add sql cast to generated sql builder output.
@@ -162,6 +221,10 @@ var (
 	RawTimestampz = jet.RawTimestampz
 	RawDate       = jet.RawDate
 	RawBytea      = jet.RawBlob
+	RawJson       = jet.RawPgJson
+	RawJsonb      = jet.RawJsonb
+	RawTsVector   = jet.RawTsVector
+	RawTsQuery    = jet.RawTsQuery
 
 	RawNumRange        = jet.RawRange[jet.NumericExpression]
 	RawInt4Range       = jet.RawRange[jet.Int4Expression]
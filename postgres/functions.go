@@ -62,52 +62,94 @@ var LOG = jet.LOG
 // ----------------- Aggregate functions  -------------------//
 
 // AVG is aggregate function used to calculate avg value from numeric expression
-var AVG = jet.AVG
+func AVG(numericExpression Expression) FloatAggregateExpression {
+	return jet.NewFloatAggregateFunc("AVG", numericExpression)
+}
 
 // BIT_AND is aggregate function used to calculates the bitwise AND of all non-null input values, or null if none.
-var BIT_AND = jet.BIT_AND
+func BIT_AND(integerExpression IntegerExpression) IntegerAggregateExpression {
+	return jet.NewIntegerAggregateFunc("BIT_AND", integerExpression)
+}
 
 // BIT_OR is aggregate function used to calculates the bitwise OR of all non-null input values, or null if none.
-var BIT_OR = jet.BIT_OR
+func BIT_OR(integerExpression IntegerExpression) IntegerAggregateExpression {
+	return jet.NewIntegerAggregateFunc("BIT_OR", integerExpression)
+}
 
 // BOOL_AND is aggregate function. Returns true if all input values are true, otherwise false
-var BOOL_AND = jet.BOOL_AND
+func BOOL_AND(boolExpression BoolExpression) BoolAggregateExpression {
+	return jet.NewBoolAggregateFunc("BOOL_AND", boolExpression)
+}
 
 // BOOL_OR is aggregate function. Returns true if at least one input value is true, otherwise false
-var BOOL_OR = jet.BOOL_OR
+func BOOL_OR(boolExpression BoolExpression) BoolAggregateExpression {
+	return jet.NewBoolAggregateFunc("BOOL_OR", boolExpression)
+}
 
 // COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
-var COUNT = jet.COUNT
+func COUNT(expression Expression) IntegerAggregateExpression {
+	return jet.NewIntegerAggregateFunc("COUNT", expression)
+}
 
 // EVERY is aggregate function. Returns true if all input values are true, otherwise false
-var EVERY = jet.EVERY
+func EVERY(boolExpression BoolExpression) BoolAggregateExpression {
+	return jet.NewBoolAggregateFunc("EVERY", boolExpression)
+}
 
 // MAX is aggregate function. Returns maximum value of expression across all input values
-var MAX = jet.MAX
+func MAX(expression Expression) AggregateExpression {
+	return jet.NewAggregateFunc("MAX", expression)
+}
 
 // MAXf is aggregate function. Returns maximum value of float expression across all input values
-var MAXf = jet.MAXf
+func MAXf(floatExpression FloatExpression) FloatAggregateExpression {
+	return jet.NewFloatAggregateFunc("MAX", floatExpression)
+}
 
 // MAXi is aggregate function. Returns maximum value of int expression across all input values
-var MAXi = jet.MAXi
+func MAXi(integerExpression IntegerExpression) IntegerAggregateExpression {
+	return jet.NewIntegerAggregateFunc("MAX", integerExpression)
+}
 
 // MIN is aggregate function. Returns minimum value of expression across all input values.
-var MIN = jet.MIN
+func MIN(expression Expression) AggregateExpression {
+	return jet.NewAggregateFunc("MIN", expression)
+}
 
 // MINf is aggregate function. Returns minimum value of float expression across all input values
-var MINf = jet.MINf
+func MINf(floatExpression FloatExpression) FloatAggregateExpression {
+	return jet.NewFloatAggregateFunc("MIN", floatExpression)
+}
 
 // MINi is aggregate function. Returns minimum value of int expression across all input values
-var MINi = jet.MINi
+func MINi(integerExpression IntegerExpression) IntegerAggregateExpression {
+	return jet.NewIntegerAggregateFunc("MIN", integerExpression)
+}
 
 // SUM is aggregate function. Returns sum of all expressions
-var SUM = jet.SUM
+func SUM(expression Expression) AggregateExpression {
+	return jet.NewAggregateFunc("SUM", expression)
+}
 
 // SUMf is aggregate function. Returns sum of expression across all float expressions
-var SUMf = jet.SUMf
+func SUMf(floatExpression FloatExpression) FloatAggregateExpression {
+	return jet.NewFloatAggregateFunc("SUM", floatExpression)
+}
 
 // SUMi is aggregate function. Returns sum of expression across all integer expression.
-var SUMi = jet.SUMi
+func SUMi(integerExpression IntegerExpression) IntegerAggregateExpression {
+	return jet.NewIntegerAggregateFunc("SUM", integerExpression)
+}
+
+// ARRAY_AGG is aggregate function. Collects all the input values, including nulls, into an array.
+func ARRAY_AGG(expression Expression) AggregateExpression {
+	return jet.NewAggregateFunc("ARRAY_AGG", expression)
+}
+
+// STRING_AGG is aggregate function. Concatenates the non-null input values into a string, separated by delimiter.
+func STRING_AGG(expression StringExpression, delimiter StringExpression) StringAggregateExpression {
+	return jet.NewStringAggregateFunc("STRING_AGG", expression, delimiter)
+}
 
 // -------------------- Window functions -----------------------//
 
@@ -484,6 +526,183 @@ func optionalAppend[O Expression](elem []Expression, optional []O) []Expression
 	return append(elem, optional[0])
 }
 
+//----------------- JSON Functions ----------------------//
+
+// TO_JSON converts any SQL value to json
+func TO_JSON(value Expression) JsonExpression {
+	return JsonExp(Func("TO_JSON", value))
+}
+
+// TO_JSONB converts any SQL value to jsonb
+func TO_JSONB(value Expression) JsonbExpression {
+	return JsonbExp(Func("TO_JSONB", value))
+}
+
+// ARRAY_TO_JSON converts an SQL array to a JSON array
+func ARRAY_TO_JSON[E Expression](arr Array[E]) JsonExpression {
+	return JsonExp(Func("ARRAY_TO_JSON", arr))
+}
+
+// ROW_TO_JSON converts an SQL composite value to a JSON object
+func ROW_TO_JSON(row Expression) JsonExpression {
+	return JsonExp(Func("ROW_TO_JSON", row))
+}
+
+// JSON_BUILD_ARRAY builds a possibly-heterogeneously-typed JSON array out of a variadic argument list
+func JSON_BUILD_ARRAY(values ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_BUILD_ARRAY", values...))
+}
+
+// JSONB_BUILD_ARRAY builds a possibly-heterogeneously-typed JSON array out of a variadic argument list
+func JSONB_BUILD_ARRAY(values ...Expression) JsonbExpression {
+	return JsonbExp(Func("JSONB_BUILD_ARRAY", values...))
+}
+
+// JSON_BUILD_OBJECT builds a JSON object out of a variadic argument list. By convention, the argument list
+// consists of alternating keys and values.
+//
+//	JSON_BUILD_OBJECT(String("id"), Film.FilmID, String("title"), Film.Title)
+func JSON_BUILD_OBJECT(keyValues ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_BUILD_OBJECT", keyValues...))
+}
+
+// JSONB_BUILD_OBJECT builds a JSON object out of a variadic argument list. By convention, the argument list
+// consists of alternating keys and values.
+func JSONB_BUILD_OBJECT(keyValues ...Expression) JsonbExpression {
+	return JsonbExp(Func("JSONB_BUILD_OBJECT", keyValues...))
+}
+
+// JSON_AGG is aggregate function. Collects all the input values, including nulls, into a JSON array.
+func JSON_AGG(value Expression) JsonExpression {
+	return JsonExp(Func("JSON_AGG", value))
+}
+
+// JSONB_AGG is aggregate function. Collects all the input values, including nulls, into a JSON array.
+func JSONB_AGG(value Expression) JsonbExpression {
+	return JsonbExp(Func("JSONB_AGG", value))
+}
+
+// JSON_OBJECT_AGG is aggregate function. Collects all the key/value pairs into a JSON object.
+func JSON_OBJECT_AGG(key, value Expression) JsonExpression {
+	return JsonExp(Func("JSON_OBJECT_AGG", key, value))
+}
+
+// JSONB_OBJECT_AGG is aggregate function. Collects all the key/value pairs into a JSON object.
+func JSONB_OBJECT_AGG(key, value Expression) JsonbExpression {
+	return JsonbExp(Func("JSONB_OBJECT_AGG", key, value))
+}
+
+// JSON_ARRAY_LENGTH returns the number of elements in the top-level JSON array
+func JSON_ARRAY_LENGTH(json JsonExpression) IntegerExpression {
+	return IntExp(Func("JSON_ARRAY_LENGTH", json))
+}
+
+// JSONB_ARRAY_LENGTH returns the number of elements in the top-level JSON array
+func JSONB_ARRAY_LENGTH(jsonb JsonbExpression) IntegerExpression {
+	return IntExp(Func("JSONB_ARRAY_LENGTH", jsonb))
+}
+
+// JSON_TYPEOF returns the type of the top-level JSON value as a text string. Possible types are
+// object, array, string, number, boolean, and null.
+func JSON_TYPEOF(json JsonExpression) StringExpression {
+	return StringExp(Func("JSON_TYPEOF", json))
+}
+
+// JSONB_TYPEOF returns the type of the top-level JSON value as a text string. Possible types are
+// object, array, string, number, boolean, and null.
+func JSONB_TYPEOF(jsonb JsonbExpression) StringExpression {
+	return StringExp(Func("JSONB_TYPEOF", jsonb))
+}
+
+// JSON_EXTRACT_PATH extracts JSON sub-object at the specified path. (This is functionally equivalent
+// to the #> operator, but writing the path out as a variadic list can be more convenient in some cases.)
+func JSON_EXTRACT_PATH(json JsonExpression, path ...StringExpression) JsonExpression {
+	return JsonExp(Func("JSON_EXTRACT_PATH", append([]Expression{json}, jet.ToExpressionList(path)...)...))
+}
+
+// JSONB_EXTRACT_PATH extracts JSON sub-object at the specified path.
+func JSONB_EXTRACT_PATH(jsonb JsonbExpression, path ...StringExpression) JsonbExpression {
+	return JsonbExp(Func("JSONB_EXTRACT_PATH", append([]Expression{jsonb}, jet.ToExpressionList(path)...)...))
+}
+
+// JSON_EXTRACT_PATH_TEXT extracts JSON sub-object at the specified path as text.
+func JSON_EXTRACT_PATH_TEXT(json JsonExpression, path ...StringExpression) StringExpression {
+	return StringExp(Func("JSON_EXTRACT_PATH_TEXT", append([]Expression{json}, jet.ToExpressionList(path)...)...))
+}
+
+// JSONB_EXTRACT_PATH_TEXT extracts JSON sub-object at the specified path as text.
+func JSONB_EXTRACT_PATH_TEXT(jsonb JsonbExpression, path ...StringExpression) StringExpression {
+	return StringExp(Func("JSONB_EXTRACT_PATH_TEXT", append([]Expression{jsonb}, jet.ToExpressionList(path)...)...))
+}
+
+// JSON_STRIP_NULLS deletes all object fields that have null values from the given JSON value, recursively.
+func JSON_STRIP_NULLS(json JsonExpression) JsonExpression {
+	return JsonExp(Func("JSON_STRIP_NULLS", json))
+}
+
+// JSONB_STRIP_NULLS deletes all object fields that have null values from the given JSON value, recursively.
+func JSONB_STRIP_NULLS(jsonb JsonbExpression) JsonbExpression {
+	return JsonbExp(Func("JSONB_STRIP_NULLS", jsonb))
+}
+
+// JSONB_SET returns target with the item designated by path replaced by newValue, or with newValue added if
+// createIfMissing is true (which is the default) and the item designated by path does not exist.
+func JSONB_SET(target JsonbExpression, path Array[StringExpression], newValue JsonbExpression, createIfMissing ...bool) JsonbExpression {
+	args := []Expression{target, path, newValue}
+
+	if len(createIfMissing) > 0 {
+		args = append(args, Bool(createIfMissing[0]))
+	}
+
+	return JsonbExp(Func("JSONB_SET", args...))
+}
+
+// JSONB_INSERT returns target with newValue inserted. If the item designated by the path is an array element,
+// newValue will be inserted before that item if insertAfter is false (which is the default), or after it if
+// insertAfter is true.
+func JSONB_INSERT(target JsonbExpression, path Array[StringExpression], newValue JsonbExpression, insertAfter ...bool) JsonbExpression {
+	args := []Expression{target, path, newValue}
+
+	if len(insertAfter) > 0 {
+		args = append(args, Bool(insertAfter[0]))
+	}
+
+	return JsonbExp(Func("JSONB_INSERT", args...))
+}
+
+// JSONB_PRETTY converts the given JSON value to pretty-printed, indented text.
+func JSONB_PRETTY(jsonb JsonbExpression) StringExpression {
+	return StringExp(Func("JSONB_PRETTY", jsonb))
+}
+
+// JSONB_PATH_EXISTS checks whether the JSON path returns any item for the specified JSON value.
+// If the vars argument is specified, it must be a JSON object, and its fields provide named values
+// to be substituted into the jsonpath expression.
+func JSONB_PATH_EXISTS(target JsonbExpression, path StringExpression, vars ...JsonbExpression) BoolExpression {
+	return BoolExp(Func("JSONB_PATH_EXISTS", optionalAppend([]Expression{target, path}, vars)...))
+}
+
+// JSONB_PATH_MATCH returns the result of a JSON path predicate check for the specified JSON value.
+func JSONB_PATH_MATCH(target JsonbExpression, path StringExpression, vars ...JsonbExpression) BoolExpression {
+	return BoolExp(Func("JSONB_PATH_MATCH", optionalAppend([]Expression{target, path}, vars)...))
+}
+
+// JSONB_PATH_QUERY returns all JSON items returned by the JSON path for the specified JSON value.
+func JSONB_PATH_QUERY(target JsonbExpression, path StringExpression, vars ...JsonbExpression) JsonbExpression {
+	return JsonbExp(Func("JSONB_PATH_QUERY", optionalAppend([]Expression{target, path}, vars)...))
+}
+
+// JSONB_PATH_QUERY_ARRAY returns all JSON items returned by the JSON path for the specified JSON value, as a JSON array.
+func JSONB_PATH_QUERY_ARRAY(target JsonbExpression, path StringExpression, vars ...JsonbExpression) JsonbExpression {
+	return JsonbExp(Func("JSONB_PATH_QUERY_ARRAY", optionalAppend([]Expression{target, path}, vars)...))
+}
+
+// JSONB_PATH_QUERY_FIRST returns the first JSON item returned by the JSON path for the specified JSON value,
+// or NULL if there are no results.
+func JSONB_PATH_QUERY_FIRST(target JsonbExpression, path StringExpression, vars ...JsonbExpression) JsonbExpression {
+	return JsonbExp(Func("JSONB_PATH_QUERY_FIRST", optionalAppend([]Expression{target, path}, vars)...))
+}
+
 //---------- Data Type Formatting Functions ----------------------//
 
 // TO_CHAR converts expression to string with format
@@ -558,6 +777,90 @@ func GENERATE_SERIES(start Expression, stop Expression, step ...Expression) Expr
 	return Func("GENERATE_SERIES", start, stop)
 }
 
+// ----------------- Text Search Functions -------------------//
+
+// TO_TSVECTOR converts document text (or json/jsonb values) to tsvector, using optional text search configuration.
+// If configuration is omitted default_text_search_config is used.
+func TO_TSVECTOR(document Expression, config ...string) TsVectorExpression {
+	return TsVectorExp(Func("TO_TSVECTOR", optionalConfig(config, document)...))
+}
+
+// TO_TSQUERY converts text to a tsquery, normalizing words according to the optional text search configuration.
+// The words must be combined by valid tsquery operators.
+func TO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(Func("TO_TSQUERY", optionalConfig(config, query)...))
+}
+
+// PLAINTO_TSQUERY converts text to a tsquery, normalizing words according to the optional text search configuration.
+// Any punctuation in the string is ignored, and & (AND) operator is inserted between surviving words.
+func PLAINTO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(Func("PLAINTO_TSQUERY", optionalConfig(config, query)...))
+}
+
+// PHRASETO_TSQUERY converts text to a tsquery, normalizing words according to the optional text search configuration.
+// Any punctuation in the string is ignored, and <-> (FOLLOWED BY) operator is inserted between surviving words.
+func PHRASETO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(Func("PHRASETO_TSQUERY", optionalConfig(config, query)...))
+}
+
+// WEBSEARCH_TO_TSQUERY converts text to a tsquery, normalizing words according to the optional text search
+// configuration. Quoted word sequences are converted to phrase tests, "or" is converted to the | (OR) operator,
+// and "-" is converted to the ! (NOT) operator.
+func WEBSEARCH_TO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(Func("WEBSEARCH_TO_TSQUERY", optionalConfig(config, query)...))
+}
+
+// TS_RANK computes a score showing how well the vector matches the query, with optional normalization.
+func TS_RANK(vector TsVectorExpression, query TsQueryExpression, normalization ...IntegerExpression) FloatExpression {
+	return FloatExp(Func("TS_RANK", optionalAppend([]Expression{vector, query}, normalization)...))
+}
+
+// TS_RANK_CD computes a score showing how well the vector matches the query, using a cover density algorithm.
+func TS_RANK_CD(vector TsVectorExpression, query TsQueryExpression, normalization ...IntegerExpression) FloatExpression {
+	return FloatExp(Func("TS_RANK_CD", optionalAppend([]Expression{vector, query}, normalization)...))
+}
+
+// TS_HEADLINE displays, in an abbreviated form, the match(es) for the query in the document, which must be raw text
+// not a tsvector. Options are given as a string of comma-separated option=value pairs,
+// for instance: "MaxWords=35, MinWords=15".
+func TS_HEADLINE(document StringExpression, query TsQueryExpression, options ...string) StringExpression {
+	args := []Expression{document, query}
+
+	if len(options) > 0 {
+		args = append(args, jet.FixedLiteral(options[0]))
+	}
+
+	return StringExp(Func("TS_HEADLINE", args...))
+}
+
+// SETWEIGHT assigns the specified weight ('A', 'B', 'C' or 'D') to each element of the vector.
+func SETWEIGHT(vector TsVectorExpression, weight string) TsVectorExpression {
+	return TsVectorExp(Func("SETWEIGHT", vector, jet.FixedLiteral(weight)))
+}
+
+// STRIP removes positions and weights from the tsvector.
+func STRIP(vector TsVectorExpression) TsVectorExpression {
+	return TsVectorExp(Func("STRIP", vector))
+}
+
+// NUMNODE returns the number of nodes (lexemes plus operators) in a tsquery.
+func NUMNODE(query TsQueryExpression) IntegerExpression {
+	return IntExp(Func("NUMNODE", query))
+}
+
+// QUERYTREE produces a representation of the indexable portion of a tsquery.
+func QUERYTREE(query TsQueryExpression) StringExpression {
+	return StringExp(Func("QUERYTREE", query))
+}
+
+func optionalConfig(config []string, arg Expression) []Expression {
+	if len(config) > 0 {
+		return []Expression{jet.FixedLiteral(config[0]), arg}
+	}
+
+	return []Expression{arg}
+}
+
 // --------------- Conditional Expressions Functions -------------//
 
 // COALESCE function returns the first of its arguments that is not null.
@@ -593,6 +896,14 @@ func PERCENTILE_DISC(fraction FloatExpression) *jet.OrderSetAggregateFunc {
 	return jet.PERCENTILE_DISC(fraction)
 }
 
+// ------------------ Merge Support Functions --------------------//
+
+// MERGE_ACTION returns the merge action command executed for the current row ('INSERT', 'UPDATE' or 'DELETE').
+// It can be used only in the RETURNING list of a MERGE statement (PostgreSQL 17+).
+func MERGE_ACTION() StringExpression {
+	return StringExp(Func("merge_action"))
+}
+
 // ----------------- Group By operators --------------------------//
 
 // GROUPING_SETS operator allows grouping of the rows in a table by multiple sets of columns(or expressions) in a single query.
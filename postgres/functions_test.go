@@ -73,3 +73,86 @@ func TestArrayFunctions(t *testing.T) {
 		int64(6),
 	)
 }
+
+func TestJsonFunctions(t *testing.T) {
+	jsonColumn := JsonColumn("json_col")
+	jsonbColumn := JsonbColumn("jsonb_col")
+
+	assertSerialize(t, TO_JSON(table2ColStr), "TO_JSON(table2.col_str)")
+	assertSerialize(t, TO_JSONB(table2ColStr).CONTAINS(jsonbColumn), "(TO_JSONB(table2.col_str) @> jsonb_col)")
+	assertSerialize(t, JSON_BUILD_OBJECT(String("id"), table1Col1, String("name"), table2ColStr),
+		"JSON_BUILD_OBJECT($1::text, table1.col1, $2::text, table2.col_str)", "id", "name")
+	assertSerialize(t, JSONB_BUILD_OBJECT(String("id"), table1Col1).GET_TEXT(String("id")),
+		"(JSONB_BUILD_OBJECT($1::text, table1.col1) ->> $2::text)", "id", "id")
+	assertSerialize(t, JSONB_BUILD_ARRAY(Int(1), Int(2)), "JSONB_BUILD_ARRAY($1, $2)", int64(1), int64(2))
+	assertSerialize(t, JSONB_AGG(table2ColStr), "JSONB_AGG(table2.col_str)")
+	assertSerialize(t, JSON_OBJECT_AGG(table2ColStr, table1Col1), "JSON_OBJECT_AGG(table2.col_str, table1.col1)")
+	assertSerialize(t, JSON_ARRAY_LENGTH(jsonColumn).GT(Int(2)), "(JSON_ARRAY_LENGTH(json_col) > $1)", int64(2))
+	assertSerialize(t, JSONB_TYPEOF(jsonbColumn).EQ(String("object")), "(JSONB_TYPEOF(jsonb_col) = $1::text)", "object")
+	assertSerialize(t, JSON_EXTRACT_PATH_TEXT(jsonColumn, String("a"), String("b")),
+		"JSON_EXTRACT_PATH_TEXT(json_col, $1::text, $2::text)", "a", "b")
+	assertSerialize(t, JSONB_STRIP_NULLS(jsonbColumn), "JSONB_STRIP_NULLS(jsonb_col)")
+	assertSerialize(t, TO_JSON(table2ColStr).GET_PATH_TEXT(StringArray("a", "b")),
+		"(TO_JSON(table2.col_str) #>> $1::text[])", pq.StringArray{"a", "b"})
+}
+
+func TestJsonbModificationFunctions(t *testing.T) {
+	jsonbColumn := JsonbColumn("jsonb_col")
+
+	assertSerialize(t, JSONB_SET(jsonbColumn, StringArray("a", "b"), Jsonb(`{"c": 1}`)),
+		"JSONB_SET(jsonb_col, $1::text[], $2::jsonb)", pq.StringArray{"a", "b"}, `{"c": 1}`)
+	assertSerialize(t, JSONB_SET(jsonbColumn, StringArray("a"), TO_JSONB(Int(2)), false),
+		"JSONB_SET(jsonb_col, $1::text[], TO_JSONB($2), $3::boolean)", pq.StringArray{"a"}, int64(2), false)
+	assertSerialize(t, JSONB_INSERT(jsonbColumn, StringArray("a", "0"), Jsonb(`"new"`), true),
+		"JSONB_INSERT(jsonb_col, $1::text[], $2::jsonb, $3::boolean)", pq.StringArray{"a", "0"}, `"new"`, true)
+	assertSerialize(t, JSONB_PRETTY(jsonbColumn), "JSONB_PRETTY(jsonb_col)")
+}
+
+func TestJsonbPathFunctions(t *testing.T) {
+	jsonbColumn := JsonbColumn("jsonb_col")
+
+	assertSerialize(t, JSONB_PATH_EXISTS(jsonbColumn, String("$.a[*] ? (@ > 2)")),
+		"JSONB_PATH_EXISTS(jsonb_col, $1::text)", "$.a[*] ? (@ > 2)")
+	assertSerialize(t, JSONB_PATH_MATCH(jsonbColumn, String("$.a[*] > $min"), Jsonb(`{"min": 2}`)),
+		"JSONB_PATH_MATCH(jsonb_col, $1::text, $2::jsonb)", "$.a[*] > $min", `{"min": 2}`)
+	assertSerialize(t, JSONB_PATH_QUERY(jsonbColumn, String("$.a[*]")), "JSONB_PATH_QUERY(jsonb_col, $1::text)", "$.a[*]")
+	assertSerialize(t, JSONB_PATH_QUERY_ARRAY(jsonbColumn, String("$.a[*]")), "JSONB_PATH_QUERY_ARRAY(jsonb_col, $1::text)", "$.a[*]")
+	assertSerialize(t, JSONB_PATH_QUERY_FIRST(jsonbColumn, String("$.a[*]")), "JSONB_PATH_QUERY_FIRST(jsonb_col, $1::text)", "$.a[*]")
+}
+
+func TestAggregateFunctions(t *testing.T) {
+	assertSerialize(t, COUNT(STAR).FILTER(table2ColInt.GT(Int(10))), `COUNT(*) FILTER (WHERE table2.col_int > $1)`, int64(10))
+	assertSerialize(t, COUNT(table2ColStr).DISTINCT(), `COUNT(DISTINCT table2.col_str)`)
+	assertSerialize(t, ARRAY_AGG(table2ColInt).ORDER_BY(table2ColFloat.DESC()),
+		`ARRAY_AGG(table2.col_int ORDER BY table2.col_float DESC)`)
+	assertSerialize(t, STRING_AGG(table2ColStr, String(", ")).DISTINCT().ORDER_BY(table2ColStr).FILTER(table2ColInt.IS_NOT_NULL()),
+		`STRING_AGG(DISTINCT table2.col_str, $1::text ORDER BY table2.col_str) FILTER (WHERE table2.col_int IS NOT NULL)`, ", ")
+	assertSerialize(t, SUMf(table2ColFloat).FILTER(table2ColInt.LT(Int(5))).OVER(PARTITION_BY(table2ColStr).ORDER_BY(table2ColInt)),
+		`SUM(table2.col_float) FILTER (WHERE table2.col_int < $1) OVER (PARTITION BY table2.col_str ORDER BY table2.col_int)`, int64(5))
+
+	var maxAggregate AggregateExpression = MAX(table2ColInt)
+	assertSerialize(t, maxAggregate.FILTER(table2ColBool).OVER(), `MAX(table2.col_int) FILTER (WHERE table2.col_bool) OVER ()`)
+}
+
+func TestTextSearchFunctions(t *testing.T) {
+	tsVectorColumn := TsVectorColumn("tsvector_col")
+
+	assertSerialize(t, TO_TSVECTOR(table2ColStr), "TO_TSVECTOR(table2.col_str)")
+	assertSerialize(t, TO_TSVECTOR(table2ColStr, "english").MATCH(TO_TSQUERY(String("cat & rat"), "english")),
+		"(TO_TSVECTOR('english', table2.col_str) @@ TO_TSQUERY('english', $1::text))", "cat & rat")
+	assertSerialize(t, tsVectorColumn.MATCH(PLAINTO_TSQUERY(String("fat rats"))),
+		"(tsvector_col @@ PLAINTO_TSQUERY($1::text))", "fat rats")
+	assertSerialize(t, PHRASETO_TSQUERY(String("fat rats"), "simple"), "PHRASETO_TSQUERY('simple', $1::text)", "fat rats")
+	assertSerialize(t, WEBSEARCH_TO_TSQUERY(String(`"sad cat" or -rat`), "english"),
+		"WEBSEARCH_TO_TSQUERY('english', $1::text)", `"sad cat" or -rat`)
+	assertSerialize(t, TS_RANK(tsVectorColumn, TO_TSQUERY(String("cat"))), "TS_RANK(tsvector_col, TO_TSQUERY($1::text))", "cat")
+	assertSerialize(t, TS_RANK_CD(tsVectorColumn, TO_TSQUERY(String("cat")), Int(32)),
+		"TS_RANK_CD(tsvector_col, TO_TSQUERY($1::text), $2)", "cat", int64(32))
+	assertSerialize(t, TS_HEADLINE(table2ColStr, TO_TSQUERY(String("cat"))), "TS_HEADLINE(table2.col_str, TO_TSQUERY($1::text))", "cat")
+	assertSerialize(t, TS_HEADLINE(table2ColStr, TO_TSQUERY(String("cat")), "MaxWords=10, MinWords=5"),
+		"TS_HEADLINE(table2.col_str, TO_TSQUERY($1::text), 'MaxWords=10, MinWords=5')", "cat")
+	assertSerialize(t, SETWEIGHT(TO_TSVECTOR(table2ColStr), "A").CONCAT(STRIP(tsVectorColumn)),
+		"(SETWEIGHT(TO_TSVECTOR(table2.col_str), 'A') || STRIP(tsvector_col))")
+	assertSerialize(t, NUMNODE(TsQuery("cat & rat")), "NUMNODE($1::tsquery)", "cat & rat")
+	assertSerialize(t, QUERYTREE(TsQuery("cat & !rat")), "QUERYTREE($1::tsquery)", "cat & !rat")
+}
@@ -17,6 +17,7 @@ type InsertStatement interface {
 	QUERY(query jet.SerializerStatement) InsertStatement
 
 	ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict
+	ON_CONFLICT_TARGET(target ConflictTarget) conflictTarget
 
 	RETURNING(projections ...Projection) InsertStatement
 }
@@ -77,3 +78,13 @@ func (i *insertStatementImpl) ON_CONFLICT(indexExpressions ...jet.ColumnExpressi
 	}
 	return &i.OnConflict
 }
+
+func (i *insertStatementImpl) ON_CONFLICT_TARGET(target ConflictTarget) conflictTarget {
+	onConflict := i.ON_CONFLICT(target.Columns...)
+
+	if target.Predicate != nil {
+		return onConflict.WHERE(target.Predicate)
+	}
+
+	return onConflict
+}
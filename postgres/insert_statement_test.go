@@ -174,6 +174,31 @@ RETURNING table1.col1 AS "table1.col1",
 `)
 }
 
+func TestInsert_ON_CONFLICT_TARGET(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1ColBool).
+		VALUES("one", "two").
+		ON_CONFLICT_TARGET(NewConflictTarget(nil, table1Col1, table1ColBool)).
+		DO_NOTHING()
+
+	assertDebugStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_bool)
+VALUES ('one', 'two')
+ON CONFLICT (col1, col_bool) DO NOTHING;
+`)
+
+	stmt = table1.INSERT(table1Col1, table1ColBool).
+		VALUES("one", "two").
+		ON_CONFLICT_TARGET(NewConflictTarget(RawBool("col_bool = true"), table1Col1)).
+		DO_UPDATE(SET(table1ColBool.SET(Bool(false))))
+
+	assertDebugStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_bool)
+VALUES ('one', 'two')
+ON CONFLICT (col1) WHERE col_bool = true DO UPDATE
+       SET col_bool = FALSE::boolean;
+`)
+}
+
 func TestInsert_ON_CONFLICT_ON_CONSTRAINT(t *testing.T) {
 	stmt := table1.INSERT(table1Col1, table1ColBool).
 		VALUES("one", "two").
@@ -119,13 +119,33 @@ func VarChar(length ...int) func(value string) StringExpression {
 }
 
 // Json creates new json literal expression
-func Json(value interface{}) StringExpression {
+func Json(value interface{}) JsonExpression {
 	switch value.(type) {
 	case string, []byte:
 	default:
-		panic("Bytea parameter value has to be of the type string or []byte")
+		panic("Json parameter value has to be of the type string or []byte")
+	}
+	return CAST(jet.Literal(value)).AS_JSON()
+}
+
+// Jsonb creates new jsonb literal expression
+func Jsonb(value interface{}) JsonbExpression {
+	switch value.(type) {
+	case string, []byte:
+	default:
+		panic("Jsonb parameter value has to be of the type string or []byte")
 	}
-	return StringExp(CAST(jet.Literal(value)).AS("json"))
+	return CAST(jet.Literal(value)).AS_JSONB()
+}
+
+// TsVector creates new tsvector literal expression from the already normalized document
+func TsVector(value string) TsVectorExpression {
+	return CAST(jet.Literal(value)).AS_TSVECTOR()
+}
+
+// TsQuery creates new tsquery literal expression from the already normalized query
+func TsQuery(value string) TsQueryExpression {
+	return CAST(jet.Literal(value)).AS_TSQUERY()
 }
 
 // UUID is a helper function to create string literal expression from uuid object
@@ -224,6 +244,12 @@ func StringArray(values ...string) Array[StringExpression] {
 	return CAST(jet.Literal(pq.StringArray(values))).AS_TEXT_ARRAY()
 }
 
+// EnumArray creates new enum array literal expression of the enumType enum type from list of values,
+// for instance EnumArray("mood", "sad", "happy")
+func EnumArray(enumType string, values ...string) Array[EnumExpression] {
+	return ArrayExp[EnumExpression](CAST(jet.Literal(pq.StringArray(values))).AS(enumType + "[]"))
+}
+
 // ByteaArray creates new bytea array literal expression from list of values
 func ByteaArray(values ...[]byte) Array[ByteaExpression] {
 	return CAST(jet.Literal(pq.ByteaArray(values))).AS_BYTEA_ARRAY()
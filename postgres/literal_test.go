@@ -4,6 +4,8 @@ import (
 	"math"
 	"testing"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 func TestBool(t *testing.T) {
@@ -81,6 +83,27 @@ func TestJson(t *testing.T) {
 	assertSerialize(t, Json([]byte("{\"key\": \"value\"}")), `$1::json`, []byte("{\"key\": \"value\"}"))
 }
 
+func TestJsonAsStringExpression(t *testing.T) {
+	// json literals were string expressions, before json expression type was added
+	var jsonString StringExpression = Json(`{"key": "value"}`)
+
+	assertSerialize(t, jsonString, `$1::json`, `{"key": "value"}`)
+	assertSerialize(t, table2ColStr.EQ(Json(`{}`)), `(table2.col_str = $1::json)`, `{}`)
+	assertSerialize(t, Json(`{}`).CONCAT(String("a")), `($1::json || $2::text)`, `{}`, "a")
+	assertSerialize(t, LOWER(Json(`{}`).GET_TEXT(String("key"))), `LOWER($1::json ->> $2::text)`, `{}`, "key")
+	assertSerialize(t, table2ColStr.SET(Json(`{}`)), `col_str = $1::json`, `{}`)
+}
+
+func TestJsonb(t *testing.T) {
+	assertSerialize(t, Jsonb("{\"key\": \"value\"}"), `$1::jsonb`, "{\"key\": \"value\"}")
+	assertSerialize(t, Jsonb([]byte("{\"key\": \"value\"}")).GET(String("key")), `($1::jsonb -> $2::text)`, []byte("{\"key\": \"value\"}"), "key")
+}
+
+func TestTsVector(t *testing.T) {
+	assertSerialize(t, TsVector("a fat cat"), `$1::tsvector`, "a fat cat")
+	assertSerialize(t, TsVector("a:1 fat:2 cat:3").MATCH(TsQuery("cat & fat")), `($1::tsvector @@ $2::tsquery)`, "a:1 fat:2 cat:3", "cat & fat")
+}
+
 func TestDate(t *testing.T) {
 	assertSerialize(t, Date(2014, time.January, 2), `$1::date`, "2014-01-02")
 	assertSerialize(t, DateT(time.Now()), `$1::date`)
@@ -108,3 +131,11 @@ func TestTimestampz(t *testing.T) {
 		`$1::timestamp with time zone`, "2010-03-30 10:15:30 UTC")
 	assertSerialize(t, TimestampzT(time.Now()), `$1::timestamp with time zone`)
 }
+
+func TestEnumArray(t *testing.T) {
+	moods := EnumArrayColumn("moods")
+
+	assertSerialize(t, EnumArray("mood", "sad", "happy"), `$1::mood[]`, pq.StringArray{"sad", "happy"})
+	assertSerialize(t, moods.CONTAINS(EnumArray("mood", "happy")), `(moods @> $1::mood[])`, pq.StringArray{"happy"})
+	assertSerialize(t, moods.AT(Int(1)).EQ(NewEnumValue("sad")), `(moods[$1] = 'sad')`, int64(1))
+}
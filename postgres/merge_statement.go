@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/internal/utils/is"
+)
+
+// MergeStatement is interface for PostgreSQL MERGE statement.
+// MERGE statement is supported since PostgreSQL 15, WHEN_NOT_MATCHED_BY_SOURCE and RETURNING since PostgreSQL 17.
+type MergeStatement interface {
+	jet.SerializerStatement
+
+	// USING specifies the data source (table, sub-query, VALUES, etc...) to be merged into the target table
+	USING(source ReadableTable) mergeUsing
+
+	// WHEN_MATCHED adds the action for rows of the target table that have matching source row, and
+	// satisfy optional condition
+	WHEN_MATCHED(condition ...BoolExpression) mergeMatched
+	// WHEN_NOT_MATCHED adds the action for source rows without matching target table row, that
+	// satisfy optional condition
+	WHEN_NOT_MATCHED(condition ...BoolExpression) mergeNotMatched
+	// WHEN_NOT_MATCHED_BY_SOURCE adds the action for target table rows without matching source row, that
+	// satisfy optional condition
+	WHEN_NOT_MATCHED_BY_SOURCE(condition ...BoolExpression) mergeMatched
+
+	RETURNING(projections ...Projection) MergeStatement
+}
+
+type mergeUsing interface {
+	ON(condition BoolExpression) MergeStatement
+}
+
+type mergeMatched interface {
+	THEN_UPDATE(assigments ...ColumnAssigment) MergeStatement
+	THEN_DELETE() MergeStatement
+	THEN_DO_NOTHING() MergeStatement
+}
+
+type mergeNotMatched interface {
+	THEN_INSERT(columns ...jet.Column) mergeInsert
+	THEN_INSERT_DEFAULT_VALUES() MergeStatement
+	THEN_DO_NOTHING() MergeStatement
+}
+
+type mergeInsert interface {
+	VALUES(value interface{}, values ...interface{}) MergeStatement
+	MODEL(data interface{}) MergeStatement
+}
+
+type mergeStatementImpl struct {
+	jet.SerializerStatement
+
+	MergeInto clauseMergeInto
+	Using     clauseMergeUsing
+	When      clauseMergeWhenList
+	Returning jet.ClauseReturning
+}
+
+func newMergeStatement(table WritableTable) MergeStatement {
+	newMerge := &mergeStatementImpl{}
+	newMerge.SerializerStatement = jet.NewStatementImpl(Dialect, jet.MergeStatementType, newMerge,
+		&newMerge.MergeInto,
+		&newMerge.Using,
+		&newMerge.When,
+		&newMerge.Returning)
+
+	newMerge.MergeInto.Table = table
+
+	return newMerge
+}
+
+func (m *mergeStatementImpl) USING(source ReadableTable) mergeUsing {
+	m.Using.Source = source
+	return m
+}
+
+func (m *mergeStatementImpl) ON(condition BoolExpression) MergeStatement {
+	m.Using.On = condition
+	return m
+}
+
+func (m *mergeStatementImpl) WHEN_MATCHED(condition ...BoolExpression) mergeMatched {
+	return m.when("WHEN MATCHED", condition)
+}
+
+func (m *mergeStatementImpl) WHEN_NOT_MATCHED(condition ...BoolExpression) mergeNotMatched {
+	return m.when("WHEN NOT MATCHED", condition)
+}
+
+func (m *mergeStatementImpl) WHEN_NOT_MATCHED_BY_SOURCE(condition ...BoolExpression) mergeMatched {
+	return m.when("WHEN NOT MATCHED BY SOURCE", condition)
+}
+
+func (m *mergeStatementImpl) when(keyword string, condition []BoolExpression) *clauseMergeWhen {
+	when := &clauseMergeWhen{
+		mergeStatement: m,
+		keyword:        keyword,
+	}
+
+	if len(condition) > 0 {
+		when.condition = condition[0]
+	}
+
+	m.When = append(m.When, when)
+
+	return when
+}
+
+func (m *mergeStatementImpl) RETURNING(projections ...jet.Projection) MergeStatement {
+	m.Returning.ProjectionList = projections
+	return m
+}
+
+type clauseMergeInto struct {
+	Table WritableTable
+}
+
+func (i *clauseMergeInto) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if is.Nil(i.Table) {
+		panic("jet: table is nil for MERGE INTO clause")
+	}
+
+	out.NewLine()
+	out.WriteString("MERGE INTO")
+	jet.Serialize(i.Table, statementType, out)
+}
+
+type clauseMergeUsing struct {
+	Source ReadableTable
+	On     BoolExpression
+}
+
+func (u *clauseMergeUsing) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if is.Nil(u.Source) {
+		panic("jet: USING data source is nil for MERGE statement")
+	}
+
+	if is.Nil(u.On) {
+		panic("jet: ON condition is nil for MERGE statement")
+	}
+
+	out.NewLine()
+	out.WriteString("USING")
+	jet.Serialize(u.Source, statementType, out)
+	out.WriteString("ON")
+	jet.Serialize(u.On, statementType, out, jet.NoWrap)
+}
+
+type clauseMergeWhenList []*clauseMergeWhen
+
+func (w clauseMergeWhenList) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if len(w) == 0 {
+		panic("jet: MERGE statement requires at least one WHEN clause")
+	}
+
+	for _, when := range w {
+		when.Serialize(statementType, out, options...)
+	}
+}
+
+type clauseMergeWhen struct {
+	mergeStatement *mergeStatementImpl
+
+	keyword   string
+	condition BoolExpression
+	action    jet.Serializer
+
+	// THEN_INSERT
+	insertColumns []jet.Column
+	insertValues  jet.ClauseValues
+}
+
+func (w *clauseMergeWhen) THEN_UPDATE(assigments ...ColumnAssigment) MergeStatement {
+	w.action = jet.NewSerializerClauseImpl(&jet.KeywordClause{Keyword: "UPDATE"}, jet.SetClauseNew(assigments))
+	return w.mergeStatement
+}
+
+func (w *clauseMergeWhen) THEN_DELETE() MergeStatement {
+	w.action = jet.Keyword("DELETE")
+	return w.mergeStatement
+}
+
+func (w *clauseMergeWhen) THEN_DO_NOTHING() MergeStatement {
+	w.action = jet.Keyword("DO NOTHING")
+	return w.mergeStatement
+}
+
+func (w *clauseMergeWhen) THEN_INSERT(columns ...jet.Column) mergeInsert {
+	w.insertColumns = jet.UnwidColumnList(columns)
+	w.action = jet.NewSerializerClauseImpl(&mergeInsertAction{columns: w.insertColumns, values: &w.insertValues})
+	return w
+}
+
+func (w *clauseMergeWhen) THEN_INSERT_DEFAULT_VALUES() MergeStatement {
+	w.action = jet.Keyword("INSERT DEFAULT VALUES")
+	return w.mergeStatement
+}
+
+func (w *clauseMergeWhen) VALUES(value interface{}, values ...interface{}) MergeStatement {
+	w.insertValues.Rows = [][]jet.Serializer{jet.UnwindRowFromValues(value, values)}
+	return w.mergeStatement
+}
+
+func (w *clauseMergeWhen) MODEL(data interface{}) MergeStatement {
+	w.insertValues.Rows = [][]jet.Serializer{jet.UnwindRowFromModel(w.insertColumns, data)}
+	return w.mergeStatement
+}
+
+func (w *clauseMergeWhen) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if is.Nil(w.action) {
+		panic("jet: " + w.keyword + " clause is missing THEN action")
+	}
+
+	out.NewLine()
+	out.WriteString(w.keyword)
+
+	if w.condition != nil {
+		out.WriteString("AND")
+		jet.Serialize(w.condition, statementType, out, jet.NoWrap)
+	}
+
+	out.WriteString("THEN")
+
+	out.IncreaseIdent(7)
+	jet.Serialize(w.action, statementType, out)
+	out.DecreaseIdent(7)
+}
+
+// mergeInsertAction serializes INSERT action of the WHEN NOT MATCHED clause. Insert without
+// column list and values is serialized as INSERT DEFAULT VALUES.
+type mergeInsertAction struct {
+	columns []jet.Column
+	values  *jet.ClauseValues
+}
+
+func (i *mergeInsertAction) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if len(i.values.Rows) == 0 {
+		if len(i.columns) > 0 {
+			panic("jet: VALUES or MODEL is missing for MERGE INSERT action")
+		}
+
+		out.WriteString("INSERT DEFAULT VALUES")
+		return
+	}
+
+	out.WriteString("INSERT")
+
+	if len(i.columns) > 0 {
+		out.WriteString("(")
+		jet.SerializeColumnNames(i.columns, out)
+		out.WriteString(")")
+	}
+
+	i.values.Serialize(statementType, out, options...)
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestInvalidMerge(t *testing.T) {
+	assertStatementSqlErr(t, table1.MERGE(), "jet: USING data source is nil for MERGE statement")
+	assertStatementSqlErr(t, table1.MERGE().USING(table2).ON(nil), "jet: ON condition is nil for MERGE statement")
+	assertStatementSqlErr(t, table1.MERGE().USING(table2).ON(table1ColInt.EQ(table2ColInt)),
+		"jet: MERGE statement requires at least one WHEN clause")
+
+	stmt := table1.MERGE().USING(table2).ON(table1ColInt.EQ(table2ColInt))
+	stmt.WHEN_NOT_MATCHED().THEN_INSERT(table1ColInt)
+	assertStatementSqlErr(t, stmt, "jet: VALUES or MODEL is missing for MERGE INSERT action")
+}
+
+func TestMergeInsertWithoutValues(t *testing.T) {
+	stmt := table1.MERGE().USING(table2).ON(table1ColInt.EQ(table2ColInt))
+	stmt.WHEN_NOT_MATCHED().THEN_INSERT()
+
+	assertDebugStatementSql(t, stmt, `
+MERGE INTO db.table1
+USING db.table2 ON table1.col_int = table2.col_int
+WHEN NOT MATCHED THEN INSERT DEFAULT VALUES;
+`)
+}
+
+func TestMergeWhenMatched(t *testing.T) {
+	stmt := table1.MERGE().
+		USING(table2).ON(table1ColInt.EQ(table2ColInt)).
+		WHEN_MATCHED(table2ColFloat.GT(Float(10))).THEN_UPDATE(
+		table1ColFloat.SET(table2ColFloat),
+		table1Col1.SET(Int(2)),
+	).
+		WHEN_MATCHED().THEN_DELETE()
+
+	assertDebugStatementSql(t, stmt, `
+MERGE INTO db.table1
+USING db.table2 ON table1.col_int = table2.col_int
+WHEN MATCHED AND table2.col_float > 10 THEN UPDATE
+       SET col_float = table2.col_float,
+           col1 = 2
+WHEN MATCHED THEN DELETE;
+`)
+}
+
+func TestMergeWhenNotMatched(t *testing.T) {
+	stmt := table1.MERGE().
+		USING(table2).ON(table1ColInt.EQ(table2ColInt)).
+		WHEN_MATCHED().THEN_DO_NOTHING().
+		WHEN_NOT_MATCHED(table2ColFloat.IS_NOT_NULL()).THEN_INSERT(table1ColInt, table1ColFloat).
+		VALUES(table2ColInt, table2ColFloat).
+		WHEN_NOT_MATCHED().THEN_INSERT_DEFAULT_VALUES()
+
+	assertStatementSql(t, stmt, `
+MERGE INTO db.table1
+USING db.table2 ON table1.col_int = table2.col_int
+WHEN MATCHED THEN DO NOTHING
+WHEN NOT MATCHED AND table2.col_float IS NOT NULL THEN INSERT (col_int, col_float)
+       VALUES (table2.col_int, table2.col_float)
+WHEN NOT MATCHED THEN INSERT DEFAULT VALUES;
+`)
+}
+
+func TestMergeInsertModel(t *testing.T) {
+	type Table1Model struct {
+		ColInt   int
+		ColFloat float64
+	}
+
+	stmt := table1.MERGE().
+		USING(table2).ON(table1ColInt.EQ(table2ColInt)).
+		WHEN_NOT_MATCHED().THEN_INSERT(table1ColInt, table1ColFloat).
+		MODEL(Table1Model{ColInt: 11, ColFloat: 2.2})
+
+	assertStatementSql(t, stmt, `
+MERGE INTO db.table1
+USING db.table2 ON table1.col_int = table2.col_int
+WHEN NOT MATCHED THEN INSERT (col_int, col_float)
+       VALUES ($1, $2);
+`, 11, 2.2)
+}
+
+func TestMergeUsingSubQueryReturning(t *testing.T) {
+	source := SELECT(table2ColInt, table2ColFloat).
+		FROM(table2).
+		WHERE(table2ColInt.GT(Int(5))).
+		AsTable("source")
+
+	sourceColInt := table2ColInt.From(source)
+	sourceColFloat := table2ColFloat.From(source)
+
+	stmt := table1.MERGE().
+		USING(source).ON(table1ColInt.EQ(sourceColInt)).
+		WHEN_MATCHED().THEN_UPDATE(table1ColFloat.SET(sourceColFloat)).
+		WHEN_NOT_MATCHED().THEN_INSERT(table1ColInt, table1ColFloat).VALUES(sourceColInt, sourceColFloat).
+		WHEN_NOT_MATCHED_BY_SOURCE().THEN_DELETE().
+		RETURNING(MERGE_ACTION().AS("action"), table1ColInt)
+
+	assertDebugStatementSql(t, stmt, `
+MERGE INTO db.table1
+USING (
+     SELECT table2.col_int AS "table2.col_int",
+          table2.col_float AS "table2.col_float"
+     FROM db.table2
+     WHERE table2.col_int > 5
+) AS source ON table1.col_int = source."table2.col_int"
+WHEN MATCHED THEN UPDATE
+       SET col_float = source."table2.col_float"
+WHEN NOT MATCHED THEN INSERT (col_int, col_float)
+       VALUES (source."table2.col_int", source."table2.col_float")
+WHEN NOT MATCHED BY SOURCE THEN DELETE
+RETURNING merge_action() AS "action",
+          table1.col_int AS "table1.col_int";
+`)
+}
@@ -0,0 +1,236 @@
+// Package pgtype contains model types and helpers for PostgreSQL values, used by the generated model files.
+// It does not depend on the SQL builder, so the model packages can be imported without it.
+package pgtype
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ArrayValue is a model type for PostgreSQL array values of any element type and any number of dimensions,
+// for instance ArrayValue[Mood] for enum array or ArrayValue[[]int32] for two-dimensional integer array.
+// Array elements are scanned and converted the same way as composite type fields (see ScanComposite),
+// and elements can be sql.Scanner and driver.Valuer implementations. NULL array elements are scanned as zero
+// values, unless element type is a pointer.
+type ArrayValue[T any] []T
+
+// Scan implements sql.Scanner interface, for the array value in the text format '{elem1,elem2,...}'
+func (a *ArrayValue[T]) Scan(value interface{}) error {
+	var text string
+
+	switch val := value.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case string:
+		text = val
+	case []byte:
+		text = string(val)
+	default:
+		return fmt.Errorf("jet: invalid array value of type %T, value has to be of type string or []byte", value)
+	}
+
+	array, err := parseArray(text)
+	if err != nil {
+		return err
+	}
+
+	if err := assignArray(reflect.ValueOf(a).Elem(), array); err != nil {
+		return fmt.Errorf("jet: failed to scan array value '%s': %w", text, err)
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer interface, and returns array value in the text format '{elem1,elem2,...}'
+func (a ArrayValue[T]) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	var ret strings.Builder
+
+	if err := writeArray(&ret, reflect.ValueOf(a)); err != nil {
+		return nil, err
+	}
+
+	return ret.String(), nil
+}
+
+// arrayElement is parsed array element. Element is either nested array (for multidimensional arrays) or value.
+// NULL elements have nil value.
+type arrayElement struct {
+	isArray  bool
+	elements []arrayElement
+	value    *string
+}
+
+// parseArray parses array value in the text format, with optional dimensions decoration ('[1:2]={...}')
+func parseArray(text string) (arrayElement, error) {
+	body := text
+
+	if strings.HasPrefix(body, "[") {
+		if i := strings.Index(body, "="); i >= 0 {
+			body = body[i+1:]
+		}
+	}
+
+	array, pos, err := parseArrayElements(body, 0)
+	if err != nil {
+		return arrayElement{}, fmt.Errorf("jet: invalid array value '%s': %w", text, err)
+	}
+
+	if pos != len(body) {
+		return arrayElement{}, fmt.Errorf("jet: invalid array value '%s': unexpected text after array end", text)
+	}
+
+	return array, nil
+}
+
+func parseArrayElements(text string, pos int) (arrayElement, int, error) {
+	if pos >= len(text) || text[pos] != '{' {
+		return arrayElement{}, pos, errors.New("array has to start with '{'")
+	}
+
+	array := arrayElement{isArray: true}
+	pos++
+
+	if pos < len(text) && text[pos] == '}' {
+		return array, pos + 1, nil
+	}
+
+	for {
+		var element arrayElement
+		var err error
+
+		if pos < len(text) && text[pos] == '{' {
+			element, pos, err = parseArrayElements(text, pos)
+		} else {
+			element, pos, err = parseArrayValue(text, pos)
+		}
+
+		if err != nil {
+			return arrayElement{}, pos, err
+		}
+
+		array.elements = append(array.elements, element)
+
+		if pos >= len(text) {
+			return arrayElement{}, pos, errors.New("unterminated array")
+		}
+
+		switch text[pos] {
+		case ',':
+			pos++
+		case '}':
+			return array, pos + 1, nil
+		default:
+			return arrayElement{}, pos, fmt.Errorf("unexpected '%c'", text[pos])
+		}
+	}
+}
+
+func parseArrayValue(text string, pos int) (arrayElement, int, error) {
+	var value strings.Builder
+	isQuoted := pos < len(text) && text[pos] == '"'
+
+	if isQuoted {
+		pos++
+	}
+
+	for ; pos < len(text); pos++ {
+		c := text[pos]
+
+		switch {
+		case c == '\\' && pos+1 < len(text):
+			pos++
+			value.WriteByte(text[pos])
+		case isQuoted && c == '"':
+			ret := value.String()
+			return arrayElement{value: &ret}, pos + 1, nil
+		case !isQuoted && (c == ',' || c == '}'):
+			ret := value.String()
+			if strings.EqualFold(ret, "NULL") {
+				return arrayElement{}, pos, nil
+			}
+			return arrayElement{value: &ret}, pos, nil
+		default:
+			value.WriteByte(c)
+		}
+	}
+
+	return arrayElement{}, pos, errors.New("unterminated array element")
+}
+
+func assignArray(dest reflect.Value, array arrayElement) error {
+	slice := reflect.MakeSlice(dest.Type(), len(array.elements), len(array.elements))
+
+	for i, element := range array.elements {
+		elementDest := slice.Index(i)
+
+		if element.isArray {
+			if !isNestedArrayType(elementDest.Type()) {
+				return fmt.Errorf("array has more dimensions than destination %s", dest.Type())
+			}
+
+			if err := assignArray(elementDest, element); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := assignCompositeField(elementDest.Addr().Interface(), element.value); err != nil {
+			return fmt.Errorf("array element %d: %w", i+1, err)
+		}
+	}
+
+	dest.Set(slice)
+
+	return nil
+}
+
+func writeArray(ret *strings.Builder, array reflect.Value) error {
+	ret.WriteByte('{')
+
+	for i := 0; i < array.Len(); i++ {
+		if i > 0 {
+			ret.WriteByte(',')
+		}
+
+		element := array.Index(i)
+
+		if isNestedArrayType(element.Type()) {
+			if err := writeArray(ret, element); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, err := driver.DefaultParameterConverter.ConvertValue(element.Interface())
+		if err != nil {
+			return fmt.Errorf("jet: invalid array element %d value: %w", i+1, err)
+		}
+
+		if value == nil {
+			ret.WriteString("NULL")
+			continue
+		}
+
+		ret.WriteByte('"')
+		ret.WriteString(compositeFieldReplacer.Replace(compositeFieldText(value)))
+		ret.WriteByte('"')
+	}
+
+	ret.WriteByte('}')
+
+	return nil
+}
+
+// isNestedArrayType returns true for slice types, except byte slices, which are bytea values
+func isNestedArrayType(elementType reflect.Type) bool {
+	return elementType.Kind() == reflect.Slice && elementType.Elem().Kind() != reflect.Uint8
+}
@@ -0,0 +1,80 @@
+package pgtype
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testMood string
+
+func (m *testMood) Scan(value interface{}) error {
+	if value != "sad" && value != "happy" {
+		return errors.New("invalid mood")
+	}
+	*m = testMood(value.(string))
+	return nil
+}
+
+func TestArrayValueScan(t *testing.T) {
+	var moods ArrayValue[testMood]
+	require.NoError(t, moods.Scan([]byte("{sad,happy}")))
+	require.Equal(t, ArrayValue[testMood]{"sad", "happy"}, moods)
+	require.EqualError(t, moods.Scan("{ok}"), "jet: failed to scan array value '{ok}': array element 1: invalid mood")
+
+	var matrix ArrayValue[[]int32]
+	require.NoError(t, matrix.Scan("[0:1][1:2]={{1,2},{3,NULL}}"))
+	require.Equal(t, ArrayValue[[]int32]{{1, 2}, {3, 0}}, matrix)
+
+	var texts ArrayValue[*string]
+	require.NoError(t, texts.Scan(`{"a,b","say \"hi\"",NULL,"NULL",""}`))
+	require.Len(t, texts, 5)
+	require.Equal(t, "a,b", *texts[0])
+	require.Equal(t, `say "hi"`, *texts[1])
+	require.Nil(t, texts[2])
+	require.Equal(t, "NULL", *texts[3])
+	require.Equal(t, "", *texts[4])
+
+	require.NoError(t, texts.Scan("{}"))
+	require.Equal(t, ArrayValue[*string]{}, texts)
+	require.NoError(t, texts.Scan(nil))
+	require.Nil(t, texts)
+
+	var bytes ArrayValue[[]byte]
+	require.NoError(t, bytes.Scan(`{"\\x0102"}`))
+	require.Equal(t, ArrayValue[[]byte]{{1, 2}}, bytes)
+
+	require.EqualError(t, moods.Scan("{{sad}}"),
+		"jet: failed to scan array value '{{sad}}': array has more dimensions than destination pgtype.ArrayValue[github.com/go-jet/jet/v2/postgres/pgtype.testMood]")
+	require.EqualError(t, matrix.Scan("{{1,2}"), "jet: invalid array value '{{1,2}': unterminated array")
+	require.EqualError(t, matrix.Scan("1,2"), "jet: invalid array value '1,2': array has to start with '{'")
+	require.EqualError(t, matrix.Scan(1), "jet: invalid array value of type int, value has to be of type string or []byte")
+}
+
+func TestArrayValueValue(t *testing.T) {
+	value, err := ArrayValue[testMood]{"sad", "happy"}.Value()
+	require.NoError(t, err)
+	require.Equal(t, `{"sad","happy"}`, value)
+
+	value, err = ArrayValue[[]int32]{{1, 2}, {3, 4}}.Value()
+	require.NoError(t, err)
+	require.Equal(t, `{{"1","2"},{"3","4"}}`, value)
+
+	text := `say "hi", \o/`
+	value, err = ArrayValue[*string]{&text, nil}.Value()
+	require.NoError(t, err)
+	require.Equal(t, `{"say \"hi\", \\o/",NULL}`, value)
+
+	var matrix ArrayValue[[]int32]
+	require.NoError(t, matrix.Scan(`{{"1","2"},{"3","4"}}`))
+	require.Equal(t, ArrayValue[[]int32]{{1, 2}, {3, 4}}, matrix)
+
+	value, err = ArrayValue[string](nil).Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	value, err = ArrayValue[string]{}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "{}", value)
+}
@@ -0,0 +1,274 @@
+package pgtype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	pqformat "github.com/go-jet/jet/v2/internal/3rdparty/pq"
+)
+
+// ScanComposite parses PostgreSQL composite type value, in the text format '(field1,field2,...)', and assigns
+// the composite fields to the destinations, in the field order. Destination has to be sql.Scanner implementation,
+// or a pointer to string, []byte, bool, integer, float or time.Time value, or a pointer to a pointer to any of
+// these for the nullable fields. Nil destination skips the field. Used by the generated composite type models.
+func ScanComposite(value interface{}, dest ...interface{}) error {
+	var text string
+
+	switch val := value.(type) {
+	case nil:
+		for _, fieldDest := range dest {
+			if err := assignCompositeField(fieldDest, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	case string:
+		text = val
+	case []byte:
+		text = string(val)
+	default:
+		return fmt.Errorf("jet: invalid composite type value of type %T, value has to be of type string or []byte", value)
+	}
+
+	fields, err := parseComposite(text)
+	if err != nil {
+		return err
+	}
+
+	if len(fields) != len(dest) {
+		return fmt.Errorf("jet: composite type value '%s' has %d fields, expected %d", text, len(fields), len(dest))
+	}
+
+	for i, field := range fields {
+		if err := assignCompositeField(dest[i], field); err != nil {
+			return fmt.Errorf("jet: failed to scan composite type field %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// CompositeValue returns PostgreSQL composite type value, in the text format '(field1,field2,...)', of the field
+// values. Field value can be any value supported by database/sql driver, including driver.Valuer implementations.
+// Nil values are NULL fields. Used by the generated composite type models.
+func CompositeValue(fields ...interface{}) (driver.Value, error) {
+	var ret strings.Builder
+
+	ret.WriteByte('(')
+
+	for i, field := range fields {
+		if i > 0 {
+			ret.WriteByte(',')
+		}
+
+		value, err := driver.DefaultParameterConverter.ConvertValue(field)
+		if err != nil {
+			return nil, fmt.Errorf("jet: invalid composite type field %d value: %w", i+1, err)
+		}
+
+		if value == nil {
+			continue
+		}
+
+		ret.WriteByte('"')
+		ret.WriteString(compositeFieldReplacer.Replace(compositeFieldText(value)))
+		ret.WriteByte('"')
+	}
+
+	ret.WriteByte(')')
+
+	return ret.String(), nil
+}
+
+var compositeFieldReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func compositeFieldText(value driver.Value) string {
+	switch val := value.(type) {
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case float64:
+		return strconv.FormatFloat(val, 'g', -1, 64)
+	case bool:
+		if val {
+			return "t"
+		}
+		return "f"
+	case []byte:
+		return `\x` + hex.EncodeToString(val)
+	case time.Time:
+		return string(pqformat.FormatTimestamp(val))
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// parseComposite splits composite type value into the field values. NULL fields are returned as nil.
+func parseComposite(text string) ([]*string, error) {
+	if len(text) < 2 || text[0] != '(' || text[len(text)-1] != ')' {
+		return nil, fmt.Errorf("jet: invalid composite type value '%s'", text)
+	}
+
+	body := text[1 : len(text)-1]
+
+	var fields []*string
+
+	for pos := 0; ; pos++ {
+		var field strings.Builder
+		isNull, isQuoted := true, false
+
+		for ; pos < len(body); pos++ {
+			c := body[pos]
+
+			if !isQuoted && c == ',' {
+				break
+			}
+
+			isNull = false
+
+			switch {
+			case c == '\\' && pos+1 < len(body):
+				pos++
+				field.WriteByte(body[pos])
+			case c == '"' && isQuoted && pos+1 < len(body) && body[pos+1] == '"':
+				pos++
+				field.WriteByte('"')
+			case c == '"':
+				isQuoted = !isQuoted
+			default:
+				field.WriteByte(c)
+			}
+		}
+
+		if isQuoted {
+			return nil, fmt.Errorf("jet: invalid composite type value '%s', unterminated quoted field", text)
+		}
+
+		if isNull {
+			fields = append(fields, nil)
+		} else {
+			value := field.String()
+			fields = append(fields, &value)
+		}
+
+		if pos >= len(body) {
+			return fields, nil
+		}
+	}
+}
+
+func assignCompositeField(dest interface{}, value *string) error {
+	if dest == nil {
+		return nil
+	}
+
+	if scanner, ok := dest.(sql.Scanner); ok {
+		if value == nil {
+			return scanner.Scan(nil)
+		}
+		return scanner.Scan(*value)
+	}
+
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() {
+		return fmt.Errorf("destination has to be a non nil pointer, got %T", dest)
+	}
+
+	return assignCompositeValue(destValue.Elem(), value)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func assignCompositeValue(dest reflect.Value, value *string) error {
+	if value == nil {
+		dest.Set(reflect.Zero(dest.Type()))
+		return nil
+	}
+
+	if dest.Kind() == reflect.Ptr {
+		newValue := reflect.New(dest.Type().Elem())
+		if err := assignCompositeField(newValue.Interface(), value); err != nil {
+			return err
+		}
+		dest.Set(newValue)
+		return nil
+	}
+
+	text := *value
+
+	if dest.Type() == timeType {
+		timeValue, err := parseCompositeTime(text)
+		if err != nil {
+			return err
+		}
+		dest.Set(reflect.ValueOf(timeValue))
+		return nil
+	}
+
+	switch dest.Kind() {
+	case reflect.String:
+		dest.SetString(text)
+	case reflect.Bool:
+		boolValue, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		dest.SetBool(boolValue)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		intValue, err := strconv.ParseInt(text, 10, dest.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dest.SetInt(intValue)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		uintValue, err := strconv.ParseUint(text, 10, dest.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dest.SetUint(uintValue)
+	case reflect.Float32, reflect.Float64:
+		floatValue, err := strconv.ParseFloat(text, dest.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dest.SetFloat(floatValue)
+	case reflect.Slice:
+		if dest.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported destination type %s", dest.Type())
+		}
+		bytes, err := parseCompositeBytea(text)
+		if err != nil {
+			return err
+		}
+		dest.SetBytes(bytes)
+	default:
+		return fmt.Errorf("unsupported destination type %s", dest.Type())
+	}
+
+	return nil
+}
+
+// parseCompositeTime parses date, timestamp and time values, with or without time zone
+func parseCompositeTime(text string) (time.Time, error) {
+	if len(text) > 2 && text[2] == ':' { // time without date
+		text = "0000-01-01 " + text
+	}
+
+	return pq.ParseTimestamp(nil, text)
+}
+
+func parseCompositeBytea(text string) ([]byte, error) {
+	if !strings.HasPrefix(text, `\x`) {
+		return nil, errors.New("unsupported bytea format, only hex format is supported")
+	}
+
+	return hex.DecodeString(text[2:])
+}
@@ -0,0 +1,75 @@
+package pgtype
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanComposite(t *testing.T) {
+	var (
+		name     string
+		quantity *int32
+		price    float64
+		active   bool
+		note     *string
+		data     []byte
+		created  time.Time
+		id       uuid.UUID
+	)
+
+	err := ScanComposite([]byte(`("Main ""St"", 1\\2",,1.5,t,"",\\x0102,"2024-01-02 10:20:30+02",b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e)`),
+		&name, &quantity, &price, &active, &note, &data, &created, &id)
+	require.NoError(t, err)
+
+	require.Equal(t, `Main "St", 1\2`, name)
+	require.Nil(t, quantity)
+	require.Equal(t, 1.5, price)
+	require.True(t, active)
+	require.NotNil(t, note)
+	require.Equal(t, "", *note)
+	require.Equal(t, []byte{1, 2}, data)
+	require.True(t, created.Equal(time.Date(2024, 1, 2, 8, 20, 30, 0, time.UTC)))
+	require.Equal(t, uuid.MustParse("b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e"), id)
+
+	err = ScanComposite("(12,)", &quantity, nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(12), *quantity)
+
+	err = ScanComposite(nil, &quantity, &name)
+	require.NoError(t, err)
+	require.Nil(t, quantity)
+	require.Equal(t, "", name)
+
+	require.EqualError(t, ScanComposite("(1,2)", &quantity),
+		"jet: composite type value '(1,2)' has 2 fields, expected 1")
+	require.EqualError(t, ScanComposite(`("1)`, &name),
+		`jet: invalid composite type value '("1)', unterminated quoted field`)
+	require.EqualError(t, ScanComposite("(abc)", &quantity),
+		`jet: failed to scan composite type field 1: strconv.ParseInt: parsing "abc": invalid syntax`)
+	require.EqualError(t, ScanComposite(12, &quantity),
+		"jet: invalid composite type value of type int, value has to be of type string or []byte")
+}
+
+func TestCompositeValue(t *testing.T) {
+	quantity := int32(3)
+	var note *string
+
+	value, err := CompositeValue(`Main "St", 1\2`, &quantity, note, 1.5, true, []byte{1, 2},
+		time.Date(2024, 1, 2, 10, 20, 30, 0, time.UTC), uuid.MustParse("b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e"))
+	require.NoError(t, err)
+	require.Equal(t, `("Main \"St\", 1\\2","3",,"1.5","t","\\x0102","2024-01-02 10:20:30Z","b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e")`, value)
+
+	var name string
+	var scannedQuantity *int32
+	var scannedNote *string
+	require.NoError(t, ScanComposite(value, &name, &scannedQuantity, &scannedNote, nil, nil, nil, nil, nil))
+	require.Equal(t, `Main "St", 1\2`, name)
+	require.Equal(t, quantity, *scannedQuantity)
+	require.Nil(t, scannedNote)
+
+	_, err = CompositeValue(struct{}{})
+	require.Error(t, err)
+}
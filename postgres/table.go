@@ -33,6 +33,7 @@ type writableTable interface {
 	INSERT(columns ...jet.Column) InsertStatement
 	UPDATE(columns ...jet.Column) UpdateStatement
 	DELETE() DeleteStatement
+	MERGE() MergeStatement
 	LOCK() LockStatement
 }
 
@@ -97,6 +98,10 @@ func (w *writableTableInterfaceImpl) DELETE() DeleteStatement {
 	return newDeleteStatement(w.root)
 }
 
+func (w *writableTableInterfaceImpl) MERGE() MergeStatement {
+	return newMergeStatement(w.root)
+}
+
 func (w *writableTableInterfaceImpl) LOCK() LockStatement {
 	return LOCK(w.root)
 }
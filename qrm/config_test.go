@@ -0,0 +1,89 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextConfig(t *testing.T) {
+	require.Equal(t, GlobalConfig.StrictScan, ContextConfig(context.Background()).StrictScan)
+	require.Equal(t, GlobalConfig.StrictScan, ContextConfig(nil).StrictScan) //nolint:staticcheck
+
+	ctx := WithConfig(context.Background(), Config{StrictScan: true})
+	require.True(t, ContextConfig(ctx).StrictScan)
+
+	childCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	require.True(t, ContextConfig(childCtx).StrictScan)
+}
+
+func TestQueryWithConfig(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE film (id INTEGER PRIMARY KEY, title TEXT NOT NULL);
+		INSERT INTO film VALUES (1, 'Alien'), (2, 'Heat');`)
+	require.NoError(t, err)
+
+	selectFilms := rawStatement(`SELECT id AS "typedQueryFilm.id", title AS "typedQueryFilm.title", 1 AS "other" FROM film`)
+	strictCtx := WithConfig(context.Background(), Config{StrictScan: true})
+
+	films, err := QueryAll[typedQueryFilm](context.Background(), db, selectFilms)
+	require.NoError(t, err)
+	require.Len(t, films, 2)
+
+	_, err = QueryAll[typedQueryFilm](strictCtx, db, selectFilms)
+	require.EqualError(t, err, "jet: columns never used: 'other'")
+
+	_, err = QueryAll[typedQueryFilm](context.Background(), db, UseConfig(selectFilms, Config{StrictScan: true}))
+	require.EqualError(t, err, "jet: columns never used: 'other'")
+
+	for _, err := range QueryIter[typedQueryFilm](strictCtx, db, selectFilms) {
+		require.EqualError(t, err, "jet: columns never used: 'other'")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(strict bool) {
+			defer wg.Done()
+
+			ctx := WithConfig(context.Background(), Config{StrictFieldMapping: strict})
+
+			var dest []struct {
+				typedQueryFilm
+				Rating int32
+			}
+			_, err := Query(ctx, db, string(selectFilms), nil, &dest)
+
+			if strict {
+				require.EqualError(t, err, "jet: fields never mapped: 'struct { qrm.typedQueryFilm; Rating int32 }.Rating'")
+			} else {
+				require.NoError(t, err)
+			}
+		}(i%2 == 0)
+	}
+	wg.Wait()
+
+	var jsonCalls int
+	jsonCtx := WithConfig(context.Background(), Config{JsonUnmarshalFunc: func(data []byte, v any) error {
+		jsonCalls++
+		return GlobalConfig.JsonUnmarshalFunc(data, v)
+	}})
+
+	var film typedQueryFilm
+	_, err = QueryJsonObj(jsonCtx, db, `SELECT json_object('id', 1, 'title', 'Alien')`, nil, &film)
+	require.NoError(t, err)
+	require.Equal(t, typedQueryFilm{ID: 1, Title: "Alien"}, film)
+	require.Equal(t, 1, jsonCalls)
+
+	_, err = QueryJsonObj(WithConfig(context.Background(), Config{}), db, `SELECT json_object('id', 2)`, nil, &film)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), film.ID)
+}
@@ -0,0 +1,320 @@
+package qrm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+)
+
+// ConverterFunc converts non-NULL database value into the value of the Go type the converter is registered for.
+// During the row scanning, value is the driver value (int64, float64, bool, []byte, string or time.Time). During
+// the decoding of SELECT_JSON_OBJ and SELECT_JSON_ARR statement results, value is the decoded JSON value
+// (string, int64, float64, bool, []any or map[string]any).
+type ConverterFunc func(value any) (any, error)
+
+// Converters is a registry of converters by the destination Go type. Destination fields of the registered types, or
+// pointers to the registered types, are assigned using the converter, ahead of the sql.Scanner and json.Unmarshaler
+// implementations.
+type Converters map[reflect.Type]ConverterFunc
+
+// RegisterConverter registers converter from the database values to the values of type T in the config.
+// Converters registry is copied before the registration, so the converter is not registered in the other configs
+// copied from this config (for instance, configs copied from GlobalConfig). For instance:
+//
+//	qrm.RegisterConverter(&qrm.GlobalConfig, func(value any) (netip.Addr, error) {
+//		switch v := value.(type) {
+//		case string:
+//			return netip.ParseAddr(v)
+//		case []byte:
+//			return netip.ParseAddr(string(v))
+//		}
+//		return netip.Addr{}, fmt.Errorf("unsupported value type %T", value)
+//	})
+func RegisterConverter[T any](config *Config, convert func(value any) (T, error)) {
+	converters := maps.Clone(config.Converters)
+
+	if converters == nil {
+		converters = Converters{}
+	}
+
+	converters[reflect.TypeFor[T]()] = func(value any) (any, error) {
+		return convert(value)
+	}
+
+	config.Converters = converters
+}
+
+// converter returns the converter registered for the type, or for the type pointed to
+func (c Converters) converter(reflectType reflect.Type) (ConverterFunc, bool) {
+	if len(c) == 0 {
+		return nil, false
+	}
+
+	if convert, ok := c[reflectType]; ok {
+		return convert, true
+	}
+
+	if reflectType.Kind() == reflect.Ptr {
+		convert, ok := c[reflectType.Elem()]
+		return convert, ok
+	}
+
+	return nil, false
+}
+
+// assign assigns converted value to the destination. Destination can be a pointer.
+func (c Converters) assign(convert ConverterFunc, value any, destination reflect.Value) error {
+	converted, err := convert(value)
+
+	if err != nil {
+		return err
+	}
+
+	convertedValue := reflect.ValueOf(converted)
+
+	if destination.Kind() == reflect.Ptr && convertedValue.IsValid() && convertedValue.Type() == destination.Type().Elem() {
+		initializeValueIfNilPtr(destination)
+		destination = destination.Elem()
+	}
+
+	if !convertedValue.IsValid() || !convertedValue.Type().AssignableTo(destination.Type()) {
+		return fmt.Errorf("converter returned %T value, expected %s", converted, destination.Type())
+	}
+
+	destination.Set(convertedValue)
+
+	return nil
+}
+
+// requiresJsonAssign returns true if the type, or any type it is composed of, has a registered converter or is
+// sql.Null[T] type, which can not be unmarshalled from JSON value directly
+func (c Config) requiresJsonAssign(reflectType reflect.Type, visited map[reflect.Type]bool) bool {
+	if _, ok := c.Converters.converter(reflectType); ok || isSqlNullType(reflectType) {
+		return true
+	}
+
+	if visited[reflectType] {
+		return false
+	}
+
+	visited[reflectType] = true
+
+	switch reflectType.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return c.requiresJsonAssign(reflectType.Elem(), visited)
+	case reflect.Struct:
+		for i := 0; i < reflectType.NumField(); i++ {
+			if c.requiresJsonAssign(reflectType.Field(i).Type, visited) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// unmarshalJson unmarshals JSON query result into the destination. If the destination contains types with registered
+// converters or sql.Null[T] types, JSON is decoded into generic values first, and then assigned to the destination.
+func unmarshalJson(config Config, data []byte, destPtr any) error {
+	destination := reflect.ValueOf(destPtr).Elem()
+
+	if !config.requiresJsonAssign(destination.Type(), map[reflect.Type]bool{}) {
+		return config.jsonUnmarshalFunc()(data, &destPtr)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	return config.assignJson(value, destination)
+}
+
+func (c Config) assignJson(value any, destination reflect.Value) error {
+	if convert, ok := c.Converters.converter(destination.Type()); ok {
+		if value == nil {
+			setZeroValue(destination)
+			return nil
+		}
+
+		return c.Converters.assign(convert, jsonConverterValue(value), destination)
+	}
+
+	if !c.requiresJsonAssign(destination.Type(), map[reflect.Type]bool{}) {
+		return c.unmarshalJsonValue(value, destination)
+	}
+
+	if isNullType(destination.Type()) {
+		if value == nil {
+			setZeroValue(destination)
+			return nil
+		}
+
+		if err := c.assignJson(value, destination.FieldByName("V")); err != nil {
+			return err
+		}
+
+		destination.FieldByName("Valid").SetBool(true)
+
+		return nil
+	}
+
+	switch destination.Kind() {
+	case reflect.Ptr:
+		if value == nil {
+			setZeroValue(destination)
+			return nil
+		}
+
+		initializeValueIfNilPtr(destination)
+
+		return c.assignJson(value, destination.Elem())
+
+	case reflect.Slice:
+		array, ok := value.([]any)
+		if !ok {
+			break
+		}
+
+		slice := reflect.MakeSlice(destination.Type(), len(array), len(array))
+
+		for i, elem := range array {
+			if err := c.assignJson(elem, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		destination.Set(slice)
+
+		return nil
+
+	case reflect.Struct:
+		object, ok := value.(map[string]any)
+		if !ok {
+			break
+		}
+
+		fields := jsonStructFields(destination.Type())
+
+		for key, fieldValue := range object {
+			fieldIndex, ok := findJsonField(fields, key)
+			if !ok {
+				continue
+			}
+
+			if err := c.assignJson(fieldValue, destination.FieldByIndex(fieldIndex)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return c.unmarshalJsonValue(value, destination)
+}
+
+// unmarshalJsonValue unmarshals the decoded JSON value using config JsonUnmarshalFunc
+func (c Config) unmarshalJsonValue(value any, destination reflect.Value) error {
+	data, err := json.Marshal(value)
+
+	if err != nil {
+		return err
+	}
+
+	return c.jsonUnmarshalFunc()(data, destination.Addr().Interface())
+}
+
+// jsonConverterValue converts JSON numbers into int64 or float64 values
+func jsonConverterValue(value any) any {
+	number, ok := value.(json.Number)
+
+	if !ok {
+		return value
+	}
+
+	if intValue, err := number.Int64(); err == nil {
+		return intValue
+	}
+
+	if floatValue, err := number.Float64(); err == nil {
+		return floatValue
+	}
+
+	return number.String()
+}
+
+type jsonStructField struct {
+	name  string
+	index []int
+}
+
+// jsonStructFields returns JSON names and indexes of the exported struct fields, including the fields of embedded
+// structs, the same way encoding/json does for the most common cases.
+func jsonStructFields(structType reflect.Type) []jsonStructField {
+	var ret []jsonStructField
+	var embedded []reflect.StructField
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, field)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		ret = append(ret, jsonStructField{name: name, index: field.Index})
+	}
+
+	for _, field := range embedded {
+		for _, embeddedField := range jsonStructFields(field.Type) {
+			if _, ok := findJsonField(ret, embeddedField.name); ok {
+				continue
+			}
+
+			ret = append(ret, jsonStructField{
+				name:  embeddedField.name,
+				index: append([]int{field.Index[0]}, embeddedField.index...),
+			})
+		}
+	}
+
+	return ret
+}
+
+// findJsonField returns the index of the field with the JSON name, preferring an exact match over
+// a case-insensitive match
+func findJsonField(fields []jsonStructField, name string) ([]int, bool) {
+	for _, field := range fields {
+		if field.name == name {
+			return field.index, true
+		}
+	}
+
+	for _, field := range fields {
+		if strings.EqualFold(field.name, name) {
+			return field.index, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,143 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type money struct {
+	Cents int64
+}
+
+type converterServer struct {
+	ID      int32 `sql:"primary_key"`
+	Address netip.Addr
+	Backup  *netip.Addr
+	Price   money
+}
+
+func withConverters(t *testing.T) {
+	previous := GlobalConfig
+	t.Cleanup(func() { GlobalConfig = previous })
+
+	RegisterConverter(&GlobalConfig, func(value any) (netip.Addr, error) {
+		switch v := value.(type) {
+		case string:
+			return netip.ParseAddr(v)
+		case []byte:
+			return netip.ParseAddr(string(v))
+		}
+		return netip.Addr{}, fmt.Errorf("unsupported value type %T", value)
+	})
+
+	RegisterConverter(&GlobalConfig, func(value any) (money, error) {
+		switch v := value.(type) {
+		case int64:
+			return money{Cents: v * 100}, nil
+		case float64:
+			return money{Cents: int64(v * 100)}, nil
+		}
+		return money{}, fmt.Errorf("unsupported value type %T", value)
+	})
+}
+
+func TestConvertersRowScan(t *testing.T) {
+	withConverters(t)
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE server (id INTEGER PRIMARY KEY, address TEXT, backup TEXT, price REAL);
+		INSERT INTO server VALUES (1, '10.0.0.1', '10.0.0.2', 12.5), (2, '::1', NULL, 3);`)
+	require.NoError(t, err)
+
+	query := `SELECT id AS "converterServer.id", address AS "converterServer.address", backup AS "converterServer.backup",
+		price AS "converterServer.price" FROM server`
+
+	backup := netip.MustParseAddr("10.0.0.2")
+
+	var servers []converterServer
+	_, err = Query(context.Background(), db, query+" ORDER BY id", nil, &servers)
+	require.NoError(t, err)
+	require.Equal(t, []converterServer{
+		{ID: 1, Address: netip.MustParseAddr("10.0.0.1"), Backup: &backup, Price: money{Cents: 1250}},
+		{ID: 2, Address: netip.MustParseAddr("::1"), Price: money{Cents: 300}},
+	}, servers)
+
+	_, err = db.Exec(`INSERT INTO server VALUES (3, 'localhost', NULL, 1)`)
+	require.NoError(t, err)
+
+	var server converterServer
+	_, err = Query(context.Background(), db, query+" WHERE id = 3", nil, &server)
+	require.ErrorContains(t, err, `can't assign string("localhost") to 'Address netip.Addr': ParseAddr("localhost"): unable to parse IP`)
+}
+
+func TestConvertersJson(t *testing.T) {
+	withConverters(t)
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	type serverGroup struct {
+		Name    string `json:"groupName"`
+		Servers []converterServer
+	}
+
+	var group serverGroup
+	_, err = QueryJsonObj(context.Background(), db, `SELECT json_object('groupName', 'edge', 'servers', json_array(
+		json_object('id', 1, 'address', '10.0.0.1', 'backup', null, 'price', 2.5),
+		json_object('id', 2, 'address', '::1', 'backup', '::2', 'price', 3)))`, nil, &group)
+	require.NoError(t, err)
+
+	backup := netip.MustParseAddr("::2")
+	require.Equal(t, serverGroup{
+		Name: "edge",
+		Servers: []converterServer{
+			{ID: 1, Address: netip.MustParseAddr("10.0.0.1"), Price: money{Cents: 250}},
+			{ID: 2, Address: netip.MustParseAddr("::1"), Backup: &backup, Price: money{Cents: 300}},
+		},
+	}, group)
+
+	var servers []struct {
+		converterServer
+		Location string
+	}
+	_, err = QueryJsonArr(context.Background(), db, `SELECT json_array(
+		json_object('id', 1, 'address', '10.0.0.1', 'price', 1, 'location', 'eu'))`, nil, &servers)
+	require.NoError(t, err)
+	require.Len(t, servers, 1)
+	require.Equal(t, netip.MustParseAddr("10.0.0.1"), servers[0].Address)
+	require.Equal(t, money{Cents: 100}, servers[0].Price)
+	require.Equal(t, "eu", servers[0].Location)
+
+	_, err = QueryJsonArr(context.Background(), db, `SELECT json_array(json_object('address', 1))`, nil, &servers)
+	require.ErrorContains(t, err, "jet: invalid json, unsupported value type int64")
+}
+
+func TestRegisterConverterCopiedConfig(t *testing.T) {
+	withConverters(t)
+
+	config := GlobalConfig
+
+	RegisterConverter(&config, func(value any) (netip.Prefix, error) {
+		return netip.ParsePrefix(fmt.Sprint(value))
+	})
+
+	_, ok := config.Converters.converter(reflect.TypeFor[netip.Prefix]())
+	require.True(t, ok)
+	_, ok = config.Converters.converter(reflect.TypeFor[netip.Addr]())
+	require.True(t, ok)
+
+	_, ok = GlobalConfig.Converters.converter(reflect.TypeFor[netip.Prefix]())
+	require.False(t, ok)
+	require.Len(t, GlobalConfig.Converters, 2)
+}
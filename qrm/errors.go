@@ -0,0 +1,57 @@
+package qrm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// UnmappedColumnsError is returned by Query when StrictScan is enabled, and query result contains columns
+// not mapped to any destination field
+type UnmappedColumnsError struct {
+	Columns []string // aliases of the unmapped columns
+}
+
+func (e *UnmappedColumnsError) Error() string {
+	return "columns never used: " + quoteAndJoin(e.Columns)
+}
+
+// UnmappedFieldsError is returned by Query when StrictFieldMapping is enabled, and destination contains fields
+// not mapped to any query result column
+type UnmappedFieldsError struct {
+	Fields []string // unmapped fields, in the 'ParentField Type.Field' format
+}
+
+func (e *UnmappedFieldsError) Error() string {
+	return "fields never mapped: " + quoteAndJoin(e.Fields)
+}
+
+// ConversionError is returned by Query when query result column value can not be assigned to the destination field
+type ConversionError struct {
+	DestinationType reflect.Type // type of the destination field
+	FieldPath       string       // path of the destination field, relative to the destination, for instance 'Films.Title'
+	Column          string       // alias of the query result column
+	ColumnIndex     int          // index of the query result column
+	Value           any          // column value
+	Err             error        // underlying conversion error
+}
+
+func (e *ConversionError) Error() string {
+	fieldName := e.FieldPath[strings.LastIndex(e.FieldPath, ".")+1:]
+
+	return fmt.Sprintf(`can't assign %T(%q) to '%s %s': %s`, e.Value, e.Value, fieldName, e.DestinationType, e.Err)
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+func quoteAndJoin(list []string) string {
+	quoted := make([]string, len(list))
+
+	for i, elem := range list {
+		quoted[i] = "'" + elem + "'"
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,83 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type errorsFilm struct {
+	ID     int32 `sql:"primary_key"`
+	Actors []struct {
+		ID   int32 `sql:"primary_key"`
+		Name bool
+	} `alias:"iterActor"`
+}
+
+func TestScanErrors(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE film (id INTEGER PRIMARY KEY, title TEXT NOT NULL);
+		CREATE TABLE actor (id INTEGER PRIMARY KEY, film_id INTEGER NOT NULL, name TEXT NOT NULL);
+		INSERT INTO film VALUES (1, 'Alien');
+		INSERT INTO actor VALUES (1, 1, 'Sigourney');`)
+	require.NoError(t, err)
+
+	query := `SELECT film.id AS "iterFilm.id", film.title AS "iterFilm.title", actor.id AS "iterActor.id",
+		actor.name AS "iterActor.name", actor.film_id AS "iterActor.film_id"
+		FROM film JOIN actor ON actor.film_id = film.id`
+
+	strictCtx := WithConfig(context.Background(), Config{StrictScan: true, StrictFieldMapping: true})
+
+	var films []iterFilm
+	_, err = Query(strictCtx, db, query, nil, &films)
+	require.EqualError(t, err, "jet: columns never used: 'iterActor.film_id'")
+
+	var unmappedColumnsErr *UnmappedColumnsError
+	require.True(t, errors.As(err, &unmappedColumnsErr))
+	require.Equal(t, []string{"iterActor.film_id"}, unmappedColumnsErr.Columns)
+
+	var film struct {
+		iterFilm
+		Rating int32
+	}
+	_, err = Query(WithConfig(context.Background(), Config{StrictFieldMapping: true}), db, query, nil, &film)
+	require.EqualError(t, err, "jet: fields never mapped: 'struct { qrm.iterFilm; Rating int32 }.Rating'")
+
+	var unmappedFieldsErr *UnmappedFieldsError
+	require.True(t, errors.As(err, &unmappedFieldsErr))
+	require.Equal(t, []string{"struct { qrm.iterFilm; Rating int32 }.Rating"}, unmappedFieldsErr.Fields)
+
+	var invalidFilms []errorsFilm
+	_, err = Query(context.Background(), db, query, nil, &invalidFilms)
+	require.EqualError(t, err, `jet: can't assign string("Sigourney") to 'Name bool': sql/driver: couldn't convert "Sigourney" into type bool`)
+
+	var conversionErr *ConversionError
+	require.True(t, errors.As(err, &conversionErr))
+	require.Equal(t, reflect.TypeOf(true), conversionErr.DestinationType)
+	require.Equal(t, "Actors.Name", conversionErr.FieldPath)
+	require.Equal(t, "iterActor.name", conversionErr.Column)
+	require.Equal(t, 3, conversionErr.ColumnIndex)
+	require.Equal(t, "Sigourney", conversionErr.Value)
+	require.Error(t, errors.Unwrap(conversionErr))
+
+	rows, err := db.Query(query)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	scanContext, err := NewScanContextWithConfig(rows, Config{StrictScan: true})
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+
+	var actor iterActor
+	err = ScanOneRowToDest(scanContext, rows, &actor)
+	require.ErrorAs(t, err, &unmappedColumnsErr)
+	require.Equal(t, []string{"iterFilm.id", "iterFilm.title", "iterActor.film_id"}, unmappedColumnsErr.Columns)
+}
@@ -0,0 +1,159 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"iter"
+	"reflect"
+	"strings"
+)
+
+// QueryIter executes the statement over db connection or transaction and returns an iterator over the mapped rows.
+// Rows are read from the database and mapped one by one, as the iteration progresses, instead of buffering whole
+// result set in memory. Iteration can be stopped at any time, and the query rows are closed when it stops.
+//
+// T has to be a struct type, or a simple type for single column queries. If T groups the rows (T has primary key
+// fields and slice fields of the nested destinations), an aggregate is yielded as soon as the group key changes, so
+// the statement has to be ordered by the T primary key columns. Otherwise, the same aggregate can be yielded more
+// than once, each time with a part of the nested destinations.
+//
+// SELECT_JSON_OBJ and SELECT_JSON_ARR statements are not supported. Each iteration can be ranged over only once.
+func QueryIter[T any](ctx context.Context, db Queryable, stmt Statement) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stream := &rowStream[T]{yield: yield}
+
+		err := stmt.QueryContext(ctx, db, stream)
+
+		if err != nil && !stream.stopped {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+func queryToStream(ctx context.Context, db Queryable, query string, args []interface{}, stream rowStreamer) (rowsProcessed int64, err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	scanContext, err := NewScanContextWithConfig(rows, ContextConfig(ctx))
+
+	if err != nil {
+		return
+	}
+
+	if len(scanContext.row) == 0 {
+		return
+	}
+
+	rowsProcessed, err = stream.streamRows(scanContext, rows)
+
+	if err != nil {
+		return rowsProcessed, err
+	}
+
+	return rowsProcessed, rows.Close()
+}
+
+// rowStreamer is Query destination which receives mapped rows one by one, instead of all at once
+type rowStreamer interface {
+	streamRows(scanContext *ScanContext, rows *sql.Rows) (rowsProcessed int64, err error)
+}
+
+type rowStream[T any] struct {
+	yield   func(T, error) bool
+	stopped bool // yield returned false
+	dest    []T
+}
+
+func (r *rowStream[T]) streamRows(scanContext *ScanContext, rows *sql.Rows) (rowsProcessed int64, err error) {
+	slicePtrValue := reflect.ValueOf(&r.dest)
+	elemType := getSliceElemType(slicePtrValue)
+	isGroupedType := !isSimpleModelType(elemType) && elemType.Kind() == reflect.Struct
+
+	for rows.Next() {
+		err = rows.Scan(scanContext.row...)
+
+		if err != nil {
+			return scanContext.rowNum, err
+		}
+
+		scanContext.rowNum++
+
+		_, err = mapRowToSlice(scanContext, "", slicePtrValue, nil)
+
+		if err != nil {
+			return scanContext.rowNum, err
+		}
+
+		if scanContext.rowNum == 1 {
+			err = scanContext.ensureStrictness()
+
+			if err != nil {
+				return scanContext.rowNum, err
+			}
+		}
+
+		// the last destination might still receive nested destinations from the following rows
+		if len(r.dest) < 2 {
+			continue
+		}
+
+		if !r.yieldAll(r.dest[:len(r.dest)-1]) {
+			return scanContext.rowNum, nil
+		}
+
+		r.dest = []T{r.dest[len(r.dest)-1]}
+
+		if isGroupedType {
+			r.removeYieldedGroupKeys(scanContext, elemType)
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return scanContext.rowNum, err
+	}
+
+	r.yieldAll(r.dest)
+	r.dest = nil
+
+	return scanContext.rowNum, nil
+}
+
+func (r *rowStream[T]) yieldAll(values []T) bool {
+	for _, value := range values {
+		if !r.yield(value, nil) {
+			r.stopped = true
+			return false
+		}
+	}
+
+	return true
+}
+
+// removeYieldedGroupKeys removes group keys of already yielded destinations from the scan context, and sets
+// the index of the last destination, which is now the first destination in the slice.
+func (r *rowStream[T]) removeYieldedGroupKeys(scanContext *ScanContext, elemType reflect.Type) {
+	lastGroupKey := concat("", ",", scanContext.getGroupKey(elemType, nil))
+	lastGroupNestedKeysPrefix := concat(lastGroupKey, ":")
+
+	uniqueDestObjectsMap := make(map[string]int)
+
+	for groupKey, index := range scanContext.uniqueDestObjectsMap {
+		if groupKey == lastGroupKey {
+			uniqueDestObjectsMap[groupKey] = 0
+		} else if strings.HasPrefix(groupKey, lastGroupNestedKeysPrefix) {
+			uniqueDestObjectsMap[groupKey] = index
+		}
+	}
+
+	scanContext.uniqueDestObjectsMap = uniqueDestObjectsMap
+}
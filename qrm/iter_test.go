@@ -0,0 +1,89 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type iterActor struct {
+	ID   int32 `sql:"primary_key"`
+	Name string
+}
+
+type iterFilm struct {
+	ID    int32 `sql:"primary_key"`
+	Title string
+
+	Actors []iterActor
+}
+
+func TestQueryIter(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE film (id INTEGER PRIMARY KEY, title TEXT NOT NULL);
+		CREATE TABLE actor (id INTEGER PRIMARY KEY, film_id INTEGER NOT NULL, name TEXT NOT NULL);
+		INSERT INTO film VALUES (1, 'Alien'), (2, 'Heat'), (3, 'Ronin');
+		INSERT INTO actor VALUES (1, 1, 'Sigourney'), (2, 2, 'Al'), (3, 2, 'Robert'), (4, 1, 'Tom');`)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	selectFilms := rawStatement(`
+		SELECT film.id AS "iterFilm.id", film.title AS "iterFilm.title", actor.id AS "iterActor.id", actor.name AS "iterActor.name"
+		FROM film LEFT JOIN actor ON actor.film_id = film.id`)
+
+	collect := func(stmt Statement) []iterFilm {
+		var films []iterFilm
+		for film, err := range QueryIter[iterFilm](ctx, db, stmt) {
+			require.NoError(t, err)
+			films = append(films, film)
+		}
+		return films
+	}
+
+	require.Equal(t, []iterFilm{
+		{ID: 1, Title: "Alien", Actors: []iterActor{{ID: 1, Name: "Sigourney"}, {ID: 4, Name: "Tom"}}},
+		{ID: 2, Title: "Heat", Actors: []iterActor{{ID: 2, Name: "Al"}, {ID: 3, Name: "Robert"}}},
+		{ID: 3, Title: "Ronin"},
+	}, collect(selectFilms+" ORDER BY film.id, actor.id"))
+
+	// without ORDER BY on the group key, the same aggregate is yielded for each part of the nested destinations
+	require.Equal(t, []iterFilm{
+		{ID: 1, Title: "Alien", Actors: []iterActor{{ID: 1, Name: "Sigourney"}}},
+		{ID: 2, Title: "Heat", Actors: []iterActor{{ID: 2, Name: "Al"}, {ID: 3, Name: "Robert"}}},
+		{ID: 1, Title: "Alien", Actors: []iterActor{{ID: 4, Name: "Tom"}}},
+	}, collect(selectFilms+" WHERE actor.id IS NOT NULL ORDER BY actor.id"))
+
+	require.Empty(t, collect(selectFilms+" WHERE film.id > 3"))
+
+	var titles []string
+	for title, err := range QueryIter[string](ctx, db, rawStatement(`SELECT title FROM film ORDER BY id`)) {
+		require.NoError(t, err)
+		titles = append(titles, title)
+		if len(titles) == 2 {
+			break
+		}
+	}
+	require.Equal(t, []string{"Alien", "Heat"}, titles)
+
+	for film, err := range QueryIter[iterFilm](ctx, db, selectFilms+" ORDER BY film.id, actor.id") {
+		require.NoError(t, err)
+		require.Equal(t, iterFilm{ID: 1, Title: "Alien", Actors: []iterActor{{ID: 1, Name: "Sigourney"}, {ID: 4, Name: "Tom"}}}, film)
+		break
+	}
+
+	var errs []error
+	for _, err := range QueryIter[iterFilm](ctx, db, rawStatement(`SELECT * FROM director`)) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	require.ErrorContains(t, errs[0], "no such table: director")
+
+	_, err = QueryJsonArr(ctx, db, `SELECT '[]'`, nil, &rowStream[iterFilm]{})
+	require.ErrorIs(t, err, errJsonStream)
+}
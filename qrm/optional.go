@@ -0,0 +1,137 @@
+package qrm
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Optional represents a value that may be NULL. Optional is similar to sql.Null, but it also implements
+// json.Marshaler and json.Unmarshaler interfaces, with NULL encoded as JSON null. Database values are
+// assigned to V with the same conversion rules QRM uses for the model fields.
+type Optional[T any] struct {
+	V     T
+	Valid bool // Valid is true if V is not NULL
+}
+
+// Some returns valid Optional with the value v
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{V: v, Valid: true}
+}
+
+// Get returns the value and true if the value is not NULL
+func (o Optional[T]) Get() (T, bool) {
+	return o.V, o.Valid
+}
+
+// OrElse returns the value if the value is not NULL, and defaultValue otherwise
+func (o Optional[T]) OrElse(defaultValue T) T {
+	if !o.Valid {
+		return defaultValue
+	}
+	return o.V
+}
+
+// Scan implements the sql.Scanner interface
+func (o *Optional[T]) Scan(value any) error {
+	if value == nil {
+		*o = Optional[T]{}
+		return nil
+	}
+
+	var v T
+
+	if scanner, ok := any(&v).(sql.Scanner); ok {
+		if err := scanner.Scan(value); err != nil {
+			return err
+		}
+	} else if err := assign(reflect.ValueOf(value), reflect.ValueOf(&v).Elem()); err != nil {
+		return err
+	}
+
+	*o = Some(v)
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.Valid {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(o.V)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.V)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Optional[T]{}
+		return nil
+	}
+
+	var v T
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*o = Some(v)
+
+	return nil
+}
+
+var optionalPkgPath = reflect.TypeFor[Optional[any]]().PkgPath()
+
+// isNullType returns true for the generic nullable types sql.Null[T] and Optional[T]
+func isNullType(fieldType reflect.Type) bool {
+	return isSqlNullType(fieldType) || isGenericType(fieldType, optionalPkgPath, "Optional")
+}
+
+// isSqlNullType returns true for sql.Null[T] types
+func isSqlNullType(fieldType reflect.Type) bool {
+	return isGenericType(fieldType, "database/sql", "Null")
+}
+
+func isGenericType(fieldType reflect.Type, pkgPath, name string) bool {
+	return fieldType.Kind() == reflect.Struct && fieldType.PkgPath() == pkgPath &&
+		strings.HasPrefix(fieldType.Name(), name+"[")
+}
+
+// assignNull assigns non-NULL database value to the V field of sql.Null[T] or Optional[T] destination, using the
+// same conversions as for the other model fields (registered converters, sql.Scanner implementations and simple
+// type conversions), and marks destination as valid.
+func (c Config) assignNull(value, destination reflect.Value) error {
+	v := destination.FieldByName("V")
+
+	var err error
+
+	if convert, ok := c.Converters.converter(v.Type()); ok {
+		err = c.Converters.assign(convert, value.Interface(), v)
+	} else if implementsScannerType(v.Type()) {
+		initializeValueIfNilPtr(v)
+		err = getScanner(v).Scan(value.Interface())
+	} else {
+		err = assign(value, v)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	destination.FieldByName("Valid").SetBool(true)
+
+	return nil
+}
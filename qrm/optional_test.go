@@ -0,0 +1,156 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionalScan(t *testing.T) {
+	var number Optional[int32]
+	require.NoError(t, number.Scan(int64(12)))
+	require.Equal(t, Some(int32(12)), number)
+
+	require.NoError(t, number.Scan(nil))
+	require.Equal(t, Optional[int32]{}, number)
+
+	var flag Optional[bool]
+	require.NoError(t, flag.Scan(int64(1)))
+	require.Equal(t, Some(true), flag)
+
+	var text Optional[string]
+	require.NoError(t, text.Scan([]byte("text")))
+	require.Equal(t, Some("text"), text)
+
+	var id Optional[uuid.UUID]
+	require.NoError(t, id.Scan("b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e"))
+	require.Equal(t, Some(uuid.MustParse("b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e")), id)
+
+	require.EqualError(t, number.Scan("abc"), `converting driver.Value type string ("abc") to a int64: invalid syntax`)
+}
+
+func TestOptionalValue(t *testing.T) {
+	value, err := Some(int32(12)).Value()
+	require.NoError(t, err)
+	require.Equal(t, driver.Value(int64(12)), value)
+
+	value, err = Optional[int32]{}.Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	value, err = Some(uuid.MustParse("b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e")).Value()
+	require.NoError(t, err)
+	require.Equal(t, driver.Value("b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e"), value)
+}
+
+func TestOptionalJSON(t *testing.T) {
+	type person struct {
+		Name Optional[string]
+		Age  Optional[int32]
+	}
+
+	data, err := json.Marshal(person{Name: Some("John")})
+	require.NoError(t, err)
+	require.Equal(t, `{"Name":"John","Age":null}`, string(data))
+
+	var dest person
+	require.NoError(t, json.Unmarshal([]byte(`{"Name":null,"Age":30}`), &dest))
+	require.Equal(t, person{Age: Some(int32(30))}, dest)
+
+	require.Equal(t, int32(30), dest.Age.OrElse(10))
+	require.Equal(t, "unknown", dest.Name.OrElse("unknown"))
+
+	age, ok := dest.Age.Get()
+	require.True(t, ok)
+	require.Equal(t, int32(30), age)
+}
+
+type nullServer struct {
+	ID      int32 `sql:"primary_key"`
+	Port    sql.Null[int32]
+	Name    sql.Null[string]
+	Enabled Optional[bool]
+	Address sql.Null[netip.Addr]
+	Price   Optional[money]
+	UUID    sql.Null[uuid.UUID]
+}
+
+func TestNullFieldsRowScan(t *testing.T) {
+	withConverters(t)
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE server (id INTEGER PRIMARY KEY, port INTEGER, name BLOB, enabled INTEGER, address TEXT, price REAL, uuid TEXT);
+		INSERT INTO server VALUES
+			(1, 8080, CAST('edge' AS BLOB), 1, '10.0.0.1', 12.5, 'b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e'),
+			(2, NULL, NULL, NULL, NULL, NULL, NULL);`)
+	require.NoError(t, err)
+
+	query := `SELECT id AS "nullServer.id", port AS "nullServer.port", name AS "nullServer.name",
+		enabled AS "nullServer.enabled", address AS "nullServer.address", price AS "nullServer.price",
+		uuid AS "nullServer.uuid" FROM server`
+
+	var servers []nullServer
+	_, err = Query(context.Background(), db, query+" ORDER BY id", nil, &servers)
+	require.NoError(t, err)
+	require.Equal(t, []nullServer{
+		{
+			ID:      1,
+			Port:    sql.Null[int32]{V: 8080, Valid: true},
+			Name:    sql.Null[string]{V: "edge", Valid: true},
+			Enabled: Some(true),
+			Address: sql.Null[netip.Addr]{V: netip.MustParseAddr("10.0.0.1"), Valid: true},
+			Price:   Some(money{Cents: 1250}),
+			UUID:    sql.Null[uuid.UUID]{V: uuid.MustParse("b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e"), Valid: true},
+		},
+		{
+			ID: 2,
+		},
+	}, servers)
+
+	_, err = db.Exec(`INSERT INTO server VALUES (3, 'abc', NULL, NULL, NULL, NULL, NULL)`)
+	require.NoError(t, err)
+
+	var server nullServer
+	_, err = Query(context.Background(), db, query+" WHERE id = 3", nil, &server)
+	require.ErrorContains(t, err, `can't assign string("abc") to 'Port sql.Null[int32]'`)
+}
+
+func TestNullFieldsJson(t *testing.T) {
+	withConverters(t)
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	var servers []nullServer
+	_, err = QueryJsonArr(context.Background(), db, `SELECT json_array(
+		json_object('id', 1, 'port', 8080, 'name', 'edge', 'enabled', json('true'), 'address', '10.0.0.1', 'price', 12.5,
+			'uuid', 'b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e'),
+		json_object('id', 2, 'port', null, 'name', null, 'enabled', null, 'address', null, 'price', null, 'uuid', null))`,
+		nil, &servers)
+	require.NoError(t, err)
+	require.Equal(t, []nullServer{
+		{
+			ID:      1,
+			Port:    sql.Null[int32]{V: 8080, Valid: true},
+			Name:    sql.Null[string]{V: "edge", Valid: true},
+			Enabled: Some(true),
+			Address: sql.Null[netip.Addr]{V: netip.MustParseAddr("10.0.0.1"), Valid: true},
+			Price:   Some(money{Cents: 1250}),
+			UUID:    sql.Null[uuid.UUID]{V: uuid.MustParse("b3b3bb63-8a46-4aa9-b2de-9b6b53fd0f1e"), Valid: true},
+		},
+		{
+			ID: 2,
+		},
+	}, servers)
+}
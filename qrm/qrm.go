@@ -7,25 +7,26 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/go-jet/jet/v2/internal/utils/must"
 )
 
 // Config holds the configuration settings for QRM scanning behavior.
 type Config struct {
-	// StrictScan, when true, causes the scanning function to panic if it encounters any
+	// StrictScan, when true, causes the scanning function to return UnmappedColumnsError if it encounters any
 	// unused columns in the SQL query result. This ensures that every column is mapped
 	// to a field in the destination struct.
 	// Does not apply to statements build with SELECT_JSON_OBJ or SELECT_JSON_ARR
 	StrictScan bool
 
-	// StrictFieldMapping, when true, causes the scanning function to panic if it encounters any
+	// StrictFieldMapping, when true, causes the scanning function to return UnmappedFieldsError if it encounters any
 	// destination struct fields that do not have matching columns in the SQL query result.
 	//
 	// Optional fields:
 	// If a destination field (including struct/slice fields) is not always selected by a query,
 	// it can be marked as optional using `qrm:"optional"`. When StrictFieldMapping is enabled,
-	// unmapped fields under an optional field will not trigger a panic.
+	// unmapped fields under an optional field will not trigger an error.
 	// Does not apply to statements build with SELECT_JSON_OBJ or SELECT_JSON_ARR
 	StrictFieldMapping bool
 
@@ -34,16 +35,52 @@ type Config struct {
 	// It can be replaced with any implementation that matches the standard "encoding/json" `Unmarshal` function signature.
 	// By default, it uses the `Unmarshal` function from Go's standard `encoding/json` package.
 	JsonUnmarshalFunc func(data []byte, v any) error
+
+	// Converters are used to assign database values to the destination fields of the registered types,
+	// both during the row scanning and the decoding of SELECT_JSON_OBJ and SELECT_JSON_ARR results.
+	// Converters should be registered with the RegisterConverter function.
+	Converters Converters
 }
 
 // GlobalConfig is the package-wide configuration for SQL scanning.
 // This variable is not thread safe, and it should be modified only once, for instance, during application initialization.
+// Configuration of a single query execution can be changed with WithConfig or UseConfig.
 var GlobalConfig = Config{
 	StrictScan:         false,
 	StrictFieldMapping: false,
 	JsonUnmarshalFunc:  json.Unmarshal,
 }
 
+// jsonUnmarshalFunc returns JsonUnmarshalFunc, or json.Unmarshal if JsonUnmarshalFunc is not set
+func (c Config) jsonUnmarshalFunc() func(data []byte, v any) error {
+	if c.JsonUnmarshalFunc == nil {
+		return json.Unmarshal
+	}
+
+	return c.JsonUnmarshalFunc
+}
+
+type configContextKey struct{}
+
+// WithConfig returns a copy of the context carrying the config. Query methods executed with the returned context,
+// or a context derived from it, use the config instead of the GlobalConfig. Unlike GlobalConfig modification,
+// WithConfig is safe for concurrent use, and it can be used to change the scanning behavior of a single
+// service path or a single statement execution.
+func WithConfig(ctx context.Context, config Config) context.Context {
+	return context.WithValue(ctx, configContextKey{}, config)
+}
+
+// ContextConfig returns the config carried by the context, or GlobalConfig if the context does not carry a config
+func ContextConfig(ctx context.Context) Config {
+	if ctx != nil {
+		if config, ok := ctx.Value(configContextKey{}).(Config); ok {
+			return config
+		}
+	}
+
+	return GlobalConfig
+}
+
 // ErrNoRows is returned by Query when query result set is empty
 var ErrNoRows = errors.New("qrm: no rows in result set")
 
@@ -66,6 +103,10 @@ var ErrNoRows = errors.New("qrm: no rows in result set")
 //	rowsProcessed - The number of rows processed by the query execution.
 //	err           - An error if query execution or unmarshaling fails.
 func QueryJsonObj(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
+	if _, ok := destPtr.(rowStreamer); ok {
+		return 0, errJsonStream
+	}
+
 	must.BeInitializedPtr(destPtr, "jet: destination is nil")
 	must.BeTypeKind(destPtr, reflect.Ptr, jsonDestObjErr)
 	destType := reflect.TypeOf(destPtr).Elem()
@@ -93,6 +134,10 @@ func QueryJsonObj(ctx context.Context, db Queryable, query string, args []interf
 //	rowsProcessed - The number of rows processed by the query execution.
 //	err           - An error if query execution or unmarshaling fails.
 func QueryJsonArr(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
+	if _, ok := destPtr.(rowStreamer); ok {
+		return 0, errJsonStream
+	}
+
 	must.BeInitializedPtr(destPtr, "jet: destination is nil")
 	must.BeTypeKind(destPtr, reflect.Ptr, jsonDestArrErr)
 	destType := reflect.TypeOf(destPtr).Elem()
@@ -101,6 +146,7 @@ func QueryJsonArr(ctx context.Context, db Queryable, query string, args []interf
 	return queryJson(ctx, db, query, args, destPtr)
 }
 
+var errJsonStream = errors.New("jet: SELECT_JSON_OBJ and SELECT_JSON_ARR statement results can not be iterated")
 var jsonDestObjErr = "jet: SELECT_JSON_OBJ destination has to be a pointer to struct or pointer to map[string]any"
 var jsonDestArrErr = "jet: SELECT_JSON_ARR destination has to be a pointer to slice of struct or pointer to []map[string]any"
 
@@ -132,7 +178,7 @@ func queryJson(ctx context.Context, db Queryable, query string, args []interface
 	}
 
 	if jsonData != nil {
-		err = GlobalConfig.JsonUnmarshalFunc(jsonData, &destPtr)
+		err = unmarshalJson(ContextConfig(ctx), jsonData, destPtr)
 
 		if err != nil {
 			return 1, fmt.Errorf("jet: invalid json, %w", err)
@@ -172,6 +218,15 @@ func queryJson(ctx context.Context, db Queryable, query string, args []interface
 func Query(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
 
 	must.BeInitializedPtr(db, "jet: db is nil")
+
+	if stream, ok := destPtr.(rowStreamer); ok {
+		rowsProcessed, err := queryToStream(ctx, db, query, args, stream)
+		if err != nil {
+			return rowsProcessed, fmt.Errorf("jet: %w", err)
+		}
+		return rowsProcessed, nil
+	}
+
 	must.BeInitializedPtr(destPtr, "jet: destination is nil")
 	must.BeTypeKind(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice or pointer to struct")
 
@@ -240,7 +295,9 @@ func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interfac
 	}
 
 	if scanContext.rowNum == 1 {
-		scanContext.ensureStrictness()
+		if err := scanContext.ensureStrictness(); err != nil {
+			return fmt.Errorf("jet: %w", err)
+		}
 	}
 
 	return nil
@@ -258,7 +315,7 @@ func queryToSlice(ctx context.Context, db Queryable, query string, args []interf
 	}
 	defer rows.Close()
 
-	scanContext, err := NewScanContext(rows)
+	scanContext, err := NewScanContextWithConfig(rows, ContextConfig(ctx))
 
 	if err != nil {
 		return
@@ -286,7 +343,11 @@ func queryToSlice(ctx context.Context, db Queryable, query string, args []interf
 		}
 
 		if scanContext.rowNum == 1 {
-			scanContext.ensureStrictness()
+			err = scanContext.ensureStrictness()
+
+			if err != nil {
+				return scanContext.rowNum, err
+			}
 		}
 	}
 
@@ -402,7 +463,9 @@ func mapRowToStruct(
 
 		case complexType:
 			var changed bool
+			scanContext.fieldPath = append(scanContext.fieldPath, field.Name)
 			changed, err = mapRowToDestinationValue(scanContext, concat(groupKey, ":", field.Name), fieldValue, &field)
+			scanContext.fieldPath = scanContext.fieldPath[:len(scanContext.fieldPath)-1]
 
 			if err != nil {
 				return
@@ -426,6 +489,20 @@ func mapRowToStruct(
 			updated = true
 
 			switch fieldMappingInfo.Type {
+			case converter:
+				convert, _ := scanContext.config.Converters.converter(field.Type)
+
+				err := scanContext.config.Converters.assign(convert, scannedValue.Interface(), fieldValue)
+
+				if err != nil {
+					return updated, scanContext.conversionError(fieldMappingInfo.rowIndex, scannedValue, field, err)
+				}
+			case nullType:
+				err := scanContext.config.assignNull(scannedValue, fieldValue)
+
+				if err != nil {
+					return updated, scanContext.conversionError(fieldMappingInfo.rowIndex, scannedValue, field, err)
+				}
 			case implementsScanner:
 				initializeValueIfNilPtr(fieldValue)
 				fieldScanner := getScanner(fieldValue)
@@ -435,13 +512,13 @@ func mapRowToStruct(
 				err := fieldScanner.Scan(value)
 
 				if err != nil {
-					return updated, qrmAssignError(scannedValue, field, err)
+					return updated, scanContext.conversionError(fieldMappingInfo.rowIndex, scannedValue, field, err)
 				}
 			case jsonUnmarshal:
 				value, ok := scannedValue.Interface().([]byte)
 
 				if !ok {
-					return updated, qrmAssignError(scannedValue, field, fmt.Errorf("value not convertable to []byte"))
+					return updated, scanContext.conversionError(fieldMappingInfo.rowIndex, scannedValue, field, fmt.Errorf("value not convertable to []byte"))
 				}
 
 				fieldInterface := fieldValue.Addr().Interface()
@@ -449,13 +526,13 @@ func mapRowToStruct(
 				err := json.Unmarshal(value, fieldInterface)
 
 				if err != nil {
-					return updated, qrmAssignError(scannedValue, field, fmt.Errorf("invalid json, %w", err))
+					return updated, scanContext.conversionError(fieldMappingInfo.rowIndex, scannedValue, field, fmt.Errorf("invalid json, %w", err))
 				}
 			default: // simple type
 				err := assign(scannedValue, fieldValue)
 
 				if err != nil {
-					return updated, qrmAssignError(scannedValue, field, err)
+					return updated, scanContext.conversionError(fieldMappingInfo.rowIndex, scannedValue, field, err)
 				}
 			}
 		}
@@ -464,9 +541,15 @@ func mapRowToStruct(
 	return
 }
 
-func qrmAssignError(scannedValue reflect.Value, field reflect.StructField, err error) error {
-	return fmt.Errorf(`can't assign %T(%q) to '%s %s': %w`, scannedValue.Interface(), scannedValue.Interface(),
-		field.Name, field.Type.String(), err)
+func (s *ScanContext) conversionError(columnIndex int, scannedValue reflect.Value, field reflect.StructField, err error) error {
+	return &ConversionError{
+		DestinationType: field.Type,
+		FieldPath:       strings.Join(append(s.fieldPath, field.Name), "."),
+		Column:          s.columnAlias[columnIndex],
+		ColumnIndex:     columnIndex,
+		Value:           scannedValue.Interface(),
+		Err:             err,
+	}
 }
 
 func mapRowToDestinationValue(
@@ -18,14 +18,22 @@ type ScanContext struct {
 	typeInfoMap              map[string]typeInfo
 
 	typesVisited    typeStack // to prevent circular dependency scan
+	fieldPath       []string  // names of the complex fields currently mapped
 	columnAlias     []string
 	columnIndexRead []bool
 
 	unmappedFields []string
+
+	config Config
 }
 
-// NewScanContext creates new ScanContext from rows
+// NewScanContext creates new ScanContext from rows, using GlobalConfig
 func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
+	return NewScanContextWithConfig(rows, GlobalConfig)
+}
+
+// NewScanContextWithConfig creates new ScanContext from rows, using the config
+func NewScanContextWithConfig(rows *sql.Rows, config Config) (*ScanContext, error) {
 	aliases, err := rows.Columns()
 
 	if err != nil {
@@ -64,31 +72,39 @@ func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
 
 		columnAlias:     aliases,
 		columnIndexRead: make([]bool, len(aliases)),
+
+		config: config,
 	}, nil
 }
 
-func (s *ScanContext) ensureStrictness() { // can panic
-	if GlobalConfig.StrictScan {
-		s.ensureEveryColumnRead() // can panic
+func (s *ScanContext) ensureStrictness() error {
+	if s.config.StrictScan {
+		if err := s.ensureEveryColumnRead(); err != nil {
+			return err
+		}
 	}
 
-	if GlobalConfig.StrictFieldMapping {
-		s.ensureEveryFieldMapped() // can panic
+	if s.config.StrictFieldMapping {
+		return s.ensureEveryFieldMapped()
 	}
+
+	return nil
 }
 
-func (s *ScanContext) ensureEveryColumnRead() {
+func (s *ScanContext) ensureEveryColumnRead() error {
 	var neverUsedColumns []string
 
 	for index, read := range s.columnIndexRead {
 		if !read {
-			neverUsedColumns = append(neverUsedColumns, `'`+s.columnAlias[index]+`'`)
+			neverUsedColumns = append(neverUsedColumns, s.columnAlias[index])
 		}
 	}
 
 	if len(neverUsedColumns) > 0 {
-		panic("jet: columns never used: " + strings.Join(neverUsedColumns, ", "))
+		return &UnmappedColumnsError{Columns: neverUsedColumns}
 	}
+
+	return nil
 }
 
 func (s *ScanContext) recordUnmappedField(structType reflect.Type, parentField *reflect.StructField, field reflect.StructField) {
@@ -108,14 +124,15 @@ func (s *ScanContext) recordUnmappedField(structType reflect.Type, parentField *
 		fieldIdent = fmt.Sprintf("%s %s", parentField.Name, fieldIdent)
 	}
 
-	s.unmappedFields = append(s.unmappedFields, fmt.Sprintf("'%s'", fieldIdent))
+	s.unmappedFields = append(s.unmappedFields, fieldIdent)
 }
 
-func (s *ScanContext) ensureEveryFieldMapped() {
+func (s *ScanContext) ensureEveryFieldMapped() error {
 	if len(s.unmappedFields) == 0 {
-		return
+		return nil
 	}
-	panic("jet: fields never mapped: " + strings.Join(s.unmappedFields, ", "))
+
+	return &UnmappedFieldsError{Fields: s.unmappedFields}
 }
 
 func isOptionalQrmField(field *reflect.StructField) bool {
@@ -134,8 +151,8 @@ func isOptionalQrmField(field *reflect.StructField) bool {
 	return false
 }
 
-func shouldRecordUnmappedField(parentField *reflect.StructField, field reflect.StructField, fieldMap fieldMapping) bool {
-	if !GlobalConfig.StrictFieldMapping {
+func (s *ScanContext) shouldRecordUnmappedField(parentField *reflect.StructField, field reflect.StructField, fieldMap fieldMapping) bool {
+	if !s.config.StrictFieldMapping {
 		return false
 	}
 	if fieldMap.Type == complexType {
@@ -172,6 +189,8 @@ const (
 	complexType                  // slice and struct are complex types supported
 	implementsScanner
 	jsonUnmarshal
+	converter // field type has a registered converter
+	nullType  // sql.Null[T] or Optional[T] field
 )
 
 type fieldMapping struct {
@@ -205,8 +224,12 @@ func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.
 			rowIndex: columnIndex,
 		}
 
-		if jsonUnmarshaler {
+		if _, ok := s.config.Converters.converter(field.Type); ok {
+			fieldMap.Type = converter
+		} else if jsonUnmarshaler {
 			fieldMap.Type = jsonUnmarshal
+		} else if isNullType(field.Type) {
+			fieldMap.Type = nullType
 		} else if implementsScannerType(field.Type) {
 			fieldMap.Type = implementsScanner
 		} else if !isSimpleModelType(field.Type) {
@@ -215,7 +238,7 @@ func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.
 			fieldMap.Type = simpleType
 		}
 
-		if shouldRecordUnmappedField(parentField, field, fieldMap) {
+		if s.shouldRecordUnmappedField(parentField, field, fieldMap) {
 			s.recordUnmappedField(structType, parentField, field)
 		}
 
@@ -338,7 +361,7 @@ func (s *ScanContext) typeToColumnIndex(typeName, fieldName string) int {
 // rowElemValue always returns non-ptr value,
 // invalid value is nil
 func (s *ScanContext) rowElemValue(index int) reflect.Value {
-	if s.rowNum == 1 && GlobalConfig.StrictScan {
+	if s.rowNum == 1 && s.config.StrictScan {
 		s.columnIndexRead[index] = true
 	}
 	scannedValue := reflect.ValueOf(s.row[index])
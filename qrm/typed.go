@@ -0,0 +1,74 @@
+package qrm
+
+import (
+	"context"
+	"errors"
+)
+
+// Statement is a statement which results can be mapped into a destination. All jet statements implement
+// the Statement interface.
+type Statement interface {
+	QueryContext(ctx context.Context, db Queryable, destination interface{}) error
+}
+
+// UseConfig returns statement whose results are mapped using the config, instead of the context config or
+// the GlobalConfig. Returned statement can be used with QueryAll, QueryOne, QueryOptional and QueryIter functions.
+func UseConfig(stmt Statement, config Config) Statement {
+	return configStatement{Statement: stmt, config: config}
+}
+
+type configStatement struct {
+	Statement
+	config Config
+}
+
+func (c configStatement) QueryContext(ctx context.Context, db Queryable, destination interface{}) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return c.Statement.QueryContext(WithConfig(ctx, c.config), db, destination)
+}
+
+// QueryAll executes the statement over db connection or transaction and returns all the mapped rows.
+// T has to be a struct type, or map[string]any for the SELECT_JSON_ARR statements.
+// If the query returns no rows, QueryAll returns an empty slice and a nil error.
+func QueryAll[T any](ctx context.Context, db Queryable, stmt Statement) ([]T, error) {
+	dest := []T{}
+
+	err := stmt.QueryContext(ctx, db, &dest)
+
+	if err == nil && dest == nil { // JSON null of the SELECT_JSON_ARR statement
+		dest = []T{}
+	}
+
+	return dest, err
+}
+
+// QueryOne executes the statement over db connection or transaction and returns the mapped row.
+// T has to be a struct type, or map[string]any for the SELECT_JSON_OBJ statements.
+// If the query returns no rows, QueryOne returns ErrNoRows.
+func QueryOne[T any](ctx context.Context, db Queryable, stmt Statement) (T, error) {
+	var dest T
+
+	err := stmt.QueryContext(ctx, db, &dest)
+
+	return dest, err
+}
+
+// QueryOptional executes the statement over db connection or transaction and returns the mapped row, if any.
+// T has to be a struct type, or map[string]any for the SELECT_JSON_OBJ statements.
+// If the query returns no rows, QueryOptional returns an invalid Optional and a nil error.
+func QueryOptional[T any](ctx context.Context, db Queryable, stmt Statement) (Optional[T], error) {
+	dest, err := QueryOne[T](ctx, db, stmt)
+
+	if errors.Is(err, ErrNoRows) {
+		return Optional[T]{}, nil
+	}
+
+	if err != nil {
+		return Optional[T]{}, err
+	}
+
+	return Some(dest), nil
+}
@@ -0,0 +1,91 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type rawStatement string
+
+func (r rawStatement) QueryContext(ctx context.Context, db Queryable, destination interface{}) error {
+	_, err := Query(ctx, db, string(r), nil, destination)
+	return err
+}
+
+type rawJsonArrStatement string
+
+func (r rawJsonArrStatement) QueryContext(ctx context.Context, db Queryable, destination interface{}) error {
+	_, err := QueryJsonArr(ctx, db, string(r), nil, destination)
+	return err
+}
+
+type typedQueryFilm struct {
+	ID    int32 `sql:"primary_key"`
+	Title string
+}
+
+func TestTypedQuery(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+		CREATE TABLE film (id INTEGER PRIMARY KEY, title TEXT NOT NULL);
+		INSERT INTO film VALUES (1, 'Alien'), (2, 'Heat');`)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	selectFilms := rawStatement(`SELECT id AS "typedQueryFilm.id", title AS "typedQueryFilm.title" FROM film`)
+	selectNoFilms := selectFilms + " WHERE id > 2"
+
+	films, err := QueryAll[typedQueryFilm](ctx, db, selectFilms)
+	require.NoError(t, err)
+	require.Equal(t, []typedQueryFilm{{ID: 1, Title: "Alien"}, {ID: 2, Title: "Heat"}}, films)
+
+	films, err = QueryAll[typedQueryFilm](ctx, db, selectNoFilms)
+	require.NoError(t, err)
+	require.NotNil(t, films)
+	require.Empty(t, films)
+
+	film, err := QueryOne[typedQueryFilm](ctx, db, selectFilms+" WHERE id = 2")
+	require.NoError(t, err)
+	require.Equal(t, typedQueryFilm{ID: 2, Title: "Heat"}, film)
+
+	_, err = QueryOne[typedQueryFilm](ctx, db, selectNoFilms)
+	require.ErrorIs(t, err, ErrNoRows)
+
+	optionalFilm, err := QueryOptional[typedQueryFilm](ctx, db, selectFilms+" WHERE id = 1")
+	require.NoError(t, err)
+	require.Equal(t, Some(typedQueryFilm{ID: 1, Title: "Alien"}), optionalFilm)
+
+	optionalFilm, err = QueryOptional[typedQueryFilm](ctx, db, selectNoFilms)
+	require.NoError(t, err)
+	require.False(t, optionalFilm.Valid)
+
+	_, err = QueryOptional[typedQueryFilm](ctx, db, rawStatement("SELECT * FROM actor"))
+	require.ErrorContains(t, err, "no such table: actor")
+}
+
+func TestTypedQueryAllJsonArr(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	films, err := QueryAll[map[string]any](ctx, db, rawJsonArrStatement(`SELECT '[{"id": 1}]'`))
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{{"id": float64(1)}}, films)
+
+	for _, stmt := range []rawJsonArrStatement{"SELECT NULL", "SELECT 'null'", "SELECT '[]'"} {
+		films, err = QueryAll[map[string]any](ctx, db, stmt)
+		require.NoError(t, err)
+		require.NotNil(t, films)
+		require.Empty(t, films)
+	}
+}
@@ -62,3 +62,15 @@ type ColumnTimestamp = jet.ColumnTimestamp
 
 // TimestampColumn creates named timestamp column
 var TimestampColumn = jet.TimestampColumn
+
+// ColumnJson is interface of SQL json columns.
+type ColumnJson = jet.ColumnJson
+
+// JsonColumn creates named json column
+var JsonColumn = jet.JsonColumn
+
+// ColumnFts5 is interface of FTS5 hidden column, the column with the same name as the FTS5 virtual table.
+type ColumnFts5 = jet.ColumnFts5
+
+// Fts5Column creates named FTS5 hidden column
+var Fts5Column = jet.Fts5Column
@@ -0,0 +1,255 @@
+package sqlite
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// ColumnDefinition is definition of the table column used in CREATE TABLE and ALTER TABLE statements
+type ColumnDefinition = jet.ColumnDefinition
+
+// TableConstraint is table constraint used in CREATE TABLE and ALTER TABLE statements
+type TableConstraint = jet.TableConstraint
+
+// COLUMN creates definition of the column. If data type is not set with TYPE, default data type for the column type is used.
+//
+//	COLUMN(Film.FilmID).PRIMARY_KEY()
+var COLUMN = jet.ColumnDef
+
+// PRIMARY_KEY creates PRIMARY KEY table constraint
+var PRIMARY_KEY = jet.PrimaryKey
+
+// UNIQUE creates UNIQUE table constraint
+var UNIQUE = jet.Unique
+
+// CHECK creates CHECK table constraint
+var CHECK = jet.Check
+
+// FOREIGN_KEY creates FOREIGN KEY table constraint
+//
+//	FOREIGN_KEY(Film.LanguageID).REFERENCES(Language.LanguageID)
+var FOREIGN_KEY = jet.ForeignKey
+
+// CONSTRAINT creates named table constraint
+//
+//	CONSTRAINT("film_pkey").PRIMARY_KEY(Film.FilmID)
+var CONSTRAINT = jet.Constraint
+
+// ---------------------------------------------------//
+
+// CreateTableStatement is interface for SQLite CREATE TABLE statement
+type CreateTableStatement interface {
+	Statement
+
+	TEMPORARY() CreateTableStatement
+	IF_NOT_EXISTS() CreateTableStatement
+	COLUMNS(columns ...ColumnDefinition) CreateTableStatement
+	CONSTRAINTS(constraints ...TableConstraint) CreateTableStatement
+}
+
+// CREATE_TABLE creates new CREATE TABLE statement. If column definitions are not set with COLUMNS,
+// all the table columns are created with default data types.
+func CREATE_TABLE(table Table) CreateTableStatement {
+	newCreateTable := &createTableStatementImpl{}
+	newCreateTable.SerializerStatement = jet.NewStatementImpl(Dialect, jet.CreateTableStatementType, newCreateTable,
+		&newCreateTable.CreateTable)
+
+	newCreateTable.CreateTable.Table = table
+
+	return newCreateTable
+}
+
+type createTableStatementImpl struct {
+	jet.SerializerStatement
+
+	CreateTable jet.ClauseCreateTable
+}
+
+func (c *createTableStatementImpl) TEMPORARY() CreateTableStatement {
+	c.CreateTable.Temporary = true
+	return c
+}
+
+func (c *createTableStatementImpl) IF_NOT_EXISTS() CreateTableStatement {
+	c.CreateTable.IfNotExists = true
+	return c
+}
+
+func (c *createTableStatementImpl) COLUMNS(columns ...ColumnDefinition) CreateTableStatement {
+	c.CreateTable.Columns = columns
+	return c
+}
+
+func (c *createTableStatementImpl) CONSTRAINTS(constraints ...TableConstraint) CreateTableStatement {
+	c.CreateTable.Constraints = constraints
+	return c
+}
+
+// ---------------------------------------------------//
+
+// ALTER_TABLE starts new ALTER TABLE statement. SQLite allows only one action per ALTER TABLE statement.
+func ALTER_TABLE(table Table) alterTable {
+	return alterTable{table: table}
+}
+
+type alterTable struct {
+	table Table
+}
+
+func (a alterTable) action(action jet.Serializer) Statement {
+	newAlterTable := &alterTableStatementImpl{}
+	newAlterTable.SerializerStatement = jet.NewStatementImpl(Dialect, jet.AlterTableStatementType, newAlterTable,
+		&newAlterTable.AlterTable)
+
+	newAlterTable.AlterTable.Table = a.table
+	newAlterTable.AlterTable.Actions = []jet.Serializer{action}
+
+	return newAlterTable
+}
+
+// ADD_COLUMN adds new column to the table
+func (a alterTable) ADD_COLUMN(column ColumnDefinition) Statement {
+	return a.action(jet.AddColumn(column))
+}
+
+// DROP_COLUMN removes column from the table
+func (a alterTable) DROP_COLUMN(column Column) Statement {
+	return a.action(jet.DropColumn(column))
+}
+
+// RENAME_COLUMN renames table column
+func (a alterTable) RENAME_COLUMN(column Column, newName string) Statement {
+	return a.action(jet.RenameColumn(column, newName))
+}
+
+// RENAME_TO renames table
+func (a alterTable) RENAME_TO(newTableName string) Statement {
+	return a.action(jet.RenameTo(newTableName))
+}
+
+type alterTableStatementImpl struct {
+	jet.SerializerStatement
+
+	AlterTable jet.ClauseAlterTable
+}
+
+// ---------------------------------------------------//
+
+// DropTableStatement is interface for SQLite DROP TABLE statement
+type DropTableStatement interface {
+	Statement
+
+	IF_EXISTS() DropTableStatement
+}
+
+// DROP_TABLE creates new DROP TABLE statement
+func DROP_TABLE(table Table) DropTableStatement {
+	newDropTable := &dropTableStatementImpl{}
+	newDropTable.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DropTableStatementType, newDropTable,
+		&newDropTable.DropTable)
+
+	newDropTable.DropTable.Tables = []jet.SerializerTable{table}
+
+	return newDropTable
+}
+
+type dropTableStatementImpl struct {
+	jet.SerializerStatement
+
+	DropTable jet.ClauseDropTable
+}
+
+func (d *dropTableStatementImpl) IF_EXISTS() DropTableStatement {
+	d.DropTable.IfExists = true
+	return d
+}
+
+// ---------------------------------------------------//
+
+// CreateIndexStatement is interface for SQLite CREATE INDEX statement
+type CreateIndexStatement interface {
+	Statement
+
+	IF_NOT_EXISTS() CreateIndexStatement
+	ON(table Table, columns ...Column) CreateIndexStatement
+	WHERE(condition BoolExpression) CreateIndexStatement
+}
+
+// CREATE_INDEX creates new CREATE INDEX statement
+//
+//	CREATE_INDEX("film_title_idx").ON(Film, Film.Title)
+func CREATE_INDEX(name string) CreateIndexStatement {
+	return newCreateIndexStatement(name, false)
+}
+
+// CREATE_UNIQUE_INDEX creates new CREATE UNIQUE INDEX statement
+func CREATE_UNIQUE_INDEX(name string) CreateIndexStatement {
+	return newCreateIndexStatement(name, true)
+}
+
+func newCreateIndexStatement(name string, unique bool) CreateIndexStatement {
+	newCreateIndex := &createIndexStatementImpl{}
+	newCreateIndex.SerializerStatement = jet.NewStatementImpl(Dialect, jet.CreateIndexStatementType, newCreateIndex,
+		&newCreateIndex.CreateIndex, &newCreateIndex.Where)
+
+	newCreateIndex.CreateIndex.Name = name
+	newCreateIndex.CreateIndex.Unique = unique
+
+	return newCreateIndex
+}
+
+type createIndexStatementImpl struct {
+	jet.SerializerStatement
+
+	CreateIndex jet.ClauseCreateIndex
+	Where       jet.ClauseDDLWhere
+}
+
+func (c *createIndexStatementImpl) IF_NOT_EXISTS() CreateIndexStatement {
+	c.CreateIndex.IfNotExists = true
+	return c
+}
+
+func (c *createIndexStatementImpl) ON(table Table, columns ...Column) CreateIndexStatement {
+	c.CreateIndex.Table = table
+	c.CreateIndex.Columns = nil
+
+	for _, column := range columns {
+		c.CreateIndex.Columns = append(c.CreateIndex.Columns, column)
+	}
+
+	return c
+}
+
+func (c *createIndexStatementImpl) WHERE(condition BoolExpression) CreateIndexStatement {
+	c.Where.Condition = condition
+	return c
+}
+
+// ---------------------------------------------------//
+
+// DropIndexStatement is interface for SQLite DROP INDEX statement
+type DropIndexStatement interface {
+	Statement
+
+	IF_EXISTS() DropIndexStatement
+}
+
+// DROP_INDEX creates new DROP INDEX statement
+func DROP_INDEX(name string) DropIndexStatement {
+	newDropIndex := &dropIndexStatementImpl{}
+	newDropIndex.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DropIndexStatementType, newDropIndex,
+		&newDropIndex.DropIndex)
+
+	newDropIndex.DropIndex.Name = name
+
+	return newDropIndex
+}
+
+type dropIndexStatementImpl struct {
+	jet.SerializerStatement
+
+	DropIndex jet.ClauseDropIndex
+}
+
+func (d *dropIndexStatementImpl) IF_EXISTS() DropIndexStatement {
+	d.DropIndex.IfExists = true
+	return d
+}
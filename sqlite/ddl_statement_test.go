@@ -0,0 +1,71 @@
+package sqlite
+
+import "testing"
+
+func TestCreateTable(t *testing.T) {
+	assertStatementSql(t, CREATE_TABLE(table2), `
+CREATE TABLE db.table2 (
+    col3 INTEGER,
+    col4 INTEGER,
+    col_int INTEGER,
+    col_float REAL,
+    col_str TEXT,
+    col_bool BOOLEAN,
+    col_date DATE,
+    col_timestamp DATETIME
+);
+`)
+	assertStatementSql(t, CREATE_TABLE(table3).TEMPORARY().IF_NOT_EXISTS().
+		COLUMNS(
+			COLUMN(table3Col1).PRIMARY_KEY(),
+			COLUMN(table3ColInt).NOT_NULL().DEFAULT(Int(0)).REFERENCES(table2Col3),
+			COLUMN(table3StrCol).CHECK(table3StrCol.NOT_EQ(String(""))),
+		).
+		CONSTRAINTS(
+			UNIQUE(table3ColInt, table3StrCol),
+		), `
+CREATE TEMPORARY TABLE IF NOT EXISTS db.table3 (
+    col1 INTEGER PRIMARY KEY,
+    col_int INTEGER NOT NULL DEFAULT 0 REFERENCES table2 (col3),
+    col2 TEXT CHECK (col2 != ''),
+    UNIQUE (col_int, col2)
+);
+`)
+}
+
+func TestAlterTable(t *testing.T) {
+	assertStatementSql(t, ALTER_TABLE(table3).ADD_COLUMN(COLUMN(FloatColumn("price")).DEFAULT(Float(1.5))), `
+ALTER TABLE db.table3
+    ADD COLUMN price REAL DEFAULT 1.5;
+`)
+	assertStatementSql(t, ALTER_TABLE(table3).DROP_COLUMN(table3StrCol), `
+ALTER TABLE db.table3
+    DROP COLUMN col2;
+`)
+	assertStatementSql(t, ALTER_TABLE(table3).RENAME_COLUMN(table3StrCol, "title"), `
+ALTER TABLE db.table3
+    RENAME COLUMN col2 TO title;
+`)
+	assertStatementSql(t, ALTER_TABLE(table3).RENAME_TO("table4"), `
+ALTER TABLE db.table3
+    RENAME TO table4;
+`)
+}
+
+func TestDropTable(t *testing.T) {
+	assertStatementSql(t, DROP_TABLE(table3).IF_EXISTS(), `
+DROP TABLE IF EXISTS db.table3;
+`)
+}
+
+func TestCreateAndDropIndex(t *testing.T) {
+	assertStatementSql(t, CREATE_UNIQUE_INDEX("table3_idx").IF_NOT_EXISTS().
+		ON(table3, table3ColInt, table3StrCol).
+		WHERE(table3ColInt.IS_NOT_NULL()), `
+CREATE UNIQUE INDEX IF NOT EXISTS table3_idx ON db.table3 (col_int, col2)
+WHERE col_int IS NOT NULL;
+`)
+	assertStatementSql(t, DROP_INDEX("table3_idx").IF_EXISTS(), `
+DROP INDEX IF EXISTS table3_idx;
+`)
+}
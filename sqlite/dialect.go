@@ -29,11 +29,57 @@ func newDialect() jet.Dialect {
 		ValuesDefaultColumnName: func(index int) string {
 			return fmt.Sprintf("column%d", index+1)
 		},
+		JsonValueEncode: func(expr Expression) Expression {
+			switch e := expr.(type) {
+			// JSON is applied to every json expression (columns, functions, casts, subqueries...), so that
+			// the value is embedded as json, instead of string. It also converts binary JSONB blobs to text.
+			case JsonExpression:
+				return JSON(e)
+
+			// CustomExpression used bellow (instead STRFTIME function) so that only expr is parametrized
+			case TimestampExpression:
+				return jet.AtomicCustomExpression(Token("strftime('%Y-%m-%dT%H:%M:%fZ',"), e, Token(")"))
+			case TimeExpression:
+				return jet.AtomicCustomExpression(Token("strftime('0000-01-01T%H:%M:%fZ',"), e, Token(")"))
+			case DateExpression:
+				return jet.AtomicCustomExpression(Token("strftime('%Y-%m-%dT00:00:00Z',"), e, Token(")"))
+			case BoolExpression:
+				return jet.AtomicCustomExpression(Token("JSON(CASE"), e, Token("WHEN 0 THEN 'false' WHEN 1 THEN 'true' END)"))
+			}
+			return expr
+		},
+		DataType: dataType,
 	}
 
 	return jet.NewDialect(mySQLDialectParams)
 }
 
+// dataType returns default SQLite data type for the column type, used by DDL statements
+func dataType(column jet.Column) string {
+	switch column.(type) {
+	case ColumnBool:
+		return "BOOLEAN"
+	case ColumnInteger:
+		return "INTEGER"
+	case ColumnFloat:
+		return "REAL"
+	case ColumnString:
+		return "TEXT"
+	case ColumnBlob:
+		return "BLOB"
+	case ColumnDate:
+		return "DATE"
+	case ColumnTime:
+		return "TIME"
+	case ColumnTimestamp:
+		return "DATETIME"
+	case ColumnJson:
+		return "JSON"
+	}
+
+	return ""
+}
+
 func argumentToString(value any) (string, bool) {
 	switch bindVal := value.(type) {
 	case []byte:
@@ -42,6 +42,21 @@ type TimestampExpression = jet.TimestampExpression
 // RowExpression interface
 type RowExpression = jet.RowExpression
 
+// JsonExpression interface
+type JsonExpression = jet.JsonExpression
+
+// AggregateExpression interface
+type AggregateExpression = jet.AggregateExpression
+
+// IntegerAggregateExpression interface
+type IntegerAggregateExpression = jet.IntegerAggregateExpression
+
+// FloatAggregateExpression interface
+type FloatAggregateExpression = jet.FloatAggregateExpression
+
+// StringAggregateExpression interface
+type StringAggregateExpression = jet.StringAggregateExpression
+
 // BoolExp is bool expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as bool expression.
 // Does not add sql cast to generated sql builder output.
@@ -92,6 +107,11 @@ var TimestampExp = jet.TimestampExp
 // Note: This does not modify the generated SQL builder output by adding a SQL CAST operation.
 var RowExp = jet.RowExp
 
+// JsonExp is json expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as json expression.
+// Does not add sql cast to generated sql builder output.
+var JsonExp = jet.JsonExp
+
 // CustomExpression is used to define custom expressions.
 var CustomExpression = jet.CustomExpression
 
@@ -114,6 +134,7 @@ var (
 	RawTime      = jet.RawTime
 	RawTimestamp = jet.RawTimestamp
 	RawDate      = jet.RawDate
+	RawJson      = jet.RawJson
 )
 
 // Func can be used to call custom or unsupported database functions.
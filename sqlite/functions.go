@@ -72,7 +72,9 @@ var LOG = jet.LOG
 // ----------------- Aggregate functions  -------------------//
 
 // AVG is aggregate function used to calculate avg value from numeric expression
-var AVG = jet.AVG
+func AVG(numericExpression Expression) FloatAggregateExpression {
+	return jet.NewFloatAggregateFunc("AVG", numericExpression)
+}
 
 // BIT_AND is aggregate function used to calculates the bitwise AND of all non-null input values, or null if none.
 //var BIT_AND = jet.BIT_AND
@@ -81,34 +83,69 @@ var AVG = jet.AVG
 //var BIT_OR = jet.BIT_OR
 
 // COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
-var COUNT = jet.COUNT
+func COUNT(expression Expression) IntegerAggregateExpression {
+	return jet.NewIntegerAggregateFunc("COUNT", expression)
+}
 
 // MAX is aggregate function. Returns maximum value of expression across all input values
-var MAX = jet.MAX
+func MAX(expression Expression) AggregateExpression {
+	return jet.NewAggregateFunc("MAX", expression)
+}
 
 // MAXi is aggregate function. Returns maximum value of int expression across all input values
-var MAXi = jet.MAXi
+func MAXi(integerExpression IntegerExpression) IntegerAggregateExpression {
+	return jet.NewIntegerAggregateFunc("MAX", integerExpression)
+}
 
 // MAXf is aggregate function. Returns maximum value of float expression across all input values
-var MAXf = jet.MAXf
+func MAXf(floatExpression FloatExpression) FloatAggregateExpression {
+	return jet.NewFloatAggregateFunc("MAX", floatExpression)
+}
 
 // MIN is aggregate function. Returns minimum value of int expression across all input values
-var MIN = jet.MIN
+func MIN(expression Expression) AggregateExpression {
+	return jet.NewAggregateFunc("MIN", expression)
+}
 
 // MINi is aggregate function. Returns minimum value of int expression across all input values
-var MINi = jet.MINi
+func MINi(integerExpression IntegerExpression) IntegerAggregateExpression {
+	return jet.NewIntegerAggregateFunc("MIN", integerExpression)
+}
 
 // MINf is aggregate function. Returns minimum value of float expression across all input values
-var MINf = jet.MINf
+func MINf(floatExpression FloatExpression) FloatAggregateExpression {
+	return jet.NewFloatAggregateFunc("MIN", floatExpression)
+}
 
 // SUM is aggregate function. Returns sum of all expressions
-var SUM = jet.SUM
+func SUM(expression Expression) AggregateExpression {
+	return jet.NewAggregateFunc("SUM", expression)
+}
 
 // SUMi is aggregate function. Returns sum of integer expression.
-var SUMi = jet.SUMi
+func SUMi(integerExpression IntegerExpression) IntegerAggregateExpression {
+	return jet.NewIntegerAggregateFunc("SUM", integerExpression)
+}
 
 // SUMf is aggregate function. Returns sum of float expression.
-var SUMf = jet.SUMf
+func SUMf(floatExpression FloatExpression) FloatAggregateExpression {
+	return jet.NewFloatAggregateFunc("SUM", floatExpression)
+}
+
+// GROUP_CONCAT is aggregate function. Concatenates the non-null input values into a string, separated by
+// optional separator (default ',').
+func GROUP_CONCAT(expression Expression, separator ...StringExpression) StringAggregateExpression {
+	if len(separator) > 0 {
+		return jet.NewStringAggregateFunc("GROUP_CONCAT", expression, separator[0])
+	}
+
+	return jet.NewStringAggregateFunc("GROUP_CONCAT", expression)
+}
+
+// STRING_AGG is aggregate function. Concatenates the non-null input values into a string, separated by delimiter.
+func STRING_AGG(expression StringExpression, delimiter StringExpression) StringAggregateExpression {
+	return jet.NewStringAggregateFunc("STRING_AGG", expression, delimiter)
+}
 
 // -------------------- Window functions -----------------------//
 
@@ -354,6 +391,133 @@ func UNIX_TIMESTAMP(str StringExpression) TimestampExpression {
 	return jet.NewTimestampFunc("UNIX_TIMESTAMP", str)
 }
 
+// ----------------------- JSON Functions ----------------------------//
+
+// JSON verifies that its argument is a valid JSON string and returns a minified version of that JSON string
+func JSON(json Expression) JsonExpression {
+	return JsonExp(Func("JSON", json))
+}
+
+// JSON_ARRAY accepts zero or more arguments and returns a well-formed JSON array that is composed from those arguments
+func JSON_ARRAY(values ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_ARRAY", values...))
+}
+
+// JSON_ARRAY_LENGTH returns the number of elements in the JSON array, or, if a path argument is given,
+// the number of elements in the array located at the path.
+func JSON_ARRAY_LENGTH(json Expression, path ...StringExpression) IntegerExpression {
+	return IntExp(Func("JSON_ARRAY_LENGTH", optionalPath("JSON_ARRAY_LENGTH", []Expression{json}, path)...))
+}
+
+// JSON_EXTRACT extracts and returns one or more values from the well-formed JSON. If only a single path
+// is provided, the SQL value of the selected JSON element is returned, otherwise a JSON array holding
+// all selected values.
+func JSON_EXTRACT(json Expression, path StringExpression, paths ...StringExpression) JsonExpression {
+	return JsonExp(Func("JSON_EXTRACT", append([]Expression{json, path}, jet.ToExpressionList(paths)...)...))
+}
+
+// JSON_INSERT inserts value at the path, without overwriting existing values. Additional path-value
+// pairs can be passed using pathValues parameter.
+func JSON_INSERT(json Expression, path StringExpression, value Expression, pathValues ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_INSERT", append([]Expression{json, path, value}, pathValues...)...))
+}
+
+// JSON_REPLACE overwrites existing value at the path. Additional path-value pairs can be passed using
+// pathValues parameter.
+func JSON_REPLACE(json Expression, path StringExpression, value Expression, pathValues ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_REPLACE", append([]Expression{json, path, value}, pathValues...)...))
+}
+
+// JSON_SET inserts or overwrites value at the path. Additional path-value pairs can be passed using
+// pathValues parameter.
+func JSON_SET(json Expression, path StringExpression, value Expression, pathValues ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_SET", append([]Expression{json, path, value}, pathValues...)...))
+}
+
+// JSON_REMOVE removes values at the given paths from the JSON
+func JSON_REMOVE(json Expression, paths ...StringExpression) JsonExpression {
+	return JsonExp(Func("JSON_REMOVE", append([]Expression{json}, jet.ToExpressionList(paths)...)...))
+}
+
+// JSON_PATCH applies RFC-7396 MergePatch algorithm to apply patch against target
+func JSON_PATCH(target, patch Expression) JsonExpression {
+	return JsonExp(Func("JSON_PATCH", target, patch))
+}
+
+// JSON_OBJECT accepts zero or more pairs of arguments and returns a well-formed JSON object that is composed
+// from those arguments. The first argument of each pair is the label and the second is the value.
+//
+//	JSON_OBJECT(String("id"), Film.FilmID, String("title"), Film.Title)
+func JSON_OBJECT(keyValues ...Expression) JsonExpression {
+	return JsonExp(Func("JSON_OBJECT", keyValues...))
+}
+
+// JSON_QUOTE converts the SQL value (a number or a string) into its corresponding JSON representation
+func JSON_QUOTE(value Expression) JsonExpression {
+	return JsonExp(Func("JSON_QUOTE", value))
+}
+
+// JSON_TYPE returns the "type" of the outermost element of JSON, or, if a path argument is given,
+// the type of the element selected by the path. Possible types are: 'null', 'true', 'false', 'integer',
+// 'real', 'text', 'array', or 'object'.
+func JSON_TYPE(json Expression, path ...StringExpression) StringExpression {
+	return StringExp(Func("JSON_TYPE", optionalPath("JSON_TYPE", []Expression{json}, path)...))
+}
+
+// JSON_VALID returns true if the argument is well-formed JSON
+func JSON_VALID(json Expression) BoolExpression {
+	return BoolExp(Func("JSON_VALID", json))
+}
+
+// JSON_GROUP_ARRAY is aggregate function. Returns a JSON array comprised of all values in the aggregation.
+func JSON_GROUP_ARRAY(value Expression) JsonExpression {
+	return JsonExp(Func("JSON_GROUP_ARRAY", value))
+}
+
+// JSON_GROUP_OBJECT is aggregate function. Returns a JSON object comprised of all name/value pairs in the aggregation.
+func JSON_GROUP_OBJECT(name StringExpression, value Expression) JsonExpression {
+	return JsonExp(Func("JSON_GROUP_OBJECT", name, value))
+}
+
+// ----------------------- FTS5 Functions ----------------------------//
+
+// BM25 returns relevance of the current FTS5 match. The better the match, the numerically smaller the value.
+// Optional weights are applied to the FTS5 table columns, in order of their declaration.
+//
+//	BM25(Email.Email, Float(10.0), Float(5.0))
+func BM25(table ColumnFts5, weights ...FloatExpression) FloatExpression {
+	return FloatExp(Func("bm25", append([]Expression{table}, jet.ToExpressionList(weights)...)...))
+}
+
+// HIGHLIGHT returns a copy of the text from the column of the current FTS5 match, with the matched
+// phrases surrounded by before and after strings. Column is 0-based index of the FTS5 table column.
+//
+//	HIGHLIGHT(Email.Email, Int(1), String("<b>"), String("</b>"))
+func HIGHLIGHT(table ColumnFts5, column IntegerExpression, before, after StringExpression) StringExpression {
+	return StringExp(Func("highlight", table, column, before, after))
+}
+
+// SNIPPET selects a short fragment of text from the column of the current FTS5 match, with the matched
+// phrases surrounded by before and after strings. Ellipsis is added when the fragment does not start or
+// end at the text boundary, and maxTokens is the maximum number of tokens in the fragment (1-64).
+//
+//	SNIPPET(Email.Email, Int(2), String("<b>"), String("</b>"), String("..."), Int(16))
+func SNIPPET(table ColumnFts5, column IntegerExpression, before, after, ellipsis StringExpression,
+	maxTokens IntegerExpression) StringExpression {
+	return StringExp(Func("snippet", table, column, before, after, ellipsis, maxTokens))
+}
+
+func optionalPath(funcName string, args []Expression, path []StringExpression) []Expression {
+	switch len(path) {
+	case 0:
+		return args
+	case 1:
+		return append(args, path[0])
+	default:
+		panic(fmt.Sprintf("jet: %s accepts at most one path argument, got %d", funcName, len(path)))
+	}
+}
+
 // --------------- Conditional Expressions Functions -------------//
 
 // COALESCE function returns the first of its arguments that is not null.
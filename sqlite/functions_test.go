@@ -0,0 +1,71 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonOperators(t *testing.T) {
+	assertSerialize(t, table1ColJson.GET(String("$.name")), `(table1.col_json -> ?)`, "$.name")
+	assertSerialize(t, table1ColJson.GET(Int(0)).GET_TEXT(String("name")).EQ(String("John")),
+		`(((table1.col_json -> ?) ->> ?) = ?)`, int64(0), "name", "John")
+}
+
+func TestJsonFunctions(t *testing.T) {
+	assertSerialize(t, JSON(table1ColString), `JSON(table1.col_string)`)
+	assertSerialize(t, JSON_ARRAY(table1ColInt, String("a")), `JSON_ARRAY(table1.col_int, ?)`, "a")
+	assertSerialize(t, JSON_ARRAY_LENGTH(table1ColJson), `JSON_ARRAY_LENGTH(table1.col_json)`)
+	assertSerialize(t, JSON_ARRAY_LENGTH(table1ColJson, String("$.a")), `JSON_ARRAY_LENGTH(table1.col_json, ?)`, "$.a")
+	assertSerialize(t, JSON_EXTRACT(table1ColJson, String("$.a")), `JSON_EXTRACT(table1.col_json, ?)`, "$.a")
+	assertSerialize(t, JSON_EXTRACT(table1ColJson, String("$.a"), String("$.b")), `JSON_EXTRACT(table1.col_json, ?, ?)`, "$.a", "$.b")
+	assertSerialize(t, JSON_INSERT(table1ColJson, String("$.a"), Int(1)), `JSON_INSERT(table1.col_json, ?, ?)`, "$.a", int64(1))
+	assertSerialize(t, JSON_REPLACE(table1ColJson, String("$.a"), Int(1)), `JSON_REPLACE(table1.col_json, ?, ?)`, "$.a", int64(1))
+	assertSerialize(t, JSON_SET(table1ColJson, String("$.a"), Int(1), String("$.b"), Json(`[]`)),
+		`JSON_SET(table1.col_json, ?, ?, ?, JSON(?))`, "$.a", int64(1), "$.b", "[]")
+	assertSerialize(t, JSON_REMOVE(table1ColJson, String("$.a"), String("$.b")), `JSON_REMOVE(table1.col_json, ?, ?)`, "$.a", "$.b")
+	assertSerialize(t, JSON_PATCH(table1ColJson, Json(`{"a": null}`)), `JSON_PATCH(table1.col_json, JSON(?))`, `{"a": null}`)
+	assertSerialize(t, JSON_OBJECT(String("id"), table1ColInt), `JSON_OBJECT(?, table1.col_int)`, "id")
+	assertSerialize(t, JSON_QUOTE(table1ColString), `JSON_QUOTE(table1.col_string)`)
+	assertSerialize(t, JSON_TYPE(table1ColJson, String("$.a")), `JSON_TYPE(table1.col_json, ?)`, "$.a")
+	assertSerialize(t, JSON_VALID(table1ColString), `JSON_VALID(table1.col_string)`)
+	assertSerialize(t, JSON_GROUP_ARRAY(table1ColInt), `JSON_GROUP_ARRAY(table1.col_int)`)
+	assertSerialize(t, JSON_GROUP_OBJECT(table1ColString, table1ColInt), `JSON_GROUP_OBJECT(table1.col_string, table1.col_int)`)
+}
+
+func TestJsonFunctionsPathCount(t *testing.T) {
+	require.PanicsWithValue(t, "jet: JSON_ARRAY_LENGTH accepts at most one path argument, got 2", func() {
+		JSON_ARRAY_LENGTH(table1ColJson, String("$.a"), String("$.b"))
+	})
+	require.PanicsWithValue(t, "jet: JSON_TYPE accepts at most one path argument, got 2", func() {
+		JSON_TYPE(table1ColJson, String("$.a"), String("$.b"))
+	})
+	require.PanicsWithValue(t, "jet: JSON_EACH accepts at most one path argument, got 2", func() {
+		JSON_EACH(table1ColJson, String("$.a"), String("$.b"))
+	})
+	require.PanicsWithValue(t, "jet: JSON_TREE accepts at most one path argument, got 3", func() {
+		JSON_TREE(table1ColJson, String("$.a"), String("$.b"), String("$.c"))
+	})
+}
+
+func TestAggregateFunctions(t *testing.T) {
+	assertSerialize(t, COUNT(STAR).FILTER(table1ColInt.GT(Int(10))), `COUNT(*) FILTER (WHERE table1.col_int > ?)`, int64(10))
+	assertSerialize(t, GROUP_CONCAT(table1ColString), `GROUP_CONCAT(table1.col_string)`)
+	assertSerialize(t, GROUP_CONCAT(table1ColString).DISTINCT(), `GROUP_CONCAT(DISTINCT table1.col_string)`)
+	assertSerialize(t, GROUP_CONCAT(table1ColString, String(";")).ORDER_BY(table1ColInt.DESC()),
+		`GROUP_CONCAT(table1.col_string, ? ORDER BY table1.col_int DESC)`, ";")
+	assertSerialize(t, STRING_AGG(table1ColString, String(",")).FILTER(table1ColBool),
+		`STRING_AGG(table1.col_string, ?) FILTER (WHERE table1.col_bool)`, ",")
+	assertSerialize(t, AVG(table1ColFloat).FILTER(table1ColInt.IS_NOT_NULL()).OVER(PARTITION_BY(table1ColString)),
+		`AVG(table1.col_float) FILTER (WHERE table1.col_int IS NOT NULL) OVER (PARTITION BY table1.col_string)`)
+}
+
+func TestFts5Functions(t *testing.T) {
+	assertSerialize(t, emailColEmail.MATCH(String("fox")), `(email.email MATCH ?)`, "fox")
+	assertSerialize(t, BM25(emailColEmail), `bm25(email.email)`)
+	assertSerialize(t, BM25(emailColEmail, Float(10), Float(1)), `bm25(email.email, ?, ?)`, 10.0, 1.0)
+	assertSerialize(t, HIGHLIGHT(emailColEmail, Int(1), String("<b>"), String("</b>")),
+		`highlight(email.email, ?, ?, ?)`, int64(1), "<b>", "</b>")
+	assertSerialize(t, SNIPPET(emailColEmail, Int(1), String("<b>"), String("</b>"), String("..."), Int(16)),
+		`snippet(email.email, ?, ?, ?, ?, ?)`, int64(1), "<b>", "</b>", "...", int64(16))
+}
@@ -15,6 +15,7 @@ type InsertStatement interface {
 	DEFAULT_VALUES() InsertStatement
 
 	ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict
+	ON_CONFLICT_TARGET(target ConflictTarget) conflictTarget
 	RETURNING(projections ...Projection) InsertStatement
 }
 
@@ -87,3 +88,13 @@ func (is *insertStatementImpl) ON_CONFLICT(indexExpressions ...jet.ColumnExpress
 	}
 	return &is.OnConflict
 }
+
+func (is *insertStatementImpl) ON_CONFLICT_TARGET(target ConflictTarget) conflictTarget {
+	onConflict := is.ON_CONFLICT(target.Columns...)
+
+	if target.Predicate != nil {
+		return onConflict.WHERE(target.Predicate)
+	}
+
+	return onConflict
+}
@@ -148,3 +148,16 @@ RETURNING table1.col1 AS "table1.col1",
           table1.col_bool AS "table1.col_bool";
 `)
 }
+
+func TestInsert_ON_CONFLICT_TARGET(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1ColBool).
+		VALUES("one", "two").
+		ON_CONFLICT_TARGET(NewConflictTarget(RawBool("col_bool = 1"), table1Col1, table1ColBool)).
+		DO_NOTHING()
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_bool)
+VALUES (?, ?)
+ON CONFLICT (col1, col_bool) WHERE col_bool = 1 DO NOTHING;
+`, "one", "two")
+}
@@ -0,0 +1,70 @@
+package sqlite
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// JsonEachTable is a table returned by JSON_EACH and JSON_TREE table-valued functions.
+// Each row of the table represents one element of the JSON array or one member of the JSON object.
+type JsonEachTable struct {
+	SelectTable
+
+	Key     ColumnString  // label of the object member or index of the array element
+	Value   ColumnString  // SQL value of the element, or JSON text for arrays and objects
+	Type    ColumnString  // 'null', 'true', 'false', 'integer', 'real', 'text', 'array' or 'object'
+	Atom    ColumnString  // SQL value for primitive elements, NULL for arrays and objects
+	ID      ColumnInteger // integer that identifies the JSON element within the complete JSON string
+	Parent  ColumnInteger // id of the parent element (JSON_TREE only, NULL for JSON_EACH)
+	FullKey ColumnString  // path that uniquely identifies the current element within the original JSON
+	Path    ColumnString  // path to the container of the current element
+}
+
+// JSON_EACH is a table-valued function that walks the top-level array or object elements of the json,
+// or, if a path argument is given, the elements of the array or object located at the path.
+//
+//	tags := JSON_EACH(Post.Tags).AS("tags")
+//
+//	SELECT(Post.ID, tags.Value).
+//		FROM(Post.CROSS_JOIN(tags))
+func JSON_EACH(json Expression, path ...StringExpression) jsonEachFunc {
+	return jsonEachFunc{
+		function: Func("JSON_EACH", optionalPath("JSON_EACH", []Expression{json}, path)...),
+	}
+}
+
+// JSON_TREE is a table-valued function that recursively walks the JSON substructure starting with the
+// top-level element, or, if a path argument is given, starting with the element located at the path.
+func JSON_TREE(json Expression, path ...StringExpression) jsonEachFunc {
+	return jsonEachFunc{
+		function: Func("JSON_TREE", optionalPath("JSON_TREE", []Expression{json}, path)...),
+	}
+}
+
+type jsonEachFunc struct {
+	function Expression
+}
+
+// AS sets the alias of the table-valued function, and creates new table that can be used in the FROM clause
+func (j jsonEachFunc) AS(alias string) *JsonEachTable {
+	table := &JsonEachTable{
+		Key:     StringColumn("key"),
+		Value:   StringColumn("value"),
+		Type:    StringColumn("type"),
+		Atom:    StringColumn("atom"),
+		ID:      IntegerColumn("id"),
+		Parent:  IntegerColumn("parent"),
+		FullKey: StringColumn("fullkey"),
+		Path:    StringColumn("path"),
+	}
+
+	columns := []jet.ColumnExpression{
+		table.Key, table.Value, table.Type, table.Atom, table.ID, table.Parent, table.FullKey, table.Path,
+	}
+
+	subQuery := &selectTableImpl{
+		SelectTable: jet.NewTableFunction(j.function, alias, columns),
+	}
+	subQuery.readableTableInterfaceImpl.root = subQuery
+
+	table.SelectTable = subQuery
+
+	return table
+}
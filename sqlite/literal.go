@@ -73,3 +73,15 @@ func Time(hour, minute, second int, nanoseconds ...time.Duration) TimeExpression
 func DateTime(year int, month time.Month, day, hour, minute, second int, nanoseconds ...time.Duration) DateTimeExpression {
 	return DATETIME(jet.Timestamp(year, month, day, hour, minute, second, nanoseconds...))
 }
+
+// Json creates new json literal expression
+func Json(value interface{}) JsonExpression {
+	switch v := value.(type) {
+	case string:
+	case []byte:
+		value = string(v) // blob arguments are interpreted as binary JSONB
+	default:
+		panic("Json parameter value has to be of the type string or []byte")
+	}
+	return JSON(jet.Literal(value))
+}
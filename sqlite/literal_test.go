@@ -78,3 +78,9 @@ func TestDateTime(t *testing.T) {
 	assertSerialize(t, DateTime(2010, time.March, 30, 10, 15, 30), `DATETIME(?)`, "2010-03-30 10:15:30")
 	assertSerialize(t, DATETIME(testTime), `DATETIME(?)`, testTime)
 }
+
+func TestJson(t *testing.T) {
+	assertSerialize(t, Json(`{"a": 1}`), `JSON(?)`, `{"a": 1}`)
+	assertSerialize(t, Json([]byte(`[1, 2]`)), `JSON(?)`, `[1, 2]`)
+	assertPanicErr(t, func() { Json(11) }, "Json parameter value has to be of the type string or []byte")
+}
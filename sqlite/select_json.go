@@ -0,0 +1,111 @@
+package sqlite
+
+import (
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// SelectJsonStatement is an interface for SQLite statements that generate JSON on the server.
+// SQLite JSON can not hold BLOB values, so blob projections have to be converted explicitly (for instance using HEX).
+type SelectJsonStatement interface {
+	Statement
+	jet.Serializer
+
+	AS(alias string) Projection
+
+	FROM(table ReadableTable) SelectJsonStatement
+	WHERE(condition BoolExpression) SelectJsonStatement
+	ORDER_BY(orderByClauses ...OrderByClause) SelectJsonStatement
+	LIMIT(limit int64) SelectJsonStatement
+	OFFSET(offset int64) SelectJsonStatement
+}
+
+// SELECT_JSON_ARR creates a new SelectJsonStatement with a list of projections.
+func SELECT_JSON_ARR(projections ...Projection) SelectJsonStatement {
+	return newSelectStatementJson(projections, jet.SelectJsonArrStatementType)
+}
+
+// SELECT_JSON_OBJ creates a new SelectJsonStatement with a list of projections.
+func SELECT_JSON_OBJ(projections ...Projection) SelectJsonStatement {
+	return newSelectStatementJson(projections, jet.SelectJsonObjStatementType)
+}
+
+type selectJsonStatement struct {
+	*selectStatementImpl
+
+	// SELECT_JSON_ARR aggregates json objects from the subQuery, so that ORDER BY, LIMIT and OFFSET
+	// clauses are applied before aggregation
+	subQuery      *selectStatementImpl
+	statementType jet.StatementType
+}
+
+func newSelectStatementJson(projections []Projection, statementType jet.StatementType) SelectJsonStatement {
+	jsonObject := Func("JSON_OBJECT", CustomExpression(jet.JsonObjProjectionList(projections)))
+
+	newSelectJson := &selectJsonStatement{
+		selectStatementImpl: newSelectStatement(statementType, nil, ProjectionList{jsonObject.AS("json")}),
+		statementType:       statementType,
+	}
+
+	if statementType == jet.SelectJsonArrStatementType {
+		newSelectJson.subQuery = newSelectStatement(statementType, nil, ProjectionList{jsonObject.AS("json")})
+		newSelectJson.setSubQueryAlias("")
+	}
+
+	return newSelectJson
+}
+
+// AS wraps statement with JSON function, because nested statement result would otherwise be embedded
+// as a json string, instead of json object or array. Plain function expression is used, instead of
+// JsonExpression, so that dialect json value encoding does not wrap it once more.
+func (s *selectJsonStatement) AS(alias string) Projection {
+	if s.statementType == jet.SelectJsonArrStatementType {
+		s.setSubQueryAlias(strings.ToLower(alias) + "_")
+	}
+
+	return Func("JSON", s.selectStatementImpl).AS(alias)
+}
+
+func (s *selectJsonStatement) setSubQueryAlias(alias string) {
+	subQueryAlias := alias + "records"
+
+	jsonArray := Func("JSON_GROUP_ARRAY", JSON(CustomExpression(Token(subQueryAlias+".json"))))
+
+	s.Select.ProjectionList = ProjectionList{jsonArray.AS("json")}
+	s.From.Tables = []jet.Serializer{newSelectTable(s.subQuery, subQueryAlias, nil)}
+}
+
+// clauses returns statement clauses should be applied to. For SELECT_JSON_ARR that is a subQuery.
+func (s *selectJsonStatement) clauses() *selectStatementImpl {
+	if s.subQuery != nil {
+		return s.subQuery
+	}
+
+	return s.selectStatementImpl
+}
+
+func (s *selectJsonStatement) FROM(table ReadableTable) SelectJsonStatement {
+	s.clauses().From.Tables = []jet.Serializer{table}
+	return s
+}
+
+func (s *selectJsonStatement) WHERE(condition BoolExpression) SelectJsonStatement {
+	s.clauses().Where.Condition = condition
+	return s
+}
+
+func (s *selectJsonStatement) ORDER_BY(orderBy ...OrderByClause) SelectJsonStatement {
+	s.clauses().OrderBy.List = orderBy
+	return s
+}
+
+func (s *selectJsonStatement) LIMIT(limit int64) SelectJsonStatement {
+	s.clauses().Limit.Count = limit
+	return s
+}
+
+func (s *selectJsonStatement) OFFSET(offset int64) SelectJsonStatement {
+	s.clauses().Offset.Count = Int(offset)
+	return s
+}
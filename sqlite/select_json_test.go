@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"testing"
+)
+
+func TestSelectJsonObj(t *testing.T) {
+	stmt := SELECT_JSON_OBJ(table1ColInt, table1ColBool, table1ColDate, table1ColTimestamp, table1ColTime, table1ColJson).
+		FROM(table1).
+		WHERE(table1ColInt.EQ(Int(2)))
+
+	assertStatementSql(t, stmt, `
+SELECT JSON_OBJECT(
+          'colInt', table1.col_int,
+          'colBool', JSON(CASE table1.col_bool WHEN 0 THEN 'false' WHEN 1 THEN 'true' END),
+          'colDate', strftime('%Y-%m-%dT00:00:00Z',table1.col_date),
+          'colTimestamp', strftime('%Y-%m-%dT%H:%M:%fZ',table1.col_timestamp),
+          'colTime', strftime('0000-01-01T%H:%M:%fZ',table1.col_time),
+          'colJSON', JSON(table1.col_json)
+     ) AS "json"
+FROM db.table1
+WHERE table1.col_int = ?;
+`, int64(2))
+}
+
+func TestSelectJsonArr_Nested(t *testing.T) {
+	stmt := SELECT_JSON_ARR(
+		table1ColInt,
+		SELECT_JSON_ARR(table2ColStr).
+			FROM(table2).
+			WHERE(table2ColInt.EQ(table1ColInt)).
+			ORDER_BY(table2ColStr).
+			LIMIT(10).OFFSET(20).AS("Table2List"),
+		SELECT_JSON_OBJ(table3StrCol).
+			FROM(table3).
+			WHERE(table3ColInt.EQ(table1ColInt)).AS("Table3"),
+	).FROM(
+		table1,
+	).ORDER_BY(
+		table1ColInt.DESC(),
+	)
+
+	assertStatementSql(t, stmt, `
+SELECT JSON_GROUP_ARRAY(JSON(records.json)) AS "json"
+FROM (
+          SELECT JSON_OBJECT(
+                    'colInt', table1.col_int,
+                    'Table2List', JSON((
+                         SELECT JSON_GROUP_ARRAY(JSON(table2list_records.json)) AS "json"
+                         FROM (
+                                   SELECT JSON_OBJECT(
+                                             'colStr', table2.col_str
+                                        ) AS "json"
+                                   FROM db.table2
+                                   WHERE table2.col_int = table1.col_int
+                                   ORDER BY table2.col_str
+                                   LIMIT ?
+                                   OFFSET ?
+                              ) AS table2list_records
+                    )),
+                    'Table3', JSON((
+                         SELECT JSON_OBJECT(
+                                   'col2', table3.col2
+                              ) AS "json"
+                         FROM db.table3
+                         WHERE table3.col_int = table1.col_int
+                    ))
+               ) AS "json"
+          FROM db.table1
+          ORDER BY table1.col_int DESC
+     ) AS records;
+`, int64(10), int64(20))
+}
+
+func TestSelectJsonObj_JsonExpressions(t *testing.T) {
+	stmt := SELECT_JSON_OBJ(
+		JSON_OBJECT(String("id"), table1ColInt).AS("object"),
+		JSON_ARRAY(table1ColInt, table1ColFloat).AS("array"),
+		table1ColJson.GET(String("$.a")).AS("nested"),
+	).FROM(table1)
+
+	assertStatementSql(t, stmt, `
+SELECT JSON_OBJECT(
+          'object', JSON(JSON_OBJECT(?, table1.col_int)),
+          'array', JSON(JSON_ARRAY(table1.col_int, table1.col_float)),
+          'nested', JSON(table1.col_json -> ?)
+     ) AS "json"
+FROM db.table1;
+`, "id", "$.a")
+}
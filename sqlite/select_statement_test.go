@@ -154,3 +154,48 @@ WHERE NOT (EXISTS (
       ));
 `)
 }
+
+func TestSelectFromJsonEach(t *testing.T) {
+	items := JSON_EACH(table1ColJson, String("$.items")).AS("items")
+
+	assertStatementSql(t, SELECT(table1ColInt, items.Key, items.Value).
+		FROM(table1.CROSS_JOIN(items)).
+		WHERE(items.Type.EQ(String("text"))), `
+SELECT table1.col_int AS "table1.col_int",
+     items.`+"`key`"+` AS "key",
+     items.value AS "value"
+FROM db.table1
+     CROSS JOIN JSON_EACH(table1.col_json, ?) AS items
+WHERE items.type = ?;
+`, "$.items", "text")
+
+	tree := JSON_TREE(table1ColJson).AS("tree")
+
+	assertStatementSql(t, SELECT(tree.AllColumns()).FROM(tree), `
+SELECT tree.`+"`key`"+` AS "key",
+     tree.value AS "value",
+     tree.type AS "type",
+     tree.atom AS "atom",
+     tree.id AS "id",
+     tree.parent AS "parent",
+     tree.fullkey AS "fullkey",
+     tree.path AS "path"
+FROM JSON_TREE(table1.col_json) AS tree;
+`)
+}
+
+func TestSelectFts5(t *testing.T) {
+	testutils.AssertDebugStatementSql(t,
+		SELECT(emailColSender, emailColBody, BM25(emailColEmail).AS("score")).
+			FROM(email).
+			WHERE(emailColEmail.MATCH(String("fox"))).
+			ORDER_BY(emailColRank),
+		`
+SELECT email.sender AS "email.sender",
+     email.body AS "email.body",
+     bm25(email.email) AS "score"
+FROM email
+WHERE email.email MATCH 'fox'
+ORDER BY email.rank;
+`)
+}
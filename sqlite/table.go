@@ -49,7 +49,7 @@ type readableTableInterfaceImpl struct {
 
 // Generates a select query on the current tableName.
 func (r readableTableInterfaceImpl) SELECT(projection1 Projection, projections ...Projection) SelectStatement {
-	return newSelectStatement(r.root, append([]Projection{projection1}, projections...))
+	return newSelectStatement(jet.SelectStatementType, r.root, append([]Projection{projection1}, projections...))
 }
 
 // Creates a inner join tableName Expression using onCondition.
@@ -87,6 +87,18 @@ func NewTable(schemaName, name, alias string, columns ...jet.ColumnExpression) T
 	return t
 }
 
+// NewVirtualTable creates new virtual table with schema Name, table Name, list of columns and list of hidden columns
+func NewVirtualTable(schemaName, name, alias string, columns []jet.ColumnExpression, hiddenColumns ...jet.ColumnExpression) Table {
+	t := &tableImpl{
+		SerializerTable: jet.NewVirtualTable(schemaName, name, alias, columns, hiddenColumns...),
+	}
+
+	t.readableTableInterfaceImpl.root = t
+	t.root = t
+
+	return t
+}
+
 type tableImpl struct {
 	jet.SerializerTable
 	readableTableInterfaceImpl
@@ -15,8 +15,9 @@ var table1Col3 = IntegerColumn("col3")
 var table1ColTimestamp = TimestampColumn("col_timestamp")
 var table1ColDate = DateColumn("col_date")
 var table1ColTime = TimeColumn("col_time")
+var table1ColJson = JsonColumn("col_json")
 
-var table1 = NewTable("db", "table1", "", table1Col1, table1ColInt, table1ColFloat, table1ColString, table1Col3, table1ColBool, table1ColDate, table1ColTimestamp, table1ColTime)
+var table1 = NewTable("db", "table1", "", table1Col1, table1ColInt, table1ColFloat, table1ColString, table1Col3, table1ColBool, table1ColDate, table1ColTimestamp, table1ColTime, table1ColJson)
 
 var table2Col3 = IntegerColumn("col3")
 var table2Col4 = IntegerColumn("col4")
@@ -34,6 +35,12 @@ var table3ColInt = IntegerColumn("col_int")
 var table3StrCol = StringColumn("col2")
 var table3 = NewTable("db", "table3", "", table3Col1, table3ColInt, table3StrCol)
 
+var emailColSender = StringColumn("sender")
+var emailColBody = StringColumn("body")
+var emailColEmail = Fts5Column("email")
+var emailColRank = FloatColumn("rank")
+var email = NewVirtualTable("", "email", "", []jet.ColumnExpression{emailColSender, emailColBody}, emailColEmail, emailColRank)
+
 func assertSerialize(t *testing.T, clause jet.Serializer, query string, args ...interface{}) {
 	testutils.AssertSerialize(t, Dialect, clause, query, args...)
 }
@@ -45,7 +45,6 @@ func TestAllTypesSelectJson(t *testing.T) {
 			AllTypes.JSON, AllTypes.JSONPtr,
 			AllTypes.Jsonb, AllTypes.JsonbPtr,
 			AllTypes.JsonbArray,
-			AllTypes.TextMultiDimArray, AllTypes.TextMultiDimArrayPtr,
 		),
 		// unsupported at the moment, casting to text allows these columns to be assigned to string fields
 		CAST(AllTypes.JSONPtr).AS_TEXT().AS("jsonPtr"),
@@ -53,8 +52,6 @@ func TestAllTypesSelectJson(t *testing.T) {
 		CAST(AllTypes.JsonbPtr).AS_TEXT().AS("jsonbPtr"),
 		CAST(AllTypes.Jsonb).AS_TEXT().AS("Jsonb"),
 		CAST(AllTypes.JsonbArray).AS_TEXT_ARRAY().AS("JsonbArray"),
-		CAST(AllTypes.TextMultiDimArray).AS_TEXT().AS("TextMultiDimArray"),
-		CAST(AllTypes.TextMultiDimArrayPtr).AS_TEXT().AS("TextMultiDimArrayPtr"),
 	).FROM(AllTypes)
 
 	testutils.AssertStatementSql(t, stmt, `
@@ -114,15 +111,15 @@ FROM (
                all_types.integer_array AS "integerArray",
                all_types.text_array_ptr AS "textArrayPtr",
                all_types.text_array AS "textArray",
+               all_types.text_multi_dim_array_ptr AS "textMultiDimArrayPtr",
+               all_types.text_multi_dim_array AS "textMultiDimArray",
                all_types.mood_ptr AS "moodPtr",
                all_types.mood AS "mood",
                all_types.json_ptr::text AS "jsonPtr",
                all_types.json::text AS "JSON",
                all_types.jsonb_ptr::text AS "jsonbPtr",
                all_types.jsonb::text AS "Jsonb",
-               all_types.jsonb_array::text[] AS "JsonbArray",
-               all_types.text_multi_dim_array::text AS "TextMultiDimArray",
-               all_types.text_multi_dim_array_ptr::text AS "TextMultiDimArrayPtr"
+               all_types.jsonb_array::text[] AS "JsonbArray"
           FROM test_sample.all_types
      ) AS records;
 `)
@@ -2446,8 +2443,8 @@ var allTypesRow0 = model.AllTypes{
 	TextArrayPtr:         &pq.StringArray{"breakfast", "consulting"},
 	TextArray:            pq.StringArray{"breakfast", "consulting"},
 	JsonbArray:           pq.StringArray{`{"a": 1, "b": 2}`, `{"a": 3, "b": 4}`},
-	TextMultiDimArrayPtr: ptr.Of("{{meeting,lunch},{training,presentation}}"),
-	TextMultiDimArray:    "{{meeting,lunch},{training,presentation}}",
+	TextMultiDimArrayPtr: &ArrayValue[[]string]{{"meeting", "lunch"}, {"training", "presentation"}},
+	TextMultiDimArray:    ArrayValue[[]string]{{"meeting", "lunch"}, {"training", "presentation"}},
 	MoodPtr:              &moodSad,
 	Mood:                 model.Mood_Happy,
 }
@@ -2515,7 +2512,7 @@ var allTypesRow1 = model.AllTypes{
 	TextArray:            pq.StringArray{"breakfast", "consulting"},
 	JsonbArray:           pq.StringArray{`{"a": 1, "b": 2}`, `{"a": 3, "b": 4}`},
 	TextMultiDimArrayPtr: nil,
-	TextMultiDimArray:    "{{meeting,lunch},{training,presentation}}",
+	TextMultiDimArray:    ArrayValue[[]string]{{"meeting", "lunch"}, {"training", "presentation"}},
 	MoodPtr:              nil,
 	Mood:                 model.Mood_Ok,
 }
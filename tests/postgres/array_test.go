@@ -723,5 +723,5 @@ var sampleArrayRow0 = model.SampleArrays{
 	TimetzArray:      pq.StringArray{"12:00:00+01", "13:00:00+02"},
 	IntervalArray:    pq.StringArray{"1 day", "02:00:00"},
 	UUIDArray:        pq.StringArray{"550e8400-e29b-41d4-a716-446655440000"},
-	MoodEnumArray:    pq.StringArray{"happy", "ok"},
+	MoodEnumArray:    ArrayValue[model.Mood]{model.Mood_Happy, model.Mood_Ok},
 }
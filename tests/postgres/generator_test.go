@@ -879,6 +879,7 @@ var allTypesModelContent = `
 package model
 
 import (
+	"github.com/go-jet/jet/v2/postgres/pgtype"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/shopspring/decimal"
@@ -945,8 +946,8 @@ type AllTypes struct {
 	TextArrayPtr         *pq.StringArray
 	TextArray            pq.StringArray
 	JsonbArray           pq.StringArray
-	TextMultiDimArrayPtr *string
-	TextMultiDimArray    string
+	TextMultiDimArrayPtr *pgtype.ArrayValue[[]string]
+	TextMultiDimArray    pgtype.ArrayValue[[]string]
 	MoodPtr              *Mood
 	Mood                 Mood
 }
@@ -1035,23 +1036,23 @@ type allTypesTable struct {
 	Bit                  postgres.ColumnString
 	BitVaryingPtr        postgres.ColumnString
 	BitVarying           postgres.ColumnString
-	TsvectorPtr          postgres.ColumnString
-	Tsvector             postgres.ColumnString
+	TsvectorPtr          postgres.ColumnTsVector
+	Tsvector             postgres.ColumnTsVector
 	UUIDPtr              postgres.ColumnString
 	UUID                 postgres.ColumnString
 	XMLPtr               postgres.ColumnString
 	XML                  postgres.ColumnString
-	JSONPtr              postgres.ColumnString
-	JSON                 postgres.ColumnString
-	JsonbPtr             postgres.ColumnString
-	Jsonb                postgres.ColumnString
+	JSONPtr              postgres.ColumnJson
+	JSON                 postgres.ColumnJson
+	JsonbPtr             postgres.ColumnJsonb
+	Jsonb                postgres.ColumnJsonb
 	IntegerArrayPtr      postgres.ColumnIntegerArray
 	IntegerArray         postgres.ColumnIntegerArray
 	TextArrayPtr         postgres.ColumnStringArray
 	TextArray            postgres.ColumnStringArray
-	JsonbArray           postgres.ColumnStringArray
-	TextMultiDimArrayPtr postgres.ColumnString
-	TextMultiDimArray    postgres.ColumnString
+	JsonbArray           postgres.ColumnJsonbArray
+	TextMultiDimArrayPtr postgres.ColumnStringArray
+	TextMultiDimArray    postgres.ColumnStringArray
 	MoodPtr              postgres.ColumnString
 	Mood                 postgres.ColumnString
 
@@ -1139,23 +1140,23 @@ func newAllTypesTableImpl(schemaName, tableName, alias string) allTypesTable {
 		BitColumn                  = postgres.StringColumn("bit")
 		BitVaryingPtrColumn        = postgres.StringColumn("bit_varying_ptr")
 		BitVaryingColumn           = postgres.StringColumn("bit_varying")
-		TsvectorPtrColumn          = postgres.StringColumn("tsvector_ptr")
-		TsvectorColumn             = postgres.StringColumn("tsvector")
+		TsvectorPtrColumn          = postgres.TsVectorColumn("tsvector_ptr")
+		TsvectorColumn             = postgres.TsVectorColumn("tsvector")
 		UUIDPtrColumn              = postgres.StringColumn("uuid_ptr")
 		UUIDColumn                 = postgres.StringColumn("uuid")
 		XMLPtrColumn               = postgres.StringColumn("xml_ptr")
 		XMLColumn                  = postgres.StringColumn("xml")
-		JSONPtrColumn              = postgres.StringColumn("json_ptr")
-		JSONColumn                 = postgres.StringColumn("json")
-		JsonbPtrColumn             = postgres.StringColumn("jsonb_ptr")
-		JsonbColumn                = postgres.StringColumn("jsonb")
+		JSONPtrColumn              = postgres.JsonColumn("json_ptr")
+		JSONColumn                 = postgres.JsonColumn("json")
+		JsonbPtrColumn             = postgres.JsonbColumn("jsonb_ptr")
+		JsonbColumn                = postgres.JsonbColumn("jsonb")
 		IntegerArrayPtrColumn      = postgres.IntegerArrayColumn("integer_array_ptr")
 		IntegerArrayColumn         = postgres.IntegerArrayColumn("integer_array")
 		TextArrayPtrColumn         = postgres.StringArrayColumn("text_array_ptr")
 		TextArrayColumn            = postgres.StringArrayColumn("text_array")
-		JsonbArrayColumn           = postgres.StringArrayColumn("jsonb_array")
-		TextMultiDimArrayPtrColumn = postgres.StringColumn("text_multi_dim_array_ptr")
-		TextMultiDimArrayColumn    = postgres.StringColumn("text_multi_dim_array")
+		JsonbArrayColumn           = postgres.JsonbArrayColumn("jsonb_array")
+		TextMultiDimArrayPtrColumn = postgres.StringArrayColumn("text_multi_dim_array_ptr")
+		TextMultiDimArrayColumn    = postgres.StringArrayColumn("text_multi_dim_array")
 		MoodPtrColumn              = postgres.StringColumn("mood_ptr")
 		MoodColumn                 = postgres.StringColumn("mood")
 		allColumns                 = postgres.ColumnList{SmallIntPtrColumn, SmallIntColumn, IntegerPtrColumn, IntegerColumn, BigIntPtrColumn, BigIntColumn, DecimalPtrColumn, DecimalColumn, NumericPtrColumn, NumericColumn, RealPtrColumn, RealColumn, DoublePrecisionPtrColumn, DoublePrecisionColumn, SmallserialColumn, SerialColumn, BigserialColumn, VarCharPtrColumn, VarCharColumn, CharPtrColumn, CharColumn, TextPtrColumn, TextColumn, ByteaPtrColumn, ByteaColumn, TimestampzPtrColumn, TimestampzColumn, TimestampPtrColumn, TimestampColumn, DatePtrColumn, DateColumn, TimezPtrColumn, TimezColumn, TimePtrColumn, TimeColumn, IntervalPtrColumn, IntervalColumn, BooleanPtrColumn, BooleanColumn, PointPtrColumn, BitPtrColumn, BitColumn, BitVaryingPtrColumn, BitVaryingColumn, TsvectorPtrColumn, TsvectorColumn, UUIDPtrColumn, UUIDColumn, XMLPtrColumn, XMLColumn, JSONPtrColumn, JSONColumn, JsonbPtrColumn, JsonbColumn, IntegerArrayPtrColumn, IntegerArrayColumn, TextArrayPtrColumn, TextArrayColumn, JsonbArrayColumn, TextMultiDimArrayPtrColumn, TextMultiDimArrayColumn, MoodPtrColumn, MoodColumn}
@@ -1745,6 +1746,7 @@ func TestGeneratorTestSample(t *testing.T) {
 package model
 
 import (
+	"github.com/go-jet/jet/v2/postgres/pgtype"
 	"github.com/lib/pq"
 )
 
@@ -1769,7 +1771,7 @@ type SampleArrays struct {
 	TimetzArray      pq.StringArray
 	IntervalArray    pq.StringArray
 	UUIDArray        pq.StringArray
-	MoodEnumArray    pq.StringArray
+	MoodEnumArray    pgtype.ArrayValue[Mood]
 }
 `)
 
@@ -1813,7 +1815,7 @@ type sampleArraysTable struct {
 	TimetzArray      postgres.ColumnTimezArray
 	IntervalArray    postgres.ColumnIntervalArray
 	UUIDArray        postgres.ColumnStringArray
-	MoodEnumArray    postgres.ColumnStringArray
+	MoodEnumArray    postgres.ColumnEnumArray
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -1875,7 +1877,7 @@ func newSampleArraysTableImpl(schemaName, tableName, alias string) sampleArraysT
 		TimetzArrayColumn      = postgres.TimezArrayColumn("timetz_array")
 		IntervalArrayColumn    = postgres.IntervalArrayColumn("interval_array")
 		UUIDArrayColumn        = postgres.StringArrayColumn("uuid_array")
-		MoodEnumArrayColumn    = postgres.StringArrayColumn("mood_enum_array")
+		MoodEnumArrayColumn    = postgres.EnumArrayColumn("mood_enum_array")
 		allColumns             = postgres.ColumnList{IDColumn, BoolArrayColumn, Int2ArrayPtrColumn, Int4ArrayColumn, Int8ArrayColumn, NumericArrayColumn, DecimalArrayColumn, RealArrayColumn, DoubleArrayColumn, TextArrayColumn, VarcharArrayColumn, CharArrayColumn, ByteaArrayColumn, DateArrayColumn, TimestampArrayColumn, TimestamptzArrayColumn, TimeArrayColumn, TimetzArrayColumn, IntervalArrayColumn, UUIDArrayColumn, MoodEnumArrayColumn}
 		mutableColumns         = postgres.ColumnList{BoolArrayColumn, Int2ArrayPtrColumn, Int4ArrayColumn, Int8ArrayColumn, NumericArrayColumn, DecimalArrayColumn, RealArrayColumn, DoubleArrayColumn, TextArrayColumn, VarcharArrayColumn, CharArrayColumn, ByteaArrayColumn, DateArrayColumn, TimestampArrayColumn, TimestamptzArrayColumn, TimeArrayColumn, TimetzArrayColumn, IntervalArrayColumn, UUIDArrayColumn, MoodEnumArrayColumn}
 		defaultColumns         = postgres.ColumnList{IDColumn, Int4ArrayColumn, Int8ArrayColumn, TextArrayColumn}
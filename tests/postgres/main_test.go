@@ -176,6 +176,22 @@ func requireQueryLogged(t require.TestingT, statement postgres.Statement, rowsPr
 	require.Equal(t, funcDetails.Name(), callerFunction)
 }
 
+// skipForPostgresBelow skips the test if the test database is not PostgreSQL, or if PostgreSQL server
+// version is lower than majorVersion.
+func skipForPostgresBelow(t *testing.T, majorVersion int) {
+	if sourceIsCockroachDB() {
+		t.SkipNow()
+	}
+
+	var serverVersion int
+	err := db.QueryRow("SHOW server_version_num").Scan(&serverVersion)
+	require.NoError(t, err)
+
+	if serverVersion < majorVersion*10000 {
+		t.Skipf("test requires PostgreSQL %d or newer", majorVersion)
+	}
+}
+
 func skipForPgxDriver(t *testing.T) {
 	if isPgxDriver() {
 		t.SkipNow()
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/go-jet/jet/v2/tests/.gentestdata/jetdb/test_sample/model"
+	. "github.com/go-jet/jet/v2/tests/.gentestdata/jetdb/test_sample/table"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	skipForPostgresBelow(t, 15)
+
+	sourceID := IntegerColumn("id")
+	sourceURL := StringColumn("url")
+	sourceName := StringColumn("name")
+
+	source := VALUES(
+		WRAP(Int32(200), String("http://www.github.com"), String("GitHub")),
+		WRAP(Int32(201), String("http://www.gitlab.com"), String("GitLab")),
+	).AS("source", sourceID, sourceURL, sourceName)
+
+	stmt := Link.MERGE().
+		USING(source).ON(Link.ID.EQ(sourceID)).
+		WHEN_MATCHED().THEN_UPDATE(Link.Name.SET(sourceName)).
+		WHEN_NOT_MATCHED().THEN_INSERT(Link.ID, Link.URL, Link.Name).VALUES(sourceID, sourceURL, sourceName)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+MERGE INTO test_sample.link
+USING (
+     VALUES (200::integer, 'http://www.github.com'::text, 'GitHub'::text),
+            (201::integer, 'http://www.gitlab.com'::text, 'GitLab'::text)
+) AS source (id, url, name) ON link.id = source.id
+WHEN MATCHED THEN UPDATE
+       SET name = source.name
+WHEN NOT MATCHED THEN INSERT (id, url, name)
+       VALUES (source.id, source.url, source.name);
+`)
+
+	testutils.ExecuteInTxAndRollback(t, db, func(tx qrm.DB) {
+		insertStmt := Link.INSERT(Link.ID, Link.URL, Link.Name).
+			VALUES(200, "http://www.github.com", "Old name")
+
+		testutils.AssertExec(t, insertStmt, tx, 1)
+		testutils.AssertExec(t, stmt, tx, 2)
+		requireLogged(t, stmt)
+
+		var links []model.Link
+
+		err := Link.SELECT(Link.AllColumns).
+			WHERE(Link.ID.BETWEEN(Int(200), Int(201))).
+			ORDER_BY(Link.ID).
+			Query(tx, &links)
+
+		require.NoError(t, err)
+		testutils.AssertDeepEqual(t, links, []model.Link{
+			{
+				ID:   200,
+				URL:  "http://www.github.com",
+				Name: "GitHub",
+			},
+			{
+				ID:   201,
+				URL:  "http://www.gitlab.com",
+				Name: "GitLab",
+			},
+		})
+	})
+}
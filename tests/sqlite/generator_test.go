@@ -84,10 +84,10 @@ func TestGenerator_TableMetadata(t *testing.T) {
 	want := metadata.Table{
 		Name: "actor",
 		Columns: []metadata.Column{
-			{Name: "actor_id", IsPrimaryKey: true, IsNullable: false, IsGenerated: false, HasDefault: false, DataType: metadata.DataType{Name: "INTEGER", Kind: "base", IsUnsigned: false, SourceDialect: "SQLite"}, Comment: ""},
-			{Name: "first_name", IsPrimaryKey: false, IsNullable: false, IsGenerated: false, HasDefault: false, DataType: metadata.DataType{Name: "VARCHAR", Kind: "base", IsUnsigned: false, SourceDialect: "SQLite"}, Comment: ""},
-			{Name: "last_name", IsPrimaryKey: false, IsNullable: false, IsGenerated: false, HasDefault: false, DataType: metadata.DataType{Name: "VARCHAR", Kind: "base", IsUnsigned: false, SourceDialect: "SQLite"}, Comment: ""},
-			{Name: "last_update", IsPrimaryKey: false, IsNullable: false, IsGenerated: false, HasDefault: true, DataType: metadata.DataType{Name: "TIMESTAMP", Kind: "base", IsUnsigned: false, SourceDialect: "SQLite"}, Comment: ""},
+			{Name: "actor_id", IsPrimaryKey: true, IsNullable: false, IsGenerated: false, HasDefault: false, IsAutoIncrement: true, DataType: metadata.DataType{Name: "INTEGER", Kind: "base", IsUnsigned: false, SourceDialect: "SQLite"}, Comment: ""},
+			{Name: "first_name", IsPrimaryKey: false, IsNullable: false, IsGenerated: false, HasDefault: false, IsAutoIncrement: false, DataType: metadata.DataType{Name: "VARCHAR", Kind: "base", IsUnsigned: false, SourceDialect: "SQLite"}, Comment: ""},
+			{Name: "last_name", IsPrimaryKey: false, IsNullable: false, IsGenerated: false, HasDefault: false, IsAutoIncrement: false, DataType: metadata.DataType{Name: "VARCHAR", Kind: "base", IsUnsigned: false, SourceDialect: "SQLite"}, Comment: ""},
+			{Name: "last_update", IsPrimaryKey: false, IsNullable: false, IsGenerated: false, HasDefault: true, IsAutoIncrement: false, DataType: metadata.DataType{Name: "TIMESTAMP", Kind: "base", IsUnsigned: false, SourceDialect: "SQLite"}, Comment: ""},
 		},
 	}
 	require.Equal(t, want, got)
@@ -0,0 +1,69 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	. "github.com/go-jet/jet/v2/sqlite"
+	. "github.com/go-jet/jet/v2/tests/.gentestdata/sqlite/sakila/table"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectJsonObj_JsonExpressions(t *testing.T) {
+	stmt := SELECT_JSON_OBJ(
+		Actor.ActorID,
+		JSON_OBJECT(String("first"), Actor.FirstName, String("last"), Actor.LastName).AS("name"),
+		JSON_ARRAY(Actor.FirstName, Actor.LastName).AS("names"),
+		JSON(String(`{"a": {"b": [1, 2]}}`)).GET(String("$.a")).AS("extracted"),
+	).FROM(
+		Actor,
+	).WHERE(
+		Actor.ActorID.EQ(Int(2)),
+	)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+SELECT JSON_OBJECT(
+          'actorID', actor.actor_id,
+          'name', JSON(JSON_OBJECT('first', actor.first_name, 'last', actor.last_name)),
+          'names', JSON(JSON_ARRAY(actor.first_name, actor.last_name)),
+          'extracted', JSON(JSON('{"a": {"b": [1, 2]}}') -> '$.a')
+     ) AS "json"
+FROM actor
+WHERE actor.actor_id = 2;
+`)
+
+	var dest struct {
+		ActorID int32
+		Name    struct {
+			First string
+			Last  string
+		}
+		Names     []string
+		Extracted map[string][]int
+	}
+
+	err := stmt.QueryContext(context.Background(), db, &dest)
+	require.NoError(t, err)
+
+	testutils.AssertJSON(t, dest, `
+{
+	"ActorID": 2,
+	"Name": {
+		"First": "NICK",
+		"Last": "WAHLBERG"
+	},
+	"Names": [
+		"NICK",
+		"WAHLBERG"
+	],
+	"Extracted": {
+		"b": [
+			1,
+			2
+		]
+	}
+}
+`)
+	requireLogged(t, stmt)
+}